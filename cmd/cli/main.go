@@ -46,24 +46,26 @@ func main() {
 			os.Exit(0)
 		}
 
+		// The statements of the input, which may be pasted
+		// as a script, run in a single transaction.
 		tx := db.NewTx()
-		stmtResult, err := db.Planner().ExecuteQuery(sqlInput, tx)
+		results, err := db.Planner().ExecuteScript(sqlInput, tx)
 		if err != nil {
 			tx.Rollback()
 			fmt.Println(err)
 			continue
 		}
-
 		tx.Commit()
 
-		if affectedRows, ok := stmtResult.(int64); ok {
-			fmt.Println("Affected rows:", affectedRows)
-			continue
+		for _, result := range results {
+			if !result.IsQuery {
+				fmt.Println("Affected rows:", result.AffectedRows)
+				continue
+			}
+			headers, rows := fetchResult(result)
+			renderDataTable(headers, rows)
+			fmt.Println("")
 		}
-
-		headers, rows := fetchResult(stmtResult)
-		renderDataTable(headers, rows)
-		fmt.Println("")
 	}
 
 }
@@ -104,20 +106,16 @@ func prepareUsersTable(db *server.SimpleDB) {
 	t2.Commit()
 }
 
-func fetchResult(result any) (table.Row, []table.Row) {
-	plan := result.(plan.Plan)
-	schema := plan.Schema()
-	scan := plan.Open()
+func fetchResult(result plan.StatementResult) (table.Row, []table.Row) {
 	rows := make([]table.Row, 0)
-	for scan.Next() {
+	for _, values := range result.Rows {
 		row := make([]string, 0)
-		for _, fieldName := range schema.Fields() {
-			data := scan.GetValue(fieldName)
+		for _, data := range values {
 			row = append(row, data.String())
 		}
 		rows = append(rows, makeRow(row))
 	}
-	return makeRow(schema.Fields()), rows
+	return makeRow(result.Fields), rows
 }
 
 func makeRow(data []string) []any {
@@ -87,6 +87,14 @@ func (buf *Buffer) flush() error {
 	return nil
 }
 
+// Detaches the buffer from its block without writing
+// its content to disk, even if it is dirty.
+func (buf *Buffer) unassign() {
+	buf.blockId = file.BlockId{}
+	buf.txNum = -1
+	buf.lsn = -1
+}
+
 func (buf *Buffer) Pin() {
 	buf.pins += 1
 }
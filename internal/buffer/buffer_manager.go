@@ -1,6 +1,7 @@
 package buffer
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -14,6 +15,10 @@ const (
 	MAX_WAIT_TIME = 5 * time.Second
 )
 
+// The error of a client that could not pin a buffer,
+// because none became available in time.
+var ErrBufferAbort = errors.New("buffer request waited for too long")
+
 // Manages the pinning and unpinning of buffers to blocks.
 type BufferManager struct {
 	bufferPool   []Buffer
@@ -49,7 +54,10 @@ func (bm *BufferManager) Available() int64 {
 
 // Flushes the dirty buffers modified by the specified transaction
 func (bm *BufferManager) FlushAll(txNum int64) error {
-	for _, buffer := range bm.bufferPool {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	for i := 0; i < len(bm.bufferPool); i++ {
+		buffer := &bm.bufferPool[i]
 		if buffer.ModifyingTx() == txNum {
 			err := buffer.flush()
 			if err != nil {
@@ -60,6 +68,20 @@ func (bm *BufferManager) FlushAll(txNum int64) error {
 	return nil
 }
 
+// Unassigns the unpinned buffers holding blocks of the
+// specified file, discarding their content, so that the file
+// can be deleted without a later flush writing to it.
+func (bm *BufferManager) DropFile(fileName string) {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+	for i := 0; i < len(bm.bufferPool); i++ {
+		buffer := &bm.bufferPool[i]
+		if buffer.blockId.FileName == fileName && !buffer.IsPinned() {
+			buffer.unassign()
+		}
+	}
+}
+
 // Unpins the specified data buffer. If its pin count
 // goes to zero, then notify any waiting threads.
 func (bm *BufferManager) Unpin(buff *Buffer) {
@@ -89,7 +111,7 @@ func (bm *BufferManager) Pin(blockId file.BlockId) (*Buffer, error) {
 	}
 
 	if buff == nil {
-		return nil, fmt.Errorf("buffer request waited for too long")
+		return nil, fmt.Errorf("%w: %v", ErrBufferAbort, blockId)
 	}
 	return buff, nil
 }
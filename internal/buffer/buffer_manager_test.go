@@ -47,7 +47,7 @@ func TestBufferManager(t *testing.T) {
 
 	// Attempting to pin block 3 will not work, no buffer left
 	buffers[5], err = bm.Pin(file.NewBlockId("testfile", 3))
-	assert.NotNil(err)
+	assert.ErrorIs(err, buffer.ErrBufferAbort)
 
 	bm.Unpin(buffers[2])
 	buffers[2] = nil
@@ -1,18 +1,30 @@
 package file
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 )
 
+// The prefix of the names of temporary files,
+// which cannot be the name of a table since
+// identifiers cannot contain a dash.
+const TEMP_FILE_PREFIX = "temp-"
+
+// The error of an access to a file that was deleted,
+// which is not silently created again.
+var ErrFileDeleted = errors.New("file was deleted")
+
 type FileManager struct {
-	directory   string
-	blockSize   int64
-	openedFiles map[string]RandomAccessFile
-	mu          sync.Mutex
-	isNew       bool
+	directory    string
+	blockSize    int64
+	openedFiles  map[string]RandomAccessFile
+	deletedFiles map[string]bool
+	mu           sync.Mutex
+	isNew        bool
 }
 
 func NewFileManager(directory string, block_size int64) (*FileManager, error) {
@@ -54,10 +66,11 @@ func NewFileManager(directory string, block_size int64) (*FileManager, error) {
 	}
 
 	return &FileManager{
-		directory:   directory,
-		blockSize:   block_size,
-		openedFiles: openedFiles,
-		isNew:       isNew,
+		directory:    directory,
+		blockSize:    block_size,
+		openedFiles:  openedFiles,
+		deletedFiles: map[string]bool{},
+		isNew:        isNew,
 	}, nil
 }
 
@@ -124,6 +137,51 @@ func (fm *FileManager) BlockCount(fileName string) (int64, error) {
 	return (size / fm.blockSize), nil
 }
 
+// Close and remove the specified file.
+// The file cannot be accessed afterwards: reading,
+// writing or sizing it returns ErrFileDeleted.
+func (fm *FileManager) Delete(fileName string) error {
+	fm.mu.Lock()
+	defer fm.mu.Unlock()
+
+	if raf, exists := fm.openedFiles[fileName]; exists {
+		raf.Close()
+		delete(fm.openedFiles, fileName)
+	}
+	fm.deletedFiles[fileName] = true
+
+	err := os.Remove(filepath.Join(fm.directory, fileName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Remove the temporary files left over by a crash,
+// which are no longer needed once the database is recovered.
+func (fm *FileManager) DeleteTempFiles() error {
+	dirFile, err := os.Open(fm.directory)
+	if err != nil {
+		return err
+	}
+	defer dirFile.Close()
+	fileNames, err := dirFile.Readdirnames(0)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		if !strings.HasPrefix(fileName, TEMP_FILE_PREFIX) {
+			continue
+		}
+		err = fm.Delete(fileName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (fm *FileManager) BlockSize() int64 {
 	return fm.blockSize
 }
@@ -133,6 +191,9 @@ func (fm *FileManager) getFile(fileName string) (RandomAccessFile, error) {
 	if exists {
 		return raf, nil
 	}
+	if fm.deletedFiles[fileName] {
+		return RandomAccessFile{}, fmt.Errorf("%w: `%s`", ErrFileDeleted, fileName)
+	}
 
 	rafPath := filepath.Join(fm.directory, fileName)
 	raf, err := NewRandomAccessFile(rafPath)
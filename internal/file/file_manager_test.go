@@ -2,6 +2,7 @@ package file_test
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/evanxg852000/simpledb/internal/file"
@@ -44,4 +45,12 @@ func TestFileManager(t *testing.T) {
 	f, err := p2.ReadFloat(pos3)
 	assert.Nil(err)
 	assert.Equal(float64(-3.14), f)
+
+	// A deleted file is not created again by a later access.
+	assert.Nil(fm.Delete("testfile"))
+	assert.NoFileExists(filepath.Join(dbDirectory, "testfile"))
+	assert.ErrorIs(fm.Write(blockId, &p1), file.ErrFileDeleted)
+	_, err = fm.BlockCount("testfile")
+	assert.ErrorIs(err, file.ErrFileDeleted)
+	assert.NoFileExists(filepath.Join(dbDirectory, "testfile"))
 }
@@ -0,0 +1,111 @@
+package btree
+
+import (
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// A B-tree directory block.
+type BTreeDir struct {
+	tx       *recovery.Transaction
+	layout   *record.Layout
+	contents *BTreePage
+	fileName string
+}
+
+// Creates an object to hold the contents of the specified
+// B-tree directory block.
+func NewBTreeDir(tx *recovery.Transaction, blockId file.BlockId, layout *record.Layout) *BTreeDir {
+	return &BTreeDir{
+		tx:       tx,
+		layout:   layout,
+		contents: NewBTreePage(tx, blockId, layout),
+		fileName: blockId.FileName,
+	}
+}
+
+// Closes the directory page.
+func (dir *BTreeDir) Close() {
+	dir.contents.Close()
+}
+
+// Returns the block number of the B-tree leaf block
+// that contains the specified search key.
+func (dir *BTreeDir) Search(searchKey query.Constant) int64 {
+	childBlock := dir.findChildBlock(searchKey)
+	for dir.contents.GetFlag() > 0 {
+		dir.contents.Close()
+		dir.contents = NewBTreePage(dir.tx, childBlock, dir.layout)
+		childBlock = dir.findChildBlock(searchKey)
+	}
+	return childBlock.BlockNum
+}
+
+// Creates a new root block for the B-tree.
+// The new root will have two children:
+// the old root, and the specified block.
+// Since the root must always be in block 0 of the file,
+// the contents of the old root will get transferred to a new block.
+func (dir *BTreeDir) MakeNewRoot(entry *DirEntry) {
+	firstVal := dir.contents.GetDataVal(0)
+	level := dir.contents.GetFlag()
+	newBlock := dir.contents.Split(0, level) //ie, transfer all the records
+	oldRoot := NewDirEntry(firstVal, newBlock.BlockNum)
+	dir.insertEntry(oldRoot)
+	dir.insertEntry(entry)
+	dir.contents.SetFlag(level + 1)
+}
+
+// Inserts a new directory entry into the B-tree block.
+// If the block is at level 0, then the entry is inserted there.
+// Otherwise, the entry is inserted into the appropriate
+// child node, and the return value is examined.
+// A non-nil return value indicates that the child node
+// split, and so the returned entry is inserted into
+// this block.
+// If this block splits, then the method similarly returns
+// the entry information of the new block to its caller;
+// otherwise, the method returns nil.
+func (dir *BTreeDir) Insert(entry *DirEntry) *DirEntry {
+	if dir.contents.GetFlag() == 0 {
+		return dir.insertEntry(entry)
+	}
+	childBlock := dir.findChildBlock(entry.DataVal)
+	child := NewBTreeDir(dir.tx, childBlock, dir.layout)
+	myEntry := child.Insert(entry)
+	child.Close()
+	if myEntry != nil {
+		return dir.insertEntry(myEntry)
+	}
+	return nil
+}
+
+func (dir *BTreeDir) insertEntry(entry *DirEntry) *DirEntry {
+	newSlot := 1 + dir.contents.FindSlotBefore(entry.DataVal)
+	dir.contents.InsertDir(newSlot, entry.DataVal, entry.BlockNum)
+	if !dir.contents.IsFull() {
+		return nil
+	}
+	// else page is full, so split it
+	level := dir.contents.GetFlag()
+	splitPos := dir.contents.GetNumRecs() / 2
+	splitVal := dir.contents.GetDataVal(splitPos)
+	newBlock := dir.contents.Split(splitPos, level)
+	return NewDirEntry(splitVal, newBlock.BlockNum)
+}
+
+// Returns the child block that holds the specified search key.
+// A key below the first entry of the page, which only a null
+// key can be, belongs to the first child.
+func (dir *BTreeDir) findChildBlock(searchKey query.Constant) file.BlockId {
+	slot := max(dir.contents.FindSlotBefore(searchKey), 0)
+	if slot+1 < dir.contents.GetNumRecs() {
+		nextVal := dir.contents.GetDataVal(slot + 1)
+		if nextVal.Equals(searchKey) {
+			slot++
+		}
+	}
+	return file.NewBlockId(dir.fileName, dir.contents.GetChildNum(slot))
+}
@@ -0,0 +1,318 @@
+package btree
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// A B-tree implementation of the Index interface.
+// Leaf records live in the "<index>leaf" file and directory
+// records in the "<index>dir" file, whose block 0 is the root.
+type BTreeIndex struct {
+	tx          *recovery.Transaction
+	dirLayout   *record.Layout
+	leafLayout  *record.Layout
+	leafTblName string
+	leaf        *BTreeLeaf
+	rootBlock   file.BlockId
+}
+
+// Opens a B-tree index for the specified index.
+// The method determines the appropriate files
+// for the leaf and directory records,
+// creating them if they did not exist.
+func NewBTreeIndex(tx *recovery.Transaction, indexName string, leafLayout *record.Layout) *BTreeIndex {
+	// deal with the leaves
+	leafTblName := fmt.Sprintf("%sleaf", indexName)
+	if size, err := tx.Size(leafTblName); err == nil && size == 0 {
+		blockId, err := tx.Append(leafTblName)
+		if err != nil {
+			panic(err)
+		}
+		node := NewBTreePage(tx, blockId, leafLayout)
+		node.Format(blockId, -1)
+		node.Close()
+	}
+
+	// deal with the directory
+	dirSchema := record.NewSchema()
+	dirSchema.Add("block", *leafLayout.Schema)
+	dirSchema.Add("data_val", *leafLayout.Schema)
+	dirTblName := fmt.Sprintf("%sdir", indexName)
+	dirLayout := record.NewLayout(dirSchema)
+	rootBlock := file.NewBlockId(dirTblName, 0)
+	if size, err := tx.Size(dirTblName); err == nil && size == 0 {
+		_, err := tx.Append(dirTblName)
+		if err != nil {
+			panic(err)
+		}
+		node := NewBTreePage(tx, rootBlock, dirLayout)
+		node.Format(rootBlock, 0)
+		// insert initial directory entry
+		node.InsertDir(0, minValue(dirSchema), 0)
+		node.Close()
+	}
+
+	return &BTreeIndex{
+		tx:          tx,
+		dirLayout:   dirLayout,
+		leafLayout:  leafLayout,
+		leafTblName: leafTblName,
+		leaf:        nil,
+		rootBlock:   rootBlock,
+	}
+}
+
+// Traverses the directory to find the leaf block corresponding
+// to the specified search key.
+// The method then opens a page for that leaf block, and
+// positions the page before the first record (if any)
+// having that search key.
+// The leaf page is kept open, for use by the methods next
+// and getDataRid.
+// A null search key equals no value, so that no leaf
+// page is opened, and no record is found.
+func (bi *BTreeIndex) BeforeFirst(searchKey query.Constant) {
+	bi.Close()
+	if searchKey.IsNull() {
+		return
+	}
+	root := NewBTreeDir(bi.tx, bi.rootBlock, bi.dirLayout)
+	blockNum := root.Search(searchKey)
+	root.Close()
+	leafBlock := file.NewBlockId(bi.leafTblName, blockNum)
+	bi.leaf = NewBTreeLeaf(bi.tx, leafBlock, bi.leafLayout, searchKey)
+}
+
+// Moves to the next leaf record having the
+// previously-specified search key.
+// Returns false if there are no more such leaf records.
+func (bi *BTreeIndex) Next() bool {
+	if bi.leaf == nil {
+		return false
+	}
+	return bi.leaf.Next()
+}
+
+// Returns the dataRID value from the current leaf record.
+func (bi *BTreeIndex) GetDataRID() query.RID {
+	return bi.leaf.GetDataRID()
+}
+
+// Inserts the specified record into the index.
+// The method first traverses the directory to find
+// the appropriate leaf page; then it inserts
+// the record into the leaf.
+// If the insertion causes the leaf to split, then
+// the method calls insert on the root,
+// passing it the directory entry of the new leaf page.
+// If the root node splits, then makeNewRoot is called.
+// Null values are not indexed.
+func (bi *BTreeIndex) Insert(dataVal query.Constant, id query.RID) {
+	if dataVal.IsNull() {
+		return
+	}
+	bi.BeforeFirst(dataVal)
+	entry := bi.leaf.Insert(id)
+	bi.Close()
+	if entry == nil {
+		return
+	}
+	root := NewBTreeDir(bi.tx, bi.rootBlock, bi.dirLayout)
+	newEntry := root.Insert(entry)
+	if newEntry != nil {
+		root.MakeNewRoot(newEntry)
+	}
+	root.Close()
+}
+
+// Deletes the specified index record.
+// The method first traverses the directory to find
+// the leaf page containing that record; then it
+// deletes the record from the page.
+func (bi *BTreeIndex) Delete(dataVal query.Constant, id query.RID) {
+	if dataVal.IsNull() {
+		return
+	}
+	bi.BeforeFirst(dataVal)
+	bi.leaf.Delete(id)
+	bi.Close()
+}
+
+// Closes the index by closing its open leaf page,
+// if necessary.
+func (bi *BTreeIndex) Close() {
+	if bi.leaf != nil {
+		bi.leaf.Close()
+		bi.leaf = nil
+	}
+}
+
+// Loads the index records read from the specified scan, whose
+// fields are those of the index records and whose records are
+// sorted by data value, then by RID, into an empty index.
+// The sorted records fill the leaves one after the other,
+// then the directory is built bottom up, which is much
+// faster than inserting the records one at a time.
+// If the index is not empty, the records are simply inserted.
+func (bi *BTreeIndex) BulkLoad(entries query.Scan) {
+	bi.Close()
+	if !bi.isEmpty() {
+		for entries.Next() {
+			bi.Insert(entries.GetValue("data_val"), entryRID(entries))
+		}
+		return
+	}
+
+	dirEntries := bi.loadLeaves(entries)
+	bi.loadDirectory(dirEntries)
+}
+
+// Fills the leaves with the sorted index records and returns
+// the directory entries of the leaves.
+// The records of a same key are never spread over two leaves:
+// when they do not fit in one leaf, they spill into overflow blocks.
+// Only the records of a key that fit in a leaf are read ahead,
+// to find whether they fit in the current leaf.
+func (bi *BTreeIndex) loadLeaves(entries query.Scan) []*DirEntry {
+	capacity := pageCapacity(bi.leafLayout, bi.tx.BlockSize())
+	leaf := NewBTreePage(bi.tx, file.NewBlockId(bi.leafTblName, 0), bi.leafLayout)
+	dirEntries := []*DirEntry{NewDirEntry(minValue(bi.leafLayout.Schema), 0)}
+	overflowed := false
+	insert := func(dataVal query.Constant, rId query.RID) {
+		if leaf.GetNumRecs() == capacity {
+			newBlock := leaf.AppendNew(-1)
+			leaf.SetFlag(newBlock.BlockNum)
+			leaf.Close()
+			leaf = NewBTreePage(bi.tx, newBlock, bi.leafLayout)
+			overflowed = true
+		}
+		leaf.InsertLeaf(leaf.GetNumRecs(), dataVal, rId)
+	}
+
+	hasNext := entries.Next()
+	for hasNext {
+		dataVal := entries.GetValue("data_val")
+		rIds := make([]query.RID, 0)
+		for hasNext && int64(len(rIds)) <= capacity && dataVal.Equals(entries.GetValue("data_val")) {
+			rIds = append(rIds, entryRID(entries))
+			hasNext = entries.Next()
+		}
+
+		numRecs := leaf.GetNumRecs()
+		if numRecs > 0 && (overflowed || numRecs+int64(len(rIds)) > capacity) {
+			newBlock := leaf.AppendNew(-1)
+			leaf.Close()
+			leaf = NewBTreePage(bi.tx, newBlock, bi.leafLayout)
+			dirEntries = append(dirEntries, NewDirEntry(dataVal, newBlock.BlockNum))
+			overflowed = false
+		}
+
+		for _, rId := range rIds {
+			insert(dataVal, rId)
+		}
+		// the remaining records of a key that
+		// does not fit in a leaf are not read ahead
+		for hasNext && dataVal.Equals(entries.GetValue("data_val")) {
+			insert(dataVal, entryRID(entries))
+			hasNext = entries.Next()
+		}
+	}
+	leaf.Close()
+	return dirEntries
+}
+
+// Returns the RID held by the current index record of the scan.
+func entryRID(entries query.Scan) query.RID {
+	return query.NewRID(entries.GetInt("block"), entries.GetInt("id"))
+}
+
+// Builds the directory levels on top of the specified leaf entries,
+// the last level being written into the root block.
+func (bi *BTreeIndex) loadDirectory(entries []*DirEntry) {
+	capacity := pageCapacity(bi.dirLayout, bi.tx.BlockSize())
+	root := NewBTreePage(bi.tx, bi.rootBlock, bi.dirLayout)
+	level := int64(0)
+	for int64(len(entries)) > capacity {
+		parentEntries := make([]*DirEntry, 0)
+		for start := 0; start < len(entries); start += int(capacity) {
+			end := min(start+int(capacity), len(entries))
+			newBlock := root.AppendNew(level)
+			node := NewBTreePage(bi.tx, newBlock, bi.dirLayout)
+			for i, entry := range entries[start:end] {
+				node.InsertDir(int64(i), entry.DataVal, entry.BlockNum)
+			}
+			node.Close()
+			parentEntries = append(parentEntries, NewDirEntry(entries[start].DataVal, newBlock.BlockNum))
+		}
+		entries = parentEntries
+		level++
+	}
+
+	// replace the initial entry of the root
+	root.Delete(0)
+	root.SetFlag(level)
+	for i, entry := range entries {
+		root.InsertDir(int64(i), entry.DataVal, entry.BlockNum)
+	}
+	root.Close()
+}
+
+// Returns true if nothing was ever inserted in the index.
+func (bi *BTreeIndex) isEmpty() bool {
+	leafSize, err := bi.tx.Size(bi.leafTblName)
+	if err != nil {
+		panic(err)
+	}
+	dirSize, err := bi.tx.Size(bi.rootBlock.FileName)
+	if err != nil {
+		panic(err)
+	}
+	if leafSize != 1 || dirSize != 1 {
+		return false
+	}
+
+	leaf := NewBTreePage(bi.tx, file.NewBlockId(bi.leafTblName, 0), bi.leafLayout)
+	defer leaf.Close()
+	root := NewBTreePage(bi.tx, bi.rootBlock, bi.dirLayout)
+	defer root.Close()
+	return leaf.GetNumRecs() == 0 && root.GetFlag() == 0 && root.GetNumRecs() == 1
+}
+
+// Returns the value stored in the first directory entry,
+// which is less than or equal to any search key.
+func minValue(schema *record.Schema) query.Constant {
+	switch schema.FieldType("data_val") {
+	case record.INTEGER_TYPE:
+		return query.NewConstant(int64(math.MinInt64))
+	case record.DOUBLE_TYPE:
+		return query.NewConstant(math.Inf(-1))
+	case record.DATE_TYPE:
+		return query.NewConstant(parser.Date(math.MinInt64))
+	case record.TIMESTAMP_TYPE:
+		return query.NewConstant(parser.Timestamp(math.MinInt64))
+	}
+	// The zero value of strings and booleans is their minimum.
+	return record.ZeroValue(schema.FieldType("data_val"))
+}
+
+// Returns the number of records a page can hold without being full.
+func pageCapacity(layout *record.Layout, blockSize int64) int64 {
+	return (blockSize-2*INT_SIZE-1)/layout.SlotSize() - 1
+}
+
+// Estimates the number of block accesses
+// required to find all index records having
+// a particular search key.
+func SearchCost(numBlocks, recordPerBlock int64) int64 {
+	if numBlocks <= 1 || recordPerBlock <= 1 {
+		return 1
+	}
+	return 1 + int64(math.Log(float64(numBlocks))/math.Log(float64(recordPerBlock)))
+}
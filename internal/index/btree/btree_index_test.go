@@ -0,0 +1,120 @@
+package btree_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/index/btree"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func leafLayout() *record.Layout {
+	schema := record.NewSchema()
+	schema.AddIntField("block")
+	schema.AddIntField("id")
+	schema.AddIntField("data_val")
+	return record.NewLayout(schema)
+}
+
+func lookup(idx *btree.BTreeIndex, key int64) []query.RID {
+	rIds := make([]query.RID, 0)
+	idx.BeforeFirst(query.NewConstant(key))
+	for idx.Next() {
+		rIds = append(rIds, idx.GetDataRID())
+	}
+	return rIds
+}
+
+func TestBTreeIndex(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_btree_index")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	layout := leafLayout()
+
+	// Enough keys to split leaves and directory blocks.
+	tx := db.NewTx()
+	idx := btree.NewBTreeIndex(tx, "t_a_idx", layout)
+	for i := int64(0); i < 500; i++ {
+		idx.Insert(query.NewConstant(i%250), query.NewRID(i, 0))
+	}
+	// A key duplicated enough to spill into overflow blocks.
+	for i := int64(0); i < 40; i++ {
+		idx.Insert(query.NewConstant(int64(1000)), query.NewRID(i, 1))
+	}
+
+	for key := int64(0); key < 250; key++ {
+		assert.ElementsMatch([]query.RID{query.NewRID(key, 0), query.NewRID(key+250, 0)}, lookup(idx, key), fmt.Sprintf("key %d", key))
+	}
+	assert.Equal(40, len(lookup(idx, 1000)))
+	assert.Equal([]query.RID{}, lookup(idx, 300))
+	// A null key equals no key.
+	idx.BeforeFirst(query.NewNullConstant())
+	assert.False(idx.Next())
+
+	idx.Delete(query.NewConstant(int64(7)), query.NewRID(7, 0))
+	assert.Equal([]query.RID{query.NewRID(257, 0)}, lookup(idx, 7))
+	idx.Close()
+	tx.Commit()
+
+	// The index is persisted across transactions.
+	tx = db.NewTx()
+	idx = btree.NewBTreeIndex(tx, "t_a_idx", layout)
+	assert.ElementsMatch([]query.RID{query.NewRID(42, 0), query.NewRID(292, 0)}, lookup(idx, 42))
+	idx.Close()
+	tx.Commit()
+
+	assert.Equal(int64(1), btree.SearchCost(1, 20))
+	assert.Equal(int64(3), btree.SearchCost(1000, 20))
+}
+
+func TestBTreeIndexBulkLoad(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_btree_bulk_load")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	layout := leafLayout()
+
+	// Index records sorted by data value, then by RID, with a key
+	// spilling into overflow blocks and enough leaves to need
+	// two directory levels.
+	records := make([][]query.Constant, 0)
+	for i := int64(0); i < 2000; i++ {
+		if i == 500 {
+			for j := int64(0); j < 25; j++ {
+				records = append(records, []query.Constant{query.NewConstant(j), query.NewConstant(int64(1)), query.NewConstant(i)})
+			}
+		}
+		records = append(records, []query.Constant{query.NewConstant(i), query.NewConstant(int64(0)), query.NewConstant(i)})
+	}
+	entries := query.NewMemoryScan([]string{"block", "id", "data_val"}, records)
+
+	tx := db.NewTx()
+	idx := btree.NewBTreeIndex(tx, "t_a_idx", layout)
+	idx.BulkLoad(entries)
+	for _, key := range []int64{0, 1, 499, 501, 1234, 1999} {
+		assert.Equal([]query.RID{query.NewRID(key, 0)}, lookup(idx, key), fmt.Sprintf("key %d", key))
+	}
+	assert.Equal(26, len(lookup(idx, 500)))
+	assert.Equal([]query.RID{}, lookup(idx, 2000))
+
+	// The loaded tree keeps accepting regular inserts.
+	for i := int64(0); i < 100; i++ {
+		idx.Insert(query.NewConstant(i*20+7), query.NewRID(i, 2))
+	}
+	assert.ElementsMatch([]query.RID{query.NewRID(7, 0), query.NewRID(0, 2)}, lookup(idx, 7))
+	assert.ElementsMatch([]query.RID{query.NewRID(1987, 0), query.NewRID(99, 2)}, lookup(idx, 1987))
+	idx.Close()
+	tx.Commit()
+}
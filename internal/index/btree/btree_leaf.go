@@ -0,0 +1,145 @@
+package btree
+
+import (
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// An object that holds the contents of a B-tree leaf block.
+type BTreeLeaf struct {
+	tx          *recovery.Transaction
+	layout      *record.Layout
+	searchKey   query.Constant
+	contents    *BTreePage
+	currentSlot int64
+	fileName    string
+}
+
+// Opens a buffer to hold the specified leaf block.
+// The buffer is positioned immediately before the first record
+// having the specified search key (if any).
+func NewBTreeLeaf(tx *recovery.Transaction, blockId file.BlockId, layout *record.Layout, searchKey query.Constant) *BTreeLeaf {
+	contents := NewBTreePage(tx, blockId, layout)
+	return &BTreeLeaf{
+		tx:          tx,
+		layout:      layout,
+		searchKey:   searchKey,
+		contents:    contents,
+		currentSlot: contents.FindSlotBefore(searchKey),
+		fileName:    blockId.FileName,
+	}
+}
+
+// Closes the leaf page.
+func (leaf *BTreeLeaf) Close() {
+	leaf.contents.Close()
+}
+
+// Moves to the next leaf record having the
+// previously-specified search key.
+// Returns false if there is no more such records.
+func (leaf *BTreeLeaf) Next() bool {
+	leaf.currentSlot++
+	if leaf.currentSlot >= leaf.contents.GetNumRecs() {
+		return leaf.tryOverflow()
+	}
+	dataVal := leaf.contents.GetDataVal(leaf.currentSlot)
+	if dataVal.Equals(leaf.searchKey) {
+		return true
+	}
+	return leaf.tryOverflow()
+}
+
+// Returns the dataRID value of the current leaf record.
+func (leaf *BTreeLeaf) GetDataRID() query.RID {
+	return leaf.contents.GetDataRID(leaf.currentSlot)
+}
+
+// Deletes the leaf record having the specified dataRID
+func (leaf *BTreeLeaf) Delete(dataRId query.RID) {
+	for leaf.Next() {
+		rId := leaf.GetDataRID()
+		if rId.Equals(dataRId) {
+			leaf.contents.Delete(leaf.currentSlot)
+			return
+		}
+	}
+}
+
+// Inserts a new leaf record having the specified dataRID
+// and the previously-specified search key.
+// If the record does not fit in the page, then
+// the page splits and the method returns the
+// directory entry for the new page;
+// otherwise, the method returns nil.
+// If all of the records in the page have the same dataval,
+// then the block does not split; instead, all but one of the
+// records are placed into an overflow block.
+func (leaf *BTreeLeaf) Insert(dataRId query.RID) *DirEntry {
+	if leaf.contents.GetFlag() >= 0 {
+		firstVal := leaf.contents.GetDataVal(0)
+		if firstVal.CompareTo(leaf.searchKey) > 0 {
+			newBlock := leaf.contents.Split(0, leaf.contents.GetFlag())
+			leaf.currentSlot = 0
+			leaf.contents.SetFlag(-1)
+			leaf.contents.InsertLeaf(leaf.currentSlot, leaf.searchKey, dataRId)
+			return NewDirEntry(firstVal, newBlock.BlockNum)
+		}
+	}
+
+	leaf.currentSlot++
+	leaf.contents.InsertLeaf(leaf.currentSlot, leaf.searchKey, dataRId)
+	if !leaf.contents.IsFull() {
+		return nil
+	}
+
+	// else page is full, so split it
+	firstKey := leaf.contents.GetDataVal(0)
+	lastKey := leaf.contents.GetDataVal(leaf.contents.GetNumRecs() - 1)
+	if lastKey.Equals(firstKey) {
+		// create an overflow block to hold all but the first record
+		newBlock := leaf.contents.Split(1, leaf.contents.GetFlag())
+		leaf.contents.SetFlag(newBlock.BlockNum)
+		return nil
+	}
+
+	splitPos := leaf.contents.GetNumRecs() / 2
+	splitKey := leaf.contents.GetDataVal(splitPos)
+	if splitKey.Equals(firstKey) {
+		// move right, looking for the next key
+		for {
+			dataVal := leaf.contents.GetDataVal(splitPos)
+			if !dataVal.Equals(splitKey) {
+				break
+			}
+			splitPos++
+		}
+		splitKey = leaf.contents.GetDataVal(splitPos)
+	} else {
+		// move left, looking for first entry having that key
+		for {
+			dataVal := leaf.contents.GetDataVal(splitPos - 1)
+			if !dataVal.Equals(splitKey) {
+				break
+			}
+			splitPos--
+		}
+	}
+	newBlock := leaf.contents.Split(splitPos, -1)
+	return NewDirEntry(splitKey, newBlock.BlockNum)
+}
+
+func (leaf *BTreeLeaf) tryOverflow() bool {
+	firstKey := leaf.contents.GetDataVal(0)
+	flag := leaf.contents.GetFlag()
+	if !leaf.searchKey.Equals(firstKey) || flag < 0 {
+		return false
+	}
+	leaf.contents.Close()
+	nextBlock := file.NewBlockId(leaf.fileName, flag)
+	leaf.contents = NewBTreePage(leaf.tx, nextBlock, leaf.layout)
+	leaf.currentSlot = 0
+	return true
+}
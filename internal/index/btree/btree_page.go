@@ -0,0 +1,253 @@
+package btree
+
+import (
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+const (
+	// size in bytes of the flag and record count
+	// stored at the beginning of each page.
+	INT_SIZE int64 = 8
+)
+
+// B-tree directory and leaf pages have many commonalities:
+// in particular, their records are stored in sorted order,
+// and pages split when full.
+// A BTreePage object contains this common functionality.
+type BTreePage struct {
+	tx           *recovery.Transaction
+	currentBlock *file.BlockId
+	layout       *record.Layout
+}
+
+// Open a node for the specified B-tree block.
+func NewBTreePage(tx *recovery.Transaction, currentBlock file.BlockId, layout *record.Layout) *BTreePage {
+	err := tx.Pin(currentBlock)
+	if err != nil {
+		panic(err)
+	}
+	return &BTreePage{tx, &currentBlock, layout}
+}
+
+// Calculate the position where the first record having
+// the specified search key should be, then returns
+// the position before it.
+func (page *BTreePage) FindSlotBefore(searchKey query.Constant) int64 {
+	slot := int64(0)
+	for slot < page.GetNumRecs() {
+		dataVal := page.GetDataVal(slot)
+		if dataVal.CompareTo(searchKey) >= 0 {
+			break
+		}
+		slot++
+	}
+	return slot - 1
+}
+
+// Close the page by unpinning its buffer.
+func (page *BTreePage) Close() {
+	if page.currentBlock != nil {
+		page.tx.Unpin(*page.currentBlock)
+	}
+	page.currentBlock = nil
+}
+
+// Return true if the block is full.
+func (page *BTreePage) IsFull() bool {
+	return page.slotPosition(page.GetNumRecs()+1) >= page.tx.BlockSize()
+}
+
+// Split the page at the specified position.
+// A new page is created, and the records of the page
+// starting at the split position are transferred to the new page.
+func (page *BTreePage) Split(splitPos int64, flag int64) file.BlockId {
+	newBlock := page.AppendNew(flag)
+	newPage := NewBTreePage(page.tx, newBlock, page.layout)
+	page.transferRecords(splitPos, newPage)
+	newPage.SetFlag(flag)
+	newPage.Close()
+	return newBlock
+}
+
+// Return the dataval of the record at the specified slot.
+func (page *BTreePage) GetDataVal(slot int64) query.Constant {
+	return page.getValue(slot, "data_val")
+}
+
+// Return the value of the page's flag field.
+func (page *BTreePage) GetFlag() int64 {
+	value, err := page.tx.GetInt(*page.currentBlock, 0)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Set the page's flag field to the specified value.
+func (page *BTreePage) SetFlag(value int64) {
+	err := page.tx.SetInt(*page.currentBlock, 0, value, true)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Append a new block to the end of the specified B-tree file,
+// having the specified flag value.
+func (page *BTreePage) AppendNew(flag int64) file.BlockId {
+	blockId, err := page.tx.Append(page.currentBlock.FileName)
+	if err != nil {
+		panic(err)
+	}
+	err = page.tx.Pin(blockId)
+	if err != nil {
+		panic(err)
+	}
+	page.Format(blockId, flag)
+	page.tx.Unpin(blockId)
+	return blockId
+}
+
+// Initialize a pinned block as an empty B-tree page
+// having the specified flag value.
+// These values are not logged, because the old
+// values are meaningless.
+func (page *BTreePage) Format(blockId file.BlockId, flag int64) {
+	page.tx.SetInt(blockId, 0, flag, false)
+	page.tx.SetInt(blockId, INT_SIZE, 0, false) // #records = 0
+	slotSize := page.layout.SlotSize()
+	for pos := 2 * INT_SIZE; pos+slotSize <= page.tx.BlockSize(); pos += slotSize {
+		page.makeDefaultRecord(blockId, pos)
+	}
+}
+
+func (page *BTreePage) makeDefaultRecord(blockId file.BlockId, pos int64) {
+	schema := page.layout.Schema
+	for _, fldName := range schema.Fields() {
+		offset := page.layout.Offset(fldName)
+		fldType := schema.FieldType(fldName)
+		record.WriteValue(page.tx, blockId, pos+offset, fldType, record.ZeroValue(fldType), false)
+	}
+}
+
+// Methods called only by BTreeDir
+
+// Return the block number stored in the specified index record.
+func (page *BTreePage) GetChildNum(slot int64) int64 {
+	return page.getInt(slot, "block")
+}
+
+// Insert a directory entry at the specified slot.
+func (page *BTreePage) InsertDir(slot int64, dataVal query.Constant, blockNum int64) {
+	page.insert(slot)
+	page.setValue(slot, "data_val", dataVal)
+	page.setInt(slot, "block", blockNum)
+}
+
+// Methods called only by BTreeLeaf
+
+// Return the dataRID value stored in the specified leaf index record.
+func (page *BTreePage) GetDataRID(slot int64) query.RID {
+	return query.NewRID(page.getInt(slot, "block"), page.getInt(slot, "id"))
+}
+
+// Insert a leaf index record at the specified slot.
+func (page *BTreePage) InsertLeaf(slot int64, dataVal query.Constant, rId query.RID) {
+	page.insert(slot)
+	page.setValue(slot, "data_val", dataVal)
+	page.setInt(slot, "block", rId.BlockNum)
+	page.setInt(slot, "id", rId.Slot)
+}
+
+// Delete the index record at the specified slot.
+func (page *BTreePage) Delete(slot int64) {
+	for i := slot + 1; i < page.GetNumRecs(); i++ {
+		page.copyRecord(i, i-1)
+	}
+	page.setNumRecs(page.GetNumRecs() - 1)
+}
+
+// Return the number of index records in this page.
+func (page *BTreePage) GetNumRecs() int64 {
+	value, err := page.tx.GetInt(*page.currentBlock, INT_SIZE)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Private methods
+
+func (page *BTreePage) getInt(slot int64, fldName string) int64 {
+	value, err := page.tx.GetInt(*page.currentBlock, page.fieldPosition(slot, fldName))
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (page *BTreePage) getValue(slot int64, fldName string) query.Constant {
+	fldType := page.layout.Schema.FieldType(fldName)
+	value, err := record.ReadValue(page.tx, *page.currentBlock, page.fieldPosition(slot, fldName), fldType)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (page *BTreePage) setInt(slot int64, fldName string, value int64) {
+	err := page.tx.SetInt(*page.currentBlock, page.fieldPosition(slot, fldName), value, true)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (page *BTreePage) setValue(slot int64, fldName string, value query.Constant) {
+	fldType := page.layout.Schema.FieldType(fldName)
+	err := record.WriteValue(page.tx, *page.currentBlock, page.fieldPosition(slot, fldName), fldType, value, true)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (page *BTreePage) setNumRecs(n int64) {
+	err := page.tx.SetInt(*page.currentBlock, INT_SIZE, n, true)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (page *BTreePage) insert(slot int64) {
+	for i := page.GetNumRecs(); i > slot; i-- {
+		page.copyRecord(i-1, i)
+	}
+	page.setNumRecs(page.GetNumRecs() + 1)
+}
+
+func (page *BTreePage) copyRecord(from, to int64) {
+	for _, fldName := range page.layout.Schema.Fields() {
+		page.setValue(to, fldName, page.getValue(from, fldName))
+	}
+}
+
+func (page *BTreePage) transferRecords(slot int64, dest *BTreePage) {
+	destSlot := int64(0)
+	for slot < page.GetNumRecs() {
+		dest.insert(destSlot)
+		for _, fldName := range page.layout.Schema.Fields() {
+			dest.setValue(destSlot, fldName, page.getValue(slot, fldName))
+		}
+		page.Delete(slot)
+		destSlot++
+	}
+}
+
+func (page *BTreePage) fieldPosition(slot int64, fldName string) int64 {
+	return page.slotPosition(slot) + page.layout.Offset(fldName)
+}
+
+func (page *BTreePage) slotPosition(slot int64) int64 {
+	return INT_SIZE + INT_SIZE + (slot * page.layout.SlotSize())
+}
@@ -0,0 +1,14 @@
+package btree
+
+import "github.com/evanxg852000/simpledb/internal/query"
+
+// A directory entry has two components: the number of the child block,
+// and the dataval of the first record in that block.
+type DirEntry struct {
+	DataVal  query.Constant
+	BlockNum int64
+}
+
+func NewDirEntry(dataVal query.Constant, blockNum int64) *DirEntry {
+	return &DirEntry{dataVal, blockNum}
+}
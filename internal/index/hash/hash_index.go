@@ -1,6 +1,8 @@
 package hash
 
 import (
+	"fmt"
+
 	"github.com/evanxg852000/simpledb/internal/query"
 	"github.com/evanxg852000/simpledb/internal/record"
 	"github.com/evanxg852000/simpledb/internal/tx/recovery"
@@ -14,39 +16,101 @@ const (
 // A fixed number of buckets is allocated (currently, 100),
 // and each bucket is implemented as a file of index records.
 type HashIndex struct {
+	tx        *recovery.Transaction
+	indexName string
+	layout    *record.Layout
+	searchKey *query.Constant
+	tblScan   *record.TableScan
 }
 
+// Opens a hash index for the specified index.
 func NewHashIndex(tx *recovery.Transaction, indexName string, layout *record.Layout) *HashIndex {
-	//TODO:
-	return nil
+	return &HashIndex{
+		tx:        tx,
+		indexName: indexName,
+		layout:    layout,
+		searchKey: nil,
+		tblScan:   nil,
+	}
 }
 
+// Positions the index before the first index record
+// having the specified search key.
+// The method hashes the search key to determine the bucket,
+// and then opens a table scan on the file
+// corresponding to the bucket.
+// The table scan for the previous bucket (if any) is closed.
 func (hi *HashIndex) BeforeFirst(searchKey query.Constant) {
-
+	hi.Close()
+	hi.searchKey = &searchKey
+	bucket := searchKey.HashCode() % NUM_BUCKETS
+	tblName := fmt.Sprintf("%s%d", hi.indexName, bucket)
+	tblScan, err := record.NewTableScan(hi.tx, tblName, hi.layout)
+	if err != nil {
+		panic(err)
+	}
+	hi.tblScan = tblScan
 }
 
+// Moves to the next record having the search key.
+// The method loops through the table scan for the bucket,
+// looking for a matching record, and returning false
+// if there are no more such records.
 func (hi *HashIndex) Next() bool {
-	//TODO:
+	for hi.tblScan.Next() {
+		dataVal := hi.tblScan.GetValue("data_val")
+		if dataVal.Equals(*hi.searchKey) {
+			return true
+		}
+	}
 	return false
 }
 
+// Retrieves the dataRID from the current record
+// in the table scan for the bucket.
 func (hi *HashIndex) GetDataRID() query.RID {
-	//TODO:
-	return query.NewRID(0, 1)
+	blockNum := hi.tblScan.GetInt("block")
+	id := hi.tblScan.GetInt("id")
+	return query.NewRID(blockNum, id)
 }
 
+// Inserts a new record into the table scan for the bucket.
 func (hi *HashIndex) Insert(dataVal query.Constant, id query.RID) {
-	//TODO:
+	hi.BeforeFirst(dataVal)
+	hi.tblScan.Insert()
+	hi.tblScan.SetInt("block", id.BlockNum)
+	hi.tblScan.SetInt("id", id.Slot)
+	hi.tblScan.SetValue("data_val", dataVal)
 }
 
+// Deletes the specified record from the table scan for
+// the bucket.  The method starts at the beginning of the
+// scan, and loops through the records until the
+// specified record is found.
 func (hi *HashIndex) Delete(dataVal query.Constant, id query.RID) {
-	//TODO:
+	hi.BeforeFirst(dataVal)
+	for hi.Next() {
+		dataRId := hi.GetDataRID()
+		if dataRId.Equals(id) {
+			hi.tblScan.Delete()
+			return
+		}
+	}
 }
 
+// Closes the index by closing the current table scan.
 func (hi *HashIndex) Close() {
-	//TODO:
+	if hi.tblScan != nil {
+		hi.tblScan.Close()
+		hi.tblScan = nil
+	}
 }
 
+// Returns the cost of searching an index file having the
+// specified number of blocks.
+// The method assumes that all buckets are about the
+// same size, and so the cost is simply the size of
+// the bucket.
 func SearchCost(numBlocks, recordPerBlock int64) int64 {
 	return numBlocks / NUM_BUCKETS
 }
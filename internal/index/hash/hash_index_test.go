@@ -0,0 +1,111 @@
+package hash_test
+
+import (
+	"math"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/index/hash"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func indexLayout() *record.Layout {
+	schema := record.NewSchema()
+	schema.AddIntField("block")
+	schema.AddIntField("id")
+	schema.AddStringField("data_val", 10)
+	return record.NewLayout(schema)
+}
+
+func lookup(idx *hash.HashIndex, key string) []query.RID {
+	rIds := make([]query.RID, 0)
+	idx.BeforeFirst(query.NewConstant(key))
+	for idx.Next() {
+		rIds = append(rIds, idx.GetDataRID())
+	}
+	return rIds
+}
+
+func TestHashIndex(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_hash_index")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	layout := indexLayout()
+
+	// Inserting keys, "bob" being a duplicate key.
+	tx := db.NewTx()
+	idx := hash.NewHashIndex(tx, "users_name_idx", layout)
+	idx.Insert(query.NewConstant("alice"), query.NewRID(0, 1))
+	idx.Insert(query.NewConstant("bob"), query.NewRID(0, 2))
+	idx.Insert(query.NewConstant("bob"), query.NewRID(1, 0))
+	assert.Equal([]query.RID{query.NewRID(0, 1)}, lookup(idx, "alice"))
+	assert.Equal([]query.RID{query.NewRID(0, 2), query.NewRID(1, 0)}, lookup(idx, "bob"))
+	assert.Equal([]query.RID{}, lookup(idx, "carol"))
+
+	idx.Delete(query.NewConstant("bob"), query.NewRID(0, 2))
+	assert.Equal([]query.RID{query.NewRID(1, 0)}, lookup(idx, "bob"))
+	idx.Close()
+	tx.Commit()
+
+	// Index changes are undone along with the transaction.
+	tx = db.NewTx()
+	idx = hash.NewHashIndex(tx, "users_name_idx", layout)
+	idx.Insert(query.NewConstant("alice"), query.NewRID(2, 3))
+	idx.Delete(query.NewConstant("bob"), query.NewRID(1, 0))
+	idx.Close()
+	tx.Rollback()
+
+	tx = db.NewTx()
+	idx = hash.NewHashIndex(tx, "users_name_idx", layout)
+	assert.Equal([]query.RID{query.NewRID(0, 1)}, lookup(idx, "alice"))
+	assert.Equal([]query.RID{query.NewRID(1, 0)}, lookup(idx, "bob"))
+	idx.Close()
+	tx.Commit()
+}
+
+func TestHashIndexNegativeKeys(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_hash_index")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	schema := record.NewSchema()
+	schema.AddIntField("block")
+	schema.AddIntField("id")
+	schema.AddIntField("data_val")
+	layout := record.NewLayout(schema)
+
+	tx := db.NewTx()
+	idx := hash.NewHashIndex(tx, "events_cost_idx", layout)
+	keys := []int64{math.MinInt64, -1, math.MaxInt64}
+	for i, key := range keys {
+		idx.Insert(query.NewConstant(key), query.NewRID(0, int64(i)))
+	}
+	for i, key := range keys {
+		rIds := make([]query.RID, 0)
+		idx.BeforeFirst(query.NewConstant(key))
+		for idx.Next() {
+			rIds = append(rIds, idx.GetDataRID())
+		}
+		assert.Equal([]query.RID{query.NewRID(0, int64(i))}, rIds)
+	}
+	idx.Close()
+	tx.Commit()
+
+	// Every key is stored in a bucket with a non-negative number.
+	entries, err := os.ReadDir(dbDir)
+	assert.Nil(err)
+	for _, entry := range entries {
+		assert.NotContains(entry.Name(), "idx-")
+	}
+}
@@ -2,6 +2,13 @@ package index
 
 import "github.com/evanxg852000/simpledb/internal/query"
 
+// The kinds of index an IndexInfo can open.
+const (
+	_ = iota
+	HASH_INDEX
+	BTREE_INDEX
+)
+
 type Index interface {
 	// Positions the index before the first record
 	// having the specified search key.
@@ -26,3 +33,12 @@ type Index interface {
 	// Closes the index.
 	Close()
 }
+
+// Implemented by indexes that can load many index records
+// faster than by inserting them one at a time.
+type BulkLoader interface {
+	// Loads the index records read from the specified scan,
+	// which has the fields of the index records, `data_val`,
+	// `block` and `id`, and is sorted by data value, then by RID.
+	BulkLoad(entries query.Scan)
+}
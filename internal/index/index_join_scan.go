@@ -0,0 +1,121 @@
+package index
+
+import (
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The scan class corresponding to the indexjoin relational
+// algebra operator.
+// The code is very similar to that of ProductScan,
+// which makes sense because an index join is essentially
+// the product of each LHS record with the matching RHS index records.
+type IndexJoinScan struct {
+	left      query.Scan
+	idx       Index
+	joinField string
+	right     *record.TableScan
+	hasLeft   bool
+}
+
+// Creates an index join scan for the specified LHS scan and
+// RHS index.
+func NewIndexJoinScan(left query.Scan, idx Index, joinField string, right *record.TableScan) *IndexJoinScan {
+	scan := &IndexJoinScan{left, idx, joinField, right, false}
+	scan.BeforeFirst()
+	return scan
+}
+
+// Positions the scan before the first record.
+// That is, the LHS scan will be positioned at its
+// first record, and the index will be positioned
+// before the first record for the join value.
+func (ijs *IndexJoinScan) BeforeFirst() {
+	ijs.left.BeforeFirst()
+	ijs.hasLeft = ijs.left.Next()
+	if ijs.hasLeft {
+		ijs.resetIndex()
+	}
+}
+
+// Moves the scan to the next record.
+// The method moves to the next index record, if possible.
+// Otherwise, it moves to the next LHS record and the
+// first index record.
+// If there are no more LHS records, the method returns false.
+// An LHS record whose join value is null matches no record.
+func (ijs *IndexJoinScan) Next() bool {
+	for ijs.hasLeft {
+		if ijs.idx.Next() {
+			ijs.right.MoveToRID(ijs.idx.GetDataRID())
+			return true
+		}
+		ijs.hasLeft = ijs.left.Next()
+		if ijs.hasLeft {
+			ijs.resetIndex()
+		}
+	}
+	return false
+}
+
+// Returns the integer value of the specified field.
+// The value is obtained from whichever scan
+// contains the field.
+func (ijs *IndexJoinScan) GetInt(fieldName string) int64 {
+	if ijs.right.HasField(fieldName) {
+		return ijs.right.GetInt(fieldName)
+	}
+	return ijs.left.GetInt(fieldName)
+}
+
+// Returns the string value of the specified field.
+// The value is obtained from whichever scan
+// contains the field.
+func (ijs *IndexJoinScan) GetString(fieldName string) string {
+	if ijs.right.HasField(fieldName) {
+		return ijs.right.GetString(fieldName)
+	}
+	return ijs.left.GetString(fieldName)
+}
+
+// Returns the value of the specified field.
+// The value is obtained from whichever scan
+// contains the field.
+func (ijs *IndexJoinScan) GetValue(fieldName string) query.Constant {
+	if ijs.right.HasField(fieldName) {
+		return ijs.right.GetValue(fieldName)
+	}
+	return ijs.left.GetValue(fieldName)
+}
+
+func (ijs *IndexJoinScan) IsNull(fieldName string) bool {
+	value := ijs.GetValue(fieldName)
+	return value.IsNull()
+}
+
+// Returns true if the field is in the schema.
+func (ijs *IndexJoinScan) HasField(fieldName string) bool {
+	return ijs.right.HasField(fieldName) || ijs.left.HasField(fieldName)
+}
+
+// Closes the scan by closing its LHS scan and its RHS index.
+func (ijs *IndexJoinScan) Close() {
+	ijs.left.Close()
+	ijs.idx.Close()
+	ijs.right.Close()
+}
+
+// Positions the index before the first record for the join
+// value of the current LHS record, moving past the LHS
+// records whose join value is null.
+func (ijs *IndexJoinScan) resetIndex() {
+	searchKey := ijs.left.GetValue(ijs.joinField)
+	for searchKey.IsNull() {
+		ijs.hasLeft = ijs.left.Next()
+		if !ijs.hasLeft {
+			return
+		}
+		searchKey = ijs.left.GetValue(ijs.joinField)
+	}
+	ijs.idx.BeforeFirst(searchKey)
+}
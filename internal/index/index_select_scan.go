@@ -0,0 +1,71 @@
+package index
+
+import (
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The scan class corresponding to the select relational
+// algebra operator, when the selection is driven by an index.
+// Only the records whose indexed field equals the constant
+// value are read from the table.
+type IndexSelectScan struct {
+	tblScan *record.TableScan
+	idx     Index
+	value   query.Constant
+}
+
+// Creates an index select scan for the specified
+// index and selection constant.
+func NewIndexSelectScan(tblScan *record.TableScan, idx Index, value query.Constant) *IndexSelectScan {
+	scan := &IndexSelectScan{tblScan, idx, value}
+	scan.BeforeFirst()
+	return scan
+}
+
+// Positions the scan before the first record,
+// which in this case means positioning the index
+// before the first instance of the selection constant.
+func (iss *IndexSelectScan) BeforeFirst() {
+	iss.idx.BeforeFirst(iss.value)
+}
+
+// Moves to the next record, which in this case means
+// moving the index to the next record satisfying the
+// selection constant, and returning false if there are
+// no more such index records.
+// If there is a next record, the method moves the
+// table scan to the corresponding data record.
+func (iss *IndexSelectScan) Next() bool {
+	ok := iss.idx.Next()
+	if ok {
+		iss.tblScan.MoveToRID(iss.idx.GetDataRID())
+	}
+	return ok
+}
+
+func (iss *IndexSelectScan) GetInt(fieldName string) int64 {
+	return iss.tblScan.GetInt(fieldName)
+}
+
+func (iss *IndexSelectScan) GetString(fieldName string) string {
+	return iss.tblScan.GetString(fieldName)
+}
+
+func (iss *IndexSelectScan) GetValue(fieldName string) query.Constant {
+	return iss.tblScan.GetValue(fieldName)
+}
+
+func (iss *IndexSelectScan) IsNull(fieldName string) bool {
+	return iss.tblScan.IsNull(fieldName)
+}
+
+func (iss *IndexSelectScan) HasField(fieldName string) bool {
+	return iss.tblScan.HasField(fieldName)
+}
+
+// Closes the scan by closing the index and the table scan.
+func (iss *IndexSelectScan) Close() {
+	iss.idx.Close()
+	iss.tblScan.Close()
+}
@@ -42,10 +42,10 @@ func TestCatalog(t *testing.T) {
 		tblName  string
 		slotSize int64
 	}{
-		{"table_catalog", 56},
-		{"field_catalog", 112},
-		{"view_catalog", 156},
-		{"index_catalog", 128},
+		{"table_catalog", 72},
+		{"field_catalog", 120},
+		{"view_catalog", 172},
+		{"index_catalog", 144},
 	}, rows)
 
 	tblScan.Close()
@@ -70,6 +70,7 @@ func TestCatalog(t *testing.T) {
 			offset  int64
 		}{tblName, fldName, offset})
 	}
-	assert.Equal(12, len(rows2))
+	assert.Equal(14, len(rows2))
 	tblScan.Close()
+	tx.Commit()
 }
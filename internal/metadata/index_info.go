@@ -2,6 +2,7 @@ package metadata
 
 import (
 	"github.com/evanxg852000/simpledb/internal/index"
+	"github.com/evanxg852000/simpledb/internal/index/btree"
 	"github.com/evanxg852000/simpledb/internal/index/hash"
 	"github.com/evanxg852000/simpledb/internal/record"
 	"github.com/evanxg852000/simpledb/internal/tx/recovery"
@@ -10,6 +11,7 @@ import (
 type IndexInfo struct {
 	IndexName string
 	FieldName string
+	IndexType int64
 	tx        *recovery.Transaction
 	schema    *record.Schema
 	layout    *record.Layout
@@ -17,7 +19,7 @@ type IndexInfo struct {
 }
 
 // Create an IndexInfo object for the specified index.
-func NewIndexInfo(indexName string, fieldName string,
+func NewIndexInfo(indexName string, fieldName string, indexType int64,
 	schema *record.Schema, tx *recovery.Transaction, si StatInfo) *IndexInfo {
 	fldType := schema.FieldType(fieldName)
 	fldLength := schema.FieldLength(fieldName)
@@ -26,6 +28,7 @@ func NewIndexInfo(indexName string, fieldName string,
 	return &IndexInfo{
 		indexName,
 		fieldName,
+		indexType,
 		tx,
 		schema,
 		layout,
@@ -35,7 +38,9 @@ func NewIndexInfo(indexName string, fieldName string,
 
 // Open the index described by this object.
 func (ii *IndexInfo) Open() index.Index {
-	// return new BTreeIndex(ii.tx, ii.indexName, ii.layout)
+	if ii.IndexType == index.BTREE_INDEX {
+		return btree.NewBTreeIndex(ii.tx, ii.IndexName, ii.layout)
+	}
 	return hash.NewHashIndex(ii.tx, ii.IndexName, ii.layout)
 }
 
@@ -50,7 +55,9 @@ func (ii *IndexInfo) Open() index.Index {
 func (ii *IndexInfo) BlockAccessed() int64 {
 	recordPerBlock := ii.tx.BlockSize() / ii.layout.SlotSize()
 	numBlocks := ii.statsInfo.RecordsOutput() / recordPerBlock
-	// return btree.SearchCost(numBlocks, recordPerBlock)
+	if ii.IndexType == index.BTREE_INDEX {
+		return btree.SearchCost(numBlocks, recordPerBlock)
+	}
 	return hash.SearchCost(numBlocks, recordPerBlock)
 }
 
@@ -81,10 +88,6 @@ func createIndexLayout(fldType, fldLength int64) *record.Layout {
 	schema := record.NewSchema()
 	schema.AddIntField("block")
 	schema.AddIntField("id")
-	if int(fldType) == record.INTEGER_TYPE {
-		schema.AddIntField("data_val")
-	} else {
-		schema.AddStringField("data_val", fldLength)
-	}
+	schema.AddField("data_val", fldType, fldLength)
 	return record.NewLayout(schema)
 }
@@ -24,7 +24,8 @@ func NewIndexManager(isNew bool, tableManager *TableManager, statsManager *Stats
 		schema.AddStringField("index_name", MAX_NAME_LENGTH)
 		schema.AddStringField("table_name", MAX_NAME_LENGTH)
 		schema.AddStringField("field_name", MAX_NAME_LENGTH)
-		tableManager.CreateTable("index_catalog", schema, tx)
+		schema.AddIntField("index_type")
+		tableManager.CreateTable("index_catalog", schema, record.FIXED_FORMAT, tx)
 	}
 
 	layout, err := tableManager.GetLayout("index_catalog", tx)
@@ -37,8 +38,8 @@ func NewIndexManager(isNew bool, tableManager *TableManager, statsManager *Stats
 
 // Create an index of the specified type for the specified field.
 // A unique ID is assigned to this index, and its information
-// is stored in the idxcat table.
-func (indexManager *IndexManager) CreateIndex(idxName, tblName, fldName string, tx *recovery.Transaction) error {
+// is stored in the index_catalog table.
+func (indexManager *IndexManager) CreateIndex(idxName, tblName, fldName string, idxType int64, tx *recovery.Transaction) error {
 	tableScan, err := record.NewTableScan(tx, "index_catalog", indexManager.layout)
 	if err != nil {
 		return err
@@ -47,6 +48,7 @@ func (indexManager *IndexManager) CreateIndex(idxName, tblName, fldName string,
 	tableScan.SetString("index_name", idxName)
 	tableScan.SetString("table_name", tblName)
 	tableScan.SetString("field_name", fldName)
+	tableScan.SetInt("index_type", idxType)
 	tableScan.Close()
 	return nil
 }
@@ -65,12 +67,29 @@ func (indexManager *IndexManager) GetIndexInfo(tblName string, tx *recovery.Tran
 		if storedTableName == tblName {
 			idxName := tableScan.GetString("index_name")
 			fldName := tableScan.GetString("field_name")
+			idxType := tableScan.GetInt("index_type")
 			tableLayout, _ := indexManager.tableManager.GetLayout(tblName, tx)
 			statsInfo := indexManager.statsManager.GetStatInfo(tblName, tableLayout, tx)
-			idxInfo := NewIndexInfo(idxName, fldName, tableLayout.Schema, tx, statsInfo)
+			idxInfo := NewIndexInfo(idxName, fldName, idxType, tableLayout.Schema, tx, statsInfo)
 			result[fldName] = *idxInfo
 		}
 	}
 	tableScan.Close()
 	return result, nil
 }
+
+// Return true if an index having the specified
+// name is in the index_catalog table.
+func (indexManager *IndexManager) HasIndex(idxName string, tx *recovery.Transaction) (bool, error) {
+	tableScan, err := record.NewTableScan(tx, "index_catalog", indexManager.layout)
+	if err != nil {
+		return false, err
+	}
+	defer tableScan.Close()
+	for tableScan.Next() {
+		if tableScan.GetString("index_name") == idxName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -25,8 +25,8 @@ func NewMetadataManager(isNew bool, tx *recovery.Transaction) *MetadataManager {
 	}
 }
 
-func (mdtManager *MetadataManager) CreateTable(tblName string, schema *record.Schema, tx *recovery.Transaction) error {
-	return mdtManager.tableManager.CreateTable(tblName, schema, tx)
+func (mdtManager *MetadataManager) CreateTable(tblName string, schema *record.Schema, format int64, tx *recovery.Transaction) error {
+	return mdtManager.tableManager.CreateTable(tblName, schema, format, tx)
 }
 
 func (mdtManager *MetadataManager) GetLayout(tblName string, tx *recovery.Transaction) (*record.Layout, error) {
@@ -41,14 +41,18 @@ func (mdtManager *MetadataManager) GetViewDef(viewName string, tx *recovery.Tran
 	return mdtManager.viewManager.GetViewDef(viewName, tx)
 }
 
-func (mdtManager *MetadataManager) CreateIndex(idxName, tblName, fldName string, tx *recovery.Transaction) error {
-	return mdtManager.indexManager.CreateIndex(idxName, tblName, fldName, tx)
+func (mdtManager *MetadataManager) CreateIndex(idxName, tblName, fldName string, idxType int64, tx *recovery.Transaction) error {
+	return mdtManager.indexManager.CreateIndex(idxName, tblName, fldName, idxType, tx)
 }
 
 func (mdtManager *MetadataManager) GetIndexInfo(tblName string, tx *recovery.Transaction) (map[string]IndexInfo, error) {
 	return mdtManager.indexManager.GetIndexInfo(tblName, tx)
 }
 
+func (mdtManager *MetadataManager) HasIndex(idxName string, tx *recovery.Transaction) (bool, error) {
+	return mdtManager.indexManager.HasIndex(idxName, tx)
+}
+
 func (mdtManager *MetadataManager) GetStatInfo(tblName string, layout *record.Layout, tx *recovery.Transaction) StatInfo {
 	return mdtManager.statsManager.GetStatInfo(tblName, layout, tx)
 }
@@ -25,12 +25,12 @@ func TestMetadataManager(t *testing.T) {
 	schema := record.NewSchema()
 	schema.AddIntField("A")
 	schema.AddStringField("B", 9)
-	tblManager.CreateTable("my_table", schema, tx)
+	tblManager.CreateTable("my_table", schema, record.FIXED_FORMAT, tx)
 
 	layout, err := tblManager.GetLayout("my_table", tx)
 	assert.Nil(err)
 
-	assert.Equal(int64(33), layout.SlotSize())
+	assert.Equal(int64(41), layout.SlotSize())
 	assert.Equal(2, len(layout.Schema.Fields()))
 	rows := []struct {
 		n string
@@ -1,6 +1,9 @@
 package metadata
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/evanxg852000/simpledb/internal/record"
 	"github.com/evanxg852000/simpledb/internal/tx/recovery"
 )
@@ -13,6 +16,10 @@ const (
 	FIELD_CATALOG = "field_catalog"
 )
 
+// The error of a reference to a table
+// that is not found in the catalog.
+var ErrTableNotFound = errors.New("table not found")
+
 // The table manager.
 // There are methods to create a table, save the metadata
 // in the catalog, and obtain the metadata of a
@@ -29,6 +36,7 @@ func NewTableManager(isNew bool, tx *recovery.Transaction) *TableManager {
 	tableCatSchema := record.NewSchema()
 	tableCatSchema.AddStringField("table_name", MAX_NAME_LENGTH)
 	tableCatSchema.AddIntField("slot_size")
+	tableCatSchema.AddIntField("block_format")
 	tableCatLayout := record.NewLayout(tableCatSchema)
 
 	fieldCatSchema := record.NewSchema()
@@ -41,15 +49,19 @@ func NewTableManager(isNew bool, tx *recovery.Transaction) *TableManager {
 
 	tableManager := &TableManager{tableCatLayout, fieldCatLayout}
 	if isNew {
-		tableManager.CreateTable(TABLE_CATALOG, tableCatSchema, tx)
-		tableManager.CreateTable(FIELD_CATALOG, fieldCatSchema, tx)
+		tableManager.CreateTable(TABLE_CATALOG, tableCatSchema, record.FIXED_FORMAT, tx)
+		tableManager.CreateTable(FIELD_CATALOG, fieldCatSchema, record.FIXED_FORMAT, tx)
 	}
 	return tableManager
 }
 
-// Create a new table having the specified name and schema
-func (tableManager *TableManager) CreateTable(tblName string, schema *record.Schema, tx *recovery.Transaction) error {
+// Create a new table having the specified name and schema,
+// whose blocks have the specified format.
+func (tableManager *TableManager) CreateTable(tblName string, schema *record.Schema, format int64, tx *recovery.Transaction) error {
 	layout := record.NewLayout(schema)
+	if format == record.SLOTTED_FORMAT {
+		layout = record.NewSlottedLayout(schema)
+	}
 
 	// insert one record into table_catalog
 	tableScan, err := record.NewTableScan(tx, TABLE_CATALOG, tableManager.tableCatLayout)
@@ -59,6 +71,7 @@ func (tableManager *TableManager) CreateTable(tblName string, schema *record.Sch
 	tableScan.Insert()
 	tableScan.SetString("table_name", tblName)
 	tableScan.SetInt("slot_size", layout.SlotSize())
+	tableScan.SetInt("block_format", layout.Format())
 	tableScan.Close()
 
 	// insert one record into field_catalog
@@ -78,8 +91,12 @@ func (tableManager *TableManager) CreateTable(tblName string, schema *record.Sch
 	return nil
 }
 
+// Retrieve the layout of the specified table from the catalog.
+// An error wrapping ErrTableNotFound is returned if the
+// table is not in the catalog.
 func (tableManager *TableManager) GetLayout(tblName string, tx *recovery.Transaction) (*record.Layout, error) {
 	size := int64(-1)
+	format := int64(record.FIXED_FORMAT)
 	tableScan, err := record.NewTableScan(tx, TABLE_CATALOG, tableManager.tableCatLayout)
 	if err != nil {
 		return nil, err
@@ -88,10 +105,14 @@ func (tableManager *TableManager) GetLayout(tblName string, tx *recovery.Transac
 		storedTblName := tableScan.GetString("table_name")
 		if storedTblName == tblName {
 			size = tableScan.GetInt("slot_size")
+			format = tableScan.GetInt("block_format")
 			break
 		}
 	}
 	tableScan.Close()
+	if size < 0 {
+		return nil, fmt.Errorf("%w: `%s`", ErrTableNotFound, tblName)
+	}
 
 	schema := record.NewSchema()
 	offsets := make(map[string]int64)
@@ -112,5 +133,5 @@ func (tableManager *TableManager) GetLayout(tblName string, tx *recovery.Transac
 		}
 	}
 	tableScan.Close()
-	return record.NewLayoutFromMetadata(schema, offsets, size), nil
+	return record.NewLayoutFromMetadata(schema, offsets, size, format), nil
 }
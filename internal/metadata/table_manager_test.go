@@ -7,6 +7,9 @@ import (
 	"path"
 	"testing"
 
+	"github.com/evanxg852000/simpledb/internal/index"
+	"github.com/evanxg852000/simpledb/internal/index/btree"
+	"github.com/evanxg852000/simpledb/internal/index/hash"
 	"github.com/evanxg852000/simpledb/internal/metadata"
 	"github.com/evanxg852000/simpledb/internal/record"
 	"github.com/evanxg852000/simpledb/internal/server"
@@ -27,7 +30,7 @@ func TestTableManager(t *testing.T) {
 	schema := record.NewSchema()
 	schema.AddIntField("A")
 	schema.AddStringField("B", 9)
-	mdtManager.CreateTable("my_table", schema, tx)
+	mdtManager.CreateTable("my_table", schema, record.FIXED_FORMAT, tx)
 
 	// Statistics metadata
 	layout, err := mdtManager.GetLayout("my_table", tx)
@@ -41,7 +44,7 @@ func TestTableManager(t *testing.T) {
 		tblScan.SetString("B", fmt.Sprintf("rec_%d", n))
 	}
 	si := mdtManager.GetStatInfo("my_table", layout, tx)
-	assert.Equal(metadata.NewStatInfo(5, 50), si)
+	assert.Equal(metadata.NewStatInfo(6, 50), si)
 
 	// View metadata
 	viewDef := "select B from MyTable where A = 1"
@@ -50,16 +53,18 @@ func TestTableManager(t *testing.T) {
 	assert.Equal(viewDef, v)
 
 	// Index metadata
-	mdtManager.CreateIndex("idx_a", "my_table", "A", tx)
-	mdtManager.CreateIndex("idx_b", "my_table", "B", tx)
+	mdtManager.CreateIndex("idx_a", "my_table", "A", index.HASH_INDEX, tx)
+	mdtManager.CreateIndex("idx_b", "my_table", "B", index.BTREE_INDEX, tx)
 	idxMap, _ := mdtManager.GetIndexInfo("my_table", tx)
 
 	idxA := idxMap["A"]
 	assert.Equal("idx_a", idxA.IndexName)
 	assert.Equal("A", idxA.FieldName)
+	assert.IsType(&hash.HashIndex{}, idxA.Open())
 
 	idxB := idxMap["B"]
 	assert.Equal("idx_b", idxB.IndexName)
 	assert.Equal("B", idxB.FieldName)
+	assert.IsType(&btree.BTreeIndex{}, idxB.Open())
 	tx.Commit()
 }
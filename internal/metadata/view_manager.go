@@ -6,7 +6,7 @@ import (
 )
 
 const (
-	MAX_VIEW_DEF = 100
+	MAX_VIEW_DEF = 10000
 
 	VIEW_CATALOG = "view_catalog"
 )
@@ -21,7 +21,7 @@ func NewViewManager(isNew bool, tableManager *TableManager, tx *recovery.Transac
 		schema := record.NewSchema()
 		schema.AddStringField("view_name", MAX_NAME_LENGTH)
 		schema.AddStringField("view_def", MAX_VIEW_DEF)
-		tableManager.CreateTable("view_catalog", schema, tx)
+		tableManager.CreateTable("view_catalog", schema, record.FIXED_FORMAT, tx)
 	}
 	return viewManager
 }
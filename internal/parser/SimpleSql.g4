@@ -3,11 +3,11 @@ grammar SimpleSql;
 /* antlr4 4.7.2 */
 
 parse
-    : statementList* EOF    
+    : statementList? EOF
 ;
 
 statementList
-    : statement (SEMI_COLON statement)* 
+    : SEMI_COLON* statement (SEMI_COLON+ statement)* SEMI_COLON*
 ;
 
 statement
@@ -20,17 +20,28 @@ statement
     | create_index_stmt
 ;
 
-create_table_stmt: CREATE_ TABLE_ IDENT '(' field_specs ')' ;
+create_table_stmt: CREATE_ TABLE_ IDENT '(' field_specs ')' (FORMAT_ format=IDENT)? ;
 field_specs: field_spec (COMMA field_spec)* ;
 field_spec: IDENT type_spec ;
-type_spec: INT_ | varchar_spec ;
+type_spec: INT_ | BIGINT_ | BOOLEAN_ | DOUBLE_ | REAL_ | DATE_ | TIMESTAMP_ | BLOB_ | varchar_spec ;
 varchar_spec: VAR_CHAR_ '(' INT_LITERAL ')' ;
 
 insert_stmt: INSERT_ INTO_ IDENT ( '(' ident_list ')' )? VALUES_ '(' constant_list ')' ;
-constant_list: literal (COMMA literal)* ;
-
-select_stmt: SELECT_ (STAR | ident_list) FROM_ ident_list (WHERE_ condition)? ;
+constant_list: constant (COMMA constant)* ;
+constant: MINUS (INT_LITERAL | FLOAT_LITERAL) | literal ;
+
+select_stmt: SELECT_ (STAR | select_list) FROM_ from_list (WHERE_ where=condition)? (GROUP_ BY_ groups=column_list)? (HAVING_ having=condition)? (ORDER_ BY_ order_list)? ;
+select_list: select_expr (COMMA select_expr)* ;
+select_expr: expression (AS_? alias=IDENT)? ;
+from_list: from_item (COMMA from_item)* ;
+from_item: table_ref join_clause* ;
+table_ref: table=IDENT (AS_? alias=IDENT)? ;
+join_clause: CROSS_ JOIN_ table_ref | join_type? JOIN_ table_ref ON_ condition ;
+join_type: INNER_ | (LEFT_ | RIGHT_ | FULL_) OUTER_? ;
+order_list: order_expr (COMMA order_expr)* ;
+order_expr: expression (ASC_ | DESC_)? ;
 ident_list: IDENT (COMMA IDENT)* ;
+column_list: column_ref (COMMA column_ref)* ;
 
 update_stmt: UPDATE_ IDENT SET_ update_expr_list (WHERE_ condition)? ;
 update_expr_list: update_expr (COMMA update_expr)* ;
@@ -40,15 +51,25 @@ delete_stmt: DELETE_ FROM_ IDENT (WHERE_ condition)? ;
 
 create_view_stmt: CREATE_ VIEW_ IDENT AS_ select_stmt ;
 
-create_index_stmt: CREATE_ INDEX_ IDENT ON_ IDENT '(' IDENT ')' ;
+create_index_stmt: CREATE_ INDEX_ IDENT ON_ IDENT '(' IDENT ')' (USING_ method=IDENT)? ;
 
 
-condition: term ( op=(AND_ | OR_) term)?;
-term: left=expression operator=(EQUAL|NOT_EQUAL) right=expression ;
-expression: IDENT | literal ;
-literal: INT_LITERAL | STR_LITERAL ;
+condition: and_condition (OR_ and_condition)* ;
+and_condition: not_condition (AND_ not_condition)* ;
+not_condition: NOT_ not_condition | '(' condition ')' | term ;
+term: left=expression operator=(EQUAL|NOT_EQUAL|LESS|LESS_EQUAL|GREATER|GREATER_EQUAL) right=expression
+    | left=expression IS_ NOT_? NULL_
+;
+expression: mul_expression ((PLUS | MINUS | CONCAT) mul_expression)* ;
+mul_expression: unary_expression ((STAR | SLASH | PERCENT) unary_expression)* ;
+unary_expression: MINUS unary_expression | primary_expression ;
+primary_expression: column_ref | literal | aggregate | '(' expression ')' ;
+aggregate: function=(COUNT_ | SUM_ | MIN_ | MAX_ | AVG_) '(' (STAR | expression) ')' ;
+column_ref: IDENT (DOT IDENT)? ;
+literal: INT_LITERAL | FLOAT_LITERAL | STR_LITERAL | BLOB_LITERAL | TRUE_ | FALSE_ | DATE_ STR_LITERAL | TIMESTAMP_ STR_LITERAL | NULL_ ;
 
-/* keywords */
+/* keywords, which the lexer reads in lowercase (see ParseQuery),
+   so that they are matched whatever their case */
 
 CREATE_: 'create' ;
 INSERT_: 'insert' ;
@@ -62,23 +83,70 @@ INTO_: 'into' ;
 VALUES_: 'values' ;
 TABLE_: 'table' ;
 INDEX_: 'index' ;
+USING_: 'using' ;
+FORMAT_: 'format' ;
 VIEW_: 'view' ;
 AS_: 'as' ;
 ON_: 'on' ;
 INT_: 'int';
+BIGINT_: 'bigint' ;
+BOOLEAN_: 'boolean' ;
+DOUBLE_: 'double' ;
+REAL_: 'real' ;
+DATE_: 'date' ;
+TIMESTAMP_: 'timestamp' ;
+BLOB_: 'blob' ;
+TRUE_: 'true' ;
+FALSE_: 'false' ;
 VAR_CHAR_: 'varchar' ;
 AND_: 'and' ;
 OR_: 'or' ;
+NOT_: 'not' ;
+GROUP_: 'group' ;
+BY_: 'by' ;
+HAVING_: 'having' ;
+ORDER_: 'order' ;
+ASC_: 'asc' ;
+DESC_: 'desc' ;
+COUNT_: 'count' ;
+SUM_: 'sum' ;
+MIN_: 'min' ;
+MAX_: 'max' ;
+AVG_: 'avg' ;
+JOIN_: 'join' ;
+INNER_: 'inner' ;
+LEFT_: 'left' ;
+RIGHT_: 'right' ;
+FULL_: 'full' ;
+OUTER_: 'outer' ;
+CROSS_: 'cross' ;
+IS_: 'is' ;
+NULL_: 'null' ;
 
 STAR: '*' ;
+PLUS: '+' ;
+MINUS: '-' ;
+SLASH: '/' ;
+PERCENT: '%' ;
+CONCAT: '||' ;
 EQUAL: '=' ;
 NOT_EQUAL: '!=' ;
+LESS: '<' ;
+LESS_EQUAL: '<=' ;
+GREATER: '>' ;
+GREATER_EQUAL: '>=' ;
 COMMA: ',';
+DOT: '.' ;
 SEMI_COLON: ';';
 
-IDENT: [a-zA-Z_][a-zA-Z0-9_]* ;
-INT_LITERAL: '0'|[-+]?[1-9][0-9]* ;
+/* a quoted identifier keeps its case, and may be a keyword */
+IDENT: [a-zA-Z_][a-zA-Z0-9_]* | '"' ( ~'"' | '""' )+ '"' ;
+INT_LITERAL: '0'|[1-9][0-9]* ;
+FLOAT_LITERAL: [0-9]+ '.' [0-9]+ ([eE] [+-]? [0-9]+)? ;
 STR_LITERAL: '\'' ( ~'\'' | '\'\'')* '\'' ;
+BLOB_LITERAL: [xX] '\'' ([0-9a-fA-F] [0-9a-fA-F])* '\'' ;
 
 SPACES: [ \t\r\n] -> skip ;
+LINE_COMMENT: '--' ~[\r\n]* -> skip ;
+BLOCK_COMMENT: '/*' .*? '*/' -> skip ;
 
@@ -1,20 +1,108 @@
 package parser
 
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
 // "github.com/evanxg852000/simpledb/internal/record"
 
 const (
 	_ = iota
 	INTEGER_TYPE
 	STRING_TYPE
+	BOOLEAN_TYPE
+	DOUBLE_TYPE
+	DATE_TYPE
+	TIMESTAMP_TYPE
+	BLOB_TYPE
+)
+
+const (
+	DATE_FORMAT      = "2006-01-02"
+	TIMESTAMP_FORMAT = "2006-01-02 15:04:05.999999"
 )
 
+// A calendar date, as the number of days since 1970-01-01.
+type Date int64
+
+// A point in time, as the number of microseconds
+// since 1970-01-01 00:00:00 UTC.
+type Timestamp int64
+
+// Parses a date of the form "yyyy-mm-dd".
+func ParseDate(text string) (Date, error) {
+	value, err := time.Parse(DATE_FORMAT, text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date `%v`", text)
+	}
+	return Date(value.Unix() / (24 * 60 * 60)), nil
+}
+
+// Parses a timestamp of the form "yyyy-mm-dd hh:mm:ss",
+// with an optional fraction of a second, or a date
+// standing for its midnight.
+func ParseTimestamp(text string) (Timestamp, error) {
+	value, err := time.Parse(TIMESTAMP_FORMAT, text)
+	if err != nil {
+		date, err := ParseDate(text)
+		if err != nil {
+			return 0, fmt.Errorf("invalid timestamp `%v`", text)
+		}
+		return date.Timestamp(), nil
+	}
+	return Timestamp(value.UnixMicro()), nil
+}
+
+// Returns the timestamp of the midnight of the date.
+func (d Date) Timestamp() Timestamp {
+	return Timestamp(int64(d) * 24 * 60 * 60 * 1000000)
+}
+
+func (d Date) String() string {
+	return time.Unix(int64(d)*24*60*60, 0).UTC().Format(DATE_FORMAT)
+}
+
+func (t Timestamp) String() string {
+	return time.UnixMicro(int64(t)).UTC().Format(TIMESTAMP_FORMAT)
+}
+
+// A binary large object, whose bytes are held in a string
+// so that it can be compared like any other value.
+type Blob string
+
+// Returns the blob as a literal, in hexadecimal.
+func (b Blob) String() string {
+	return "x'" + hex.EncodeToString([]byte(b)) + "'"
+}
+
+// A literal value, which is an integer, a string, a boolean,
+// a double, a date, a timestamp, a blob, or null when its
+// value is nil.
 type Literal struct {
 	Value any
 }
 
+func (c *Literal) IsNull() bool {
+	return c.Value == nil
+}
+
 func (c *Literal) Type() int64 {
-	if _, ok := c.Value.(string); ok {
+	switch c.Value.(type) {
+	case string:
 		return STRING_TYPE
+	case bool:
+		return BOOLEAN_TYPE
+	case float64:
+		return DOUBLE_TYPE
+	case Date:
+		return DATE_TYPE
+	case Timestamp:
+		return TIMESTAMP_TYPE
+	case Blob:
+		return BLOB_TYPE
 	}
 	return INTEGER_TYPE
 }
@@ -31,20 +119,91 @@ func (c *Literal) AsString() string {
 // 	name string
 // }
 
+// A condition is a boolean tree whose leaves are terms.
+// A leaf has no operator and holds its term; an "and" or
+// "or" node combines all of its children, and a "not" node
+// negates its single child.
+// The zero value is the empty condition, which always holds.
 type Condition struct {
-	Left  Term
-	Op    string
-	Right Term
+	Op       string
+	Term     Term
+	Children []Condition
+}
+
+func NewTermCondition(term Term) Condition {
+	return Condition{Term: term}
+}
+
+func (c *Condition) IsEmpty() bool {
+	return c.Op == "" && c.Term == (Term{})
+}
+
+func (c *Condition) IsTerm() bool {
+	return c.Op == "" && c.Term != (Term{})
+}
+
+// Returns the aggregates mentioned in the terms of the condition.
+func (c *Condition) Aggregates() []AggregateExpr {
+	if c.Op == "" {
+		return append(c.Term.Left.Aggregates(), c.Term.Right.Aggregates()...)
+	}
+	aggregates := make([]AggregateExpr, 0)
+	for _, child := range c.Children {
+		aggregates = append(aggregates, child.Aggregates()...)
+	}
+	return aggregates
 }
 
+// Returns the names of the fields the terms of the condition refer to.
+func (c *Condition) FieldNames() []string {
+	if c.Op == "" {
+		return append(c.Term.Left.FieldNames(), c.Term.Right.FieldNames()...)
+	}
+	fieldNames := make([]string, 0)
+	for _, child := range c.Children {
+		fieldNames = append(fieldNames, child.FieldNames()...)
+	}
+	return fieldNames
+}
+
+// A comparison of two expressions, or a null test of
+// the left expression, whose operator is "is null" or
+// "is not null" and whose right expression is empty.
 type Term struct {
 	Left  Expr
 	Op    string
 	Right Expr
 }
 
+func (t *Term) IsNullTest() bool {
+	return t.Op == "is null" || t.Op == "is not null"
+}
+
 type Expr struct {
-	Value any // FieldName or Literal
+	Value any // FieldName, Literal, UnaryExpr, BinaryExpr or AggregateExpr
+}
+
+// An operator applied to a single expression,
+// such as the arithmetic negation.
+type UnaryExpr struct {
+	Op      string
+	Operand Expr
+}
+
+// An operator applied to two expressions, such as
+// an arithmetic operation or a string concatenation.
+type BinaryExpr struct {
+	Op    string
+	Left  Expr
+	Right Expr
+}
+
+// An aggregation function applied to an expression
+// over the records of a group, such as sum(price).
+// The argument of count(*) is the empty expression.
+type AggregateExpr struct {
+	Fn  string
+	Arg Expr
 }
 
 func (e *Expr) IsFieldName() bool {
@@ -54,6 +213,13 @@ func (e *Expr) IsFieldName() bool {
 	return false
 }
 
+func (e *Expr) IsLiteral() bool {
+	if _, ok := e.Value.(Literal); ok {
+		return true
+	}
+	return false
+}
+
 func (e *Expr) AsFieldExpr() string {
 	return e.Value.(string)
 }
@@ -62,6 +228,108 @@ func (e *Expr) AsLiteralExpr() Literal {
 	return e.Value.(Literal)
 }
 
+// Returns the names of the fields mentioned in the expression,
+// in the order they appear.
+// An aggregate is a field of the grouped records it is
+// computed for, and is named after its text.
+func (e *Expr) FieldNames() []string {
+	switch value := e.Value.(type) {
+	case string:
+		return []string{value}
+	case AggregateExpr:
+		return []string{e.String()}
+	case UnaryExpr:
+		return value.Operand.FieldNames()
+	case BinaryExpr:
+		return append(value.Left.FieldNames(), value.Right.FieldNames()...)
+	}
+	return nil
+}
+
+// Returns the text of the expression, which is the name
+// given to a computed column of a query.
+// Sub-expressions are parenthesised only when the
+// precedence of their operator requires it.
+func (e *Expr) String() string {
+	switch value := e.Value.(type) {
+	case string:
+		return value
+	case Literal:
+		switch literal := value.Value.(type) {
+		case nil:
+			return "null"
+		case string:
+			return "'" + literal + "'"
+		case float64:
+			return strconv.FormatFloat(literal, 'f', -1, 64)
+		case Date:
+			return "date '" + literal.String() + "'"
+		case Timestamp:
+			return "timestamp '" + literal.String() + "'"
+		case Blob:
+			return literal.String()
+		}
+		return fmt.Sprintf("%v", value.Value)
+	case UnaryExpr:
+		operand := value.Operand.String()
+		if _, ok := value.Operand.Value.(BinaryExpr); ok {
+			operand = "(" + operand + ")"
+		}
+		return value.Op + operand
+	case BinaryExpr:
+		precedence := operatorPrecedence(value.Op)
+		left := value.Left.String()
+		if other, ok := value.Left.Value.(BinaryExpr); ok && operatorPrecedence(other.Op) < precedence {
+			left = "(" + left + ")"
+		}
+		right := value.Right.String()
+		if other, ok := value.Right.Value.(BinaryExpr); ok && operatorPrecedence(other.Op) <= precedence {
+			right = "(" + right + ")"
+		}
+		return left + " " + value.Op + " " + right
+	case AggregateExpr:
+		return value.String()
+	}
+	return ""
+}
+
+func (a *AggregateExpr) String() string {
+	if a.Arg.Value == nil {
+		return a.Fn + "(*)"
+	}
+	return a.Fn + "(" + a.Arg.String() + ")"
+}
+
+// Returns the aggregates mentioned in the expression,
+// in the order they appear.
+func (e *Expr) Aggregates() []AggregateExpr {
+	switch value := e.Value.(type) {
+	case UnaryExpr:
+		return value.Operand.Aggregates()
+	case BinaryExpr:
+		return append(value.Left.Aggregates(), value.Right.Aggregates()...)
+	case AggregateExpr:
+		return []AggregateExpr{value}
+	}
+	return nil
+}
+
+func operatorPrecedence(op string) int {
+	switch op {
+	case "*", "/", "%":
+		return 2
+	}
+	return 1
+}
+
+// A statement of an SQL input, which is an InsertStmt,
+// a SelectStmt, an UpdateStmt, a DeleteStmt, a CreateTableStmt,
+// a CreateViewStmt or a CreateIndexStmt.
+type Statement any
+
+// An insert statement, whose Fields are nil if it
+// has no column list and so sets all the columns of
+// the table, in order.
 type InsertStmt struct {
 	Table  string
 	Fields []string
@@ -69,11 +337,72 @@ type InsertStmt struct {
 }
 
 type SelectStmt struct {
-	Fields    []string
-	Tables    []string
+	Fields    []string   // output column names or aliases, or "*"
+	Exprs     []Expr     // expression computing each column
+	Tables    []string   // every table of the from clause
+	From      []FromItem // the tables separated by commas, with their joins
+	Condition Condition
+	GroupBy   []string
+	Having    Condition
+	OrderBy   []OrderExpr
+}
+
+// A table of the from clause, which its columns can be
+// qualified with, by its alias or by its name if it has none.
+type TableRef struct {
+	Table string
+	Alias string
+}
+
+// Returns the name the columns of the table are qualified with.
+func (t *TableRef) Name() string {
+	if t.Alias != "" {
+		return t.Alias
+	}
+	return t.Table
+}
+
+// A table of the from clause, followed by the
+// tables joined to it with join clauses.
+type FromItem struct {
+	Table TableRef
+	Joins []JoinClause
+}
+
+// A join of a table to the tables before it.
+// The kind is one of "inner", "left", "right", "full"
+// or "cross", and a cross join has no condition.
+type JoinClause struct {
+	Kind      string
+	Table     TableRef
 	Condition Condition
 }
 
+// Returns the tables of the item, in order.
+func (f *FromItem) TableRefs() []TableRef {
+	refs := []TableRef{f.Table}
+	for _, join := range f.Joins {
+		refs = append(refs, join.Table)
+	}
+	return refs
+}
+
+// Returns true if the item has an outer join, which cannot be
+// planned as a product of its tables selected on the join conditions.
+func (f *FromItem) HasOuterJoin() bool {
+	for _, join := range f.Joins {
+		if join.Kind != "inner" && join.Kind != "cross" {
+			return true
+		}
+	}
+	return false
+}
+
+type OrderExpr struct {
+	Expr Expr
+	Desc bool
+}
+
 type UpdateExpr struct {
 	Field string
 	Value Expr
@@ -103,6 +432,9 @@ type FieldSpec struct {
 type CreateTableStmt struct {
 	Table  string
 	Fields []FieldSpec
+	// The format of the blocks of the table, such as
+	// "fixed" or "slotted", or empty for the default one.
+	Format string
 }
 
 type CreateViewStmt struct {
@@ -115,4 +447,7 @@ type CreateIndexStmt struct {
 	Name  string
 	Table string
 	Field string
+	// The kind of the index, such as "btree" or "hash",
+	// or empty for the default one.
+	Method string
 }
@@ -0,0 +1,152 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// A syntax error of an SQL input, found at the specified line
+// and column, both starting at 1.
+// The token is the text the error was found at, which is empty
+// at the end of the input, and the expected tokens are the
+// names of the tokens that could have been found there instead,
+// when they are known.
+type SyntaxError struct {
+	Line     int
+	Column   int
+	Token    string
+	Expected []string
+	Msg      string
+}
+
+func (se *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d: %s", se.Line, se.Column, se.Msg)
+}
+
+// The syntax errors of an SQL input, in the order they are found.
+type SyntaxErrors []*SyntaxError
+
+func (se SyntaxErrors) Error() string {
+	messages := make([]string, 0, len(se))
+	for _, err := range se {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (se SyntaxErrors) Unwrap() []error {
+	errs := make([]error, 0, len(se))
+	for _, err := range se {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// Returns the error of an invalid token, found by the AST builder
+// after the input is parsed, such as a date that does not exist.
+func newTokenError(token antlr.Token, format string, args ...any) *SyntaxError {
+	return &SyntaxError{
+		Line:   token.GetLine(),
+		Column: token.GetColumn() + 1,
+		Token:  token.GetText(),
+		Msg:    fmt.Sprintf(format, args...),
+	}
+}
+
+// An error listener of the lexer and the parser that collects
+// their syntax errors, instead of printing them to the console.
+type syntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	errors SyntaxErrors
+}
+
+func newSyntaxErrorListener() *syntaxErrorListener {
+	return &syntaxErrorListener{antlr.NewDefaultErrorListener(), nil}
+}
+
+func (sel *syntaxErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	syntaxError := &SyntaxError{Line: line, Column: column + 1}
+	parser, ok := recognizer.(antlr.Parser)
+	if !ok {
+		// The lexer found a character that starts no token.
+		syntaxError.Token = strings.Trim(strings.TrimPrefix(msg, "token recognition error at: "), "'")
+		syntaxError.Msg = fmt.Sprintf("unexpected character `%s`", syntaxError.Token)
+		sel.errors = append(sel.errors, syntaxError)
+		return
+	}
+
+	// The expected tokens are those of the state of the parser,
+	// unless it looked ahead past the current token to choose
+	// an alternative, and found the error there.
+	token, ok := offendingSymbol.(antlr.Token)
+	if !ok || token.GetTokenIndex() == parser.GetCurrentToken().GetTokenIndex() {
+		syntaxError.Expected = tokenNames(parser, parser.GetExpectedTokens())
+	}
+	unexpected := "end of input"
+	if ok && token.GetTokenType() != antlr.TokenEOF {
+		syntaxError.Token = token.GetText()
+		unexpected = fmt.Sprintf("`%s`", token.GetText())
+	}
+	syntaxError.Msg = "unexpected " + unexpected
+	if len(syntaxError.Expected) > 0 {
+		syntaxError.Msg += ", expected " + strings.Join(syntaxError.Expected, " or ")
+	}
+	sel.errors = append(sel.errors, syntaxError)
+}
+
+// The names of the tokens that are not a keyword or a symbol.
+var tokenDisplayNames = map[string]string{
+	"IDENT":         "identifier",
+	"INT_LITERAL":   "integer",
+	"FLOAT_LITERAL": "number",
+	"STR_LITERAL":   "string",
+	"BLOB_LITERAL":  "blob",
+}
+
+// Returns the names of the tokens of the set: the text of a
+// keyword or a symbol, quoted, or the name of another token.
+// The set is only printable, as "{1, 3..5, <EOF>}".
+func tokenNames(parser antlr.Parser, tokenTypes *antlr.IntervalSet) []string {
+	names := make([]string, 0)
+	literalNames := parser.GetLiteralNames()
+	symbolicNames := parser.GetSymbolicNames()
+	addName := func(tokenType int) {
+		switch {
+		case tokenType == antlr.TokenEOF:
+			names = append(names, "end of input")
+		case tokenType < len(literalNames) && literalNames[tokenType] != "":
+			names = append(names, literalNames[tokenType])
+		case tokenType < len(symbolicNames):
+			name := symbolicNames[tokenType]
+			if displayName, ok := tokenDisplayNames[name]; ok {
+				name = displayName
+			}
+			names = append(names, name)
+		}
+	}
+	if tokenTypes == nil {
+		return names
+	}
+	for _, element := range strings.Split(strings.Trim(tokenTypes.String(), "{}"), ", ") {
+		if element == "<EOF>" {
+			addName(antlr.TokenEOF)
+			continue
+		}
+		first, last, _ := strings.Cut(element, "..")
+		start, err := strconv.Atoi(first)
+		if err != nil {
+			continue
+		}
+		stop := start
+		if last != "" {
+			stop, _ = strconv.Atoi(last)
+		}
+		for tokenType := start; tokenType <= stop; tokenType++ {
+			addName(tokenType)
+		}
+	}
+	return names
+}
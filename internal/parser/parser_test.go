@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/evanxg852000/simpledb/internal/parser"
@@ -8,12 +9,19 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// Parses an input holding a single statement.
+func parseStatement(t *testing.T, input string) parser.Statement {
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(t, err, input)
+	assert.Len(t, stmts, 1, input)
+	return stmts[0]
+}
+
 func TestParseCreateTableStmt(t *testing.T) {
 	assert := assert.New(t)
 	input := "create table foo(a int, b varchar(4), c int)"
-	ast := parser.ParseQuery(input)
-
-	stmts := ast.([]any)
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
 	assert.Equal(len(stmts), 1)
 
 	createStmt := stmts[0].(parser.CreateTableStmt)
@@ -21,15 +29,35 @@ func TestParseCreateTableStmt(t *testing.T) {
 		{"a", parser.TypeSpec{record.INTEGER_TYPE, 0}},
 		{"b", parser.TypeSpec{record.STRING_TYPE, 4}},
 		{"c", parser.TypeSpec{record.INTEGER_TYPE, 0}},
-	}}, createStmt)
+	}, ""}, createStmt)
+
+	input = "create table foo(a int, b varchar(200)) format slotted"
+	createStmt = parseStatement(t, input).(parser.CreateTableStmt)
+	assert.Equal("slotted", createStmt.Format)
+}
+
+func TestParseStatementList(t *testing.T) {
+	assert := assert.New(t)
+	input := "create table foo(a int); insert into foo(a) values (1);; select a from foo;"
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
+	assert.Len(stmts, 3)
+	assert.IsType(parser.CreateTableStmt{}, stmts[0])
+	assert.IsType(parser.InsertStmt{}, stmts[1])
+	assert.IsType(parser.SelectStmt{}, stmts[2])
+
+	for _, input := range []string{"", "  "} {
+		stmts, err := parser.ParseQuery(input)
+		assert.Nil(err)
+		assert.Empty(stmts)
+	}
 }
 
 func TestParseInsertStmt(t *testing.T) {
 	assert := assert.New(t)
 	input := "insert into foo(a, b) values (2, 'evan')"
-	ast := parser.ParseQuery(input)
-
-	stmts := ast.([]any)
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
 	assert.Equal(len(stmts), 1)
 
 	insertStmt := stmts[0].(parser.InsertStmt)
@@ -38,38 +66,45 @@ func TestParseInsertStmt(t *testing.T) {
 		[]string{"a", "b"},
 		[]parser.Literal{{int64(2)}, {"evan"}},
 	}, insertStmt)
+
+	stmts, err = parser.ParseQuery("insert into foo values (3, 'john')")
+	assert.Nil(err)
+	assert.Equal(parser.InsertStmt{
+		"foo",
+		nil,
+		[]parser.Literal{{int64(3)}, {"john"}},
+	}, stmts[0])
 }
 
 func TestParseSelectStmt(t *testing.T) {
 	assert := assert.New(t)
 	input := "select a, b from foo where a=1"
-	ast := parser.ParseQuery(input)
-
-	stmts := ast.([]any)
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
 	assert.Equal(len(stmts), 1)
 
 	selectStmt := stmts[0].(parser.SelectStmt)
 	assert.Equal(parser.SelectStmt{
 		[]string{"a", "b"},
+		[]parser.Expr{{"a"}, {"b"}},
 		[]string{"foo"},
-		parser.Condition{
-			Left: parser.Term{
-				parser.Expr{"a"},
-				"=",
-				parser.Expr{parser.Literal{int64(1)}},
-			},
-			Op:    "",
-			Right: parser.Term{},
-		},
+		[]parser.FromItem{{parser.TableRef{"foo", ""}, nil}},
+		parser.NewTermCondition(parser.Term{
+			parser.Expr{"a"},
+			"=",
+			parser.Expr{parser.Literal{int64(1)}},
+		}),
+		nil,
+		parser.Condition{},
+		nil,
 	}, selectStmt)
 }
 
 func TestParseUpdateStmt(t *testing.T) {
 	assert := assert.New(t)
 	input := "update foo set a=2, b=1 where a=1 or b != 2"
-	ast := parser.ParseQuery(input)
-
-	stmts := ast.([]any)
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
 	assert.Equal(len(stmts), 1)
 
 	updateStmt := stmts[0].(parser.UpdateStmt)
@@ -80,54 +115,324 @@ func TestParseUpdateStmt(t *testing.T) {
 			{"b", parser.Expr{parser.Literal{int64(1)}}},
 		},
 		parser.Condition{
-			Left: parser.Term{
-				parser.Expr{"a"},
-				"=",
-				parser.Expr{parser.Literal{int64(1)}},
-			},
 			Op: "or",
-			Right: parser.Term{
-				parser.Expr{"b"},
-				"!=",
-				parser.Expr{parser.Literal{int64(2)}},
+			Children: []parser.Condition{
+				parser.NewTermCondition(parser.Term{
+					parser.Expr{"a"},
+					"=",
+					parser.Expr{parser.Literal{int64(1)}},
+				}),
+				parser.NewTermCondition(parser.Term{
+					parser.Expr{"b"},
+					"!=",
+					parser.Expr{parser.Literal{int64(2)}},
+				}),
 			},
 		},
 	}, updateStmt)
 }
 
+func TestParseArithmeticExpressions(t *testing.T) {
+	assert := assert.New(t)
+	input := "select price * (qty - 1), -price % 3, name || '_x' from orders where price + 1 > -2"
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
+	assert.Equal(len(stmts), 1)
+
+	selectStmt := stmts[0].(parser.SelectStmt)
+	assert.Equal([]string{"price * (qty - 1)", "-price % 3", "name || '_x'"}, selectStmt.Fields)
+	assert.Equal([]parser.Expr{
+		{parser.BinaryExpr{"*", parser.Expr{"price"}, parser.Expr{parser.BinaryExpr{
+			"-", parser.Expr{"qty"}, parser.Expr{parser.Literal{int64(1)}},
+		}}}},
+		{parser.BinaryExpr{"%", parser.Expr{parser.UnaryExpr{"-", parser.Expr{"price"}}}, parser.Expr{parser.Literal{int64(3)}}}},
+		{parser.BinaryExpr{"||", parser.Expr{"name"}, parser.Expr{parser.Literal{"_x"}}}},
+	}, selectStmt.Exprs)
+	assert.Equal(parser.NewTermCondition(parser.Term{
+		parser.Expr{parser.BinaryExpr{"+", parser.Expr{"price"}, parser.Expr{parser.Literal{int64(1)}}}},
+		">",
+		parser.Expr{parser.Literal{int64(-2)}},
+	}), selectStmt.Condition)
+
+	// Operators of the same precedence associate to the left.
+	input = "update foo set a = a - b - 1, b = (a + b) * 2 - -3"
+	updateStmt := parseStatement(t, input).(parser.UpdateStmt)
+	assert.Equal("a - b - 1", updateStmt.Exprs[0].Value.String())
+	assert.Equal("(a + b) * 2 - -3", updateStmt.Exprs[1].Value.String())
+
+	// A parenthesised expression is not mistaken for a parenthesised condition.
+	input = "delete from foo where (a + 1) * 2 >= b and (a < 3)"
+	deleteStmt := parseStatement(t, input).(parser.DeleteStmt)
+	assert.Equal("and", deleteStmt.Condition.Op)
+	assert.Equal("(a + 1) * 2", deleteStmt.Condition.Children[0].Term.Left.String())
+	assert.Equal("<", deleteStmt.Condition.Children[1].Term.Op)
+
+	input = "insert into foo(a, b) values (-5, 'x')"
+	insertStmt := parseStatement(t, input).(parser.InsertStmt)
+	assert.Equal([]parser.Literal{{int64(-5)}, {"x"}}, insertStmt.Values)
+}
+
+func TestParseGroupByStmt(t *testing.T) {
+	assert := assert.New(t)
+	input := "select dept, count(*), avg(salary * 12) from emp where age > 30 group by dept, city having max(age) < 60"
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
+	assert.Equal(len(stmts), 1)
+
+	selectStmt := stmts[0].(parser.SelectStmt)
+	assert.Equal([]string{"dept", "count(*)", "avg(salary * 12)"}, selectStmt.Fields)
+	assert.Equal(parser.Expr{parser.AggregateExpr{"count", parser.Expr{}}}, selectStmt.Exprs[1])
+	assert.Equal(parser.Term{parser.Expr{"age"}, ">", parser.Expr{parser.Literal{int64(30)}}}, selectStmt.Condition.Term)
+	assert.Equal([]string{"dept", "city"}, selectStmt.GroupBy)
+	assert.Equal(parser.NewTermCondition(parser.Term{
+		parser.Expr{parser.AggregateExpr{"max", parser.Expr{"age"}}},
+		"<",
+		parser.Expr{parser.Literal{int64(60)}},
+	}), selectStmt.Having)
+	assert.Equal([]parser.AggregateExpr{{"max", parser.Expr{"age"}}}, selectStmt.Having.Aggregates())
+
+	// A having clause may come without a where clause.
+	input = "select sum(qty) from orders having sum(qty) > 1"
+	selectStmt = parseStatement(t, input).(parser.SelectStmt)
+	assert.True(selectStmt.Condition.IsEmpty())
+	assert.Nil(selectStmt.GroupBy)
+	assert.Equal(">", selectStmt.Having.Term.Op)
+
+	_, err = parser.ParseQuery("select sum(*) from orders")
+	assert.EqualError(err, "syntax error at line 1, column 8: sum(*) is not supported, only count(*) is")
+}
+
+func TestParseOrderBy(t *testing.T) {
+	assert := assert.New(t)
+	input := "select a, b from foo where a > 1 order by b desc, a * 2, a asc"
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
+	assert.Equal(len(stmts), 1)
+
+	selectStmt := stmts[0].(parser.SelectStmt)
+	assert.Equal([]parser.OrderExpr{
+		{parser.Expr{"b"}, true},
+		{parser.Expr{parser.BinaryExpr{"*", parser.Expr{"a"}, parser.Expr{parser.Literal{int64(2)}}}}, false},
+		{parser.Expr{"a"}, false},
+	}, selectStmt.OrderBy)
+
+	input = "select dept, count(*) from emp group by dept having count(*) > 1 order by count(*) desc"
+	selectStmt = parseStatement(t, input).(parser.SelectStmt)
+	assert.Equal([]parser.OrderExpr{
+		{parser.Expr{parser.AggregateExpr{"count", parser.Expr{}}}, true},
+	}, selectStmt.OrderBy)
+}
+
+func TestParseJoins(t *testing.T) {
+	assert := assert.New(t)
+	input := "select a, c from foo join bar on a = b left outer join baz on b = c cross join qux, quux right join corge on d = e full join grault on e = f"
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
+	assert.Equal(len(stmts), 1)
+
+	equals := func(left, right string) parser.Condition {
+		return parser.NewTermCondition(parser.Term{parser.Expr{left}, "=", parser.Expr{right}})
+	}
+	selectStmt := stmts[0].(parser.SelectStmt)
+	assert.Equal([]string{"foo", "bar", "baz", "qux", "quux", "corge", "grault"}, selectStmt.Tables)
+	assert.Equal([]parser.FromItem{
+		{parser.TableRef{"foo", ""}, []parser.JoinClause{
+			{"inner", parser.TableRef{"bar", ""}, equals("a", "b")},
+			{"left", parser.TableRef{"baz", ""}, equals("b", "c")},
+			{"cross", parser.TableRef{"qux", ""}, parser.Condition{}},
+		}},
+		{parser.TableRef{"quux", ""}, []parser.JoinClause{
+			{"right", parser.TableRef{"corge", ""}, equals("d", "e")},
+			{"full", parser.TableRef{"grault", ""}, equals("e", "f")},
+		}},
+	}, selectStmt.From)
+	assert.True(selectStmt.From[0].HasOuterJoin())
+
+	input = "select a from foo inner join bar on a = b and b > 1"
+	selectStmt = parseStatement(t, input).(parser.SelectStmt)
+	assert.Equal("inner", selectStmt.From[0].Joins[0].Kind)
+	assert.Equal("and", selectStmt.From[0].Joins[0].Condition.Op)
+	assert.False(selectStmt.From[0].HasOuterJoin())
+}
+
+func TestParseNulls(t *testing.T) {
+	assert := assert.New(t)
+	input := "select a from foo where b is null or not c is not null and d = null"
+	selectStmt := parseStatement(t, input).(parser.SelectStmt)
+	assert.Equal(parser.Condition{Op: "or", Children: []parser.Condition{
+		parser.NewTermCondition(parser.Term{parser.Expr{"b"}, "is null", parser.Expr{}}),
+		{Op: "and", Children: []parser.Condition{
+			{Op: "not", Children: []parser.Condition{
+				parser.NewTermCondition(parser.Term{parser.Expr{"c"}, "is not null", parser.Expr{}}),
+			}},
+			parser.NewTermCondition(parser.Term{parser.Expr{"d"}, "=", parser.Expr{parser.Literal{nil}}}),
+		}},
+	}}, selectStmt.Condition)
+
+	input = "insert into foo(a, b) values (null, 'x')"
+	insertStmt := parseStatement(t, input).(parser.InsertStmt)
+	assert.Equal([]parser.Literal{{nil}, {"x"}}, insertStmt.Values)
+}
+
+func TestParseColumnTypes(t *testing.T) {
+	assert := assert.New(t)
+	input := "create table foo(a bigint, b boolean, c double, d real, e date, f timestamp)"
+	createStmt := parseStatement(t, input).(parser.CreateTableStmt)
+	assert.Equal([]parser.FieldSpec{
+		{"a", parser.TypeSpec{record.INTEGER_TYPE, 0}},
+		{"b", parser.TypeSpec{record.BOOLEAN_TYPE, 0}},
+		{"c", parser.TypeSpec{record.DOUBLE_TYPE, 0}},
+		{"d", parser.TypeSpec{record.DOUBLE_TYPE, 0}},
+		{"e", parser.TypeSpec{record.DATE_TYPE, 0}},
+		{"f", parser.TypeSpec{record.TIMESTAMP_TYPE, 0}},
+	}, createStmt.Fields)
+
+	input = "insert into foo(a, b, c, d, e, f) values (-7, true, 2.5, -0.25, date '2024-02-29', timestamp '2024-02-29 10:30:00')"
+	insertStmt := parseStatement(t, input).(parser.InsertStmt)
+	date, err := parser.ParseDate("2024-02-29")
+	assert.Nil(err)
+	timestamp, err := parser.ParseTimestamp("2024-02-29 10:30:00")
+	assert.Nil(err)
+	assert.Equal([]parser.Literal{{int64(-7)}, {true}, {2.5}, {-0.25}, {date}, {timestamp}}, insertStmt.Values)
+	assert.Equal("2024-02-29", date.String())
+	assert.Equal("2024-02-29 10:30:00", timestamp.String())
+	assert.Equal(date.Timestamp()+parser.Timestamp(10.5*60*60*1000000), timestamp)
+
+	input = "select c * 1.5, e from foo where e < date '2025-01-01'"
+	selectStmt := parseStatement(t, input).(parser.SelectStmt)
+	assert.Equal("c * 1.5", selectStmt.Exprs[0].String())
+	assert.Equal("date '2025-01-01'", selectStmt.Condition.Term.Right.String())
+
+	_, err = parser.ParseQuery("insert into foo(e) values (date '2024-02-30')")
+	assert.EqualError(err, "syntax error at line 1, column 33: invalid date `2024-02-30`")
+}
+
+func TestParseBlobs(t *testing.T) {
+	assert := assert.New(t)
+	input := "create table files(name varchar(1000), data blob)"
+	createStmt := parseStatement(t, input).(parser.CreateTableStmt)
+	assert.Equal([]parser.FieldSpec{
+		{"name", parser.TypeSpec{record.STRING_TYPE, 1000}},
+		{"data", parser.TypeSpec{record.BLOB_TYPE, 0}},
+	}, createStmt.Fields)
+
+	input = "insert into files(name, data) values ('a', x'00FFa0')"
+	insertStmt := parseStatement(t, input).(parser.InsertStmt)
+	assert.Equal([]parser.Literal{{"a"}, {parser.Blob("\x00\xff\xa0")}}, insertStmt.Values)
+	assert.Equal("x'00ffa0'", parser.Blob("\x00\xff\xa0").String())
+
+	input = "update files set data = X'' where name = 'a'"
+	updateStmt := parseStatement(t, input).(parser.UpdateStmt)
+	assert.Equal("x''", updateStmt.Exprs[0].Value.String())
+}
+
+func TestParseAliases(t *testing.T) {
+	assert := assert.New(t)
+	input := "select u.name as username, o.id total, u.id from users u, orders as o join users on o.user_id = users.id where u.id = o.user_id group by u.name, o.id order by username"
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
+	assert.Equal(len(stmts), 1)
+
+	selectStmt := stmts[0].(parser.SelectStmt)
+	assert.Equal([]string{"username", "total", "u.id"}, selectStmt.Fields)
+	assert.Equal([]parser.Expr{{"u.name"}, {"o.id"}, {"u.id"}}, selectStmt.Exprs)
+	assert.Equal([]string{"users", "orders", "users"}, selectStmt.Tables)
+	assert.Equal([]parser.FromItem{
+		{parser.TableRef{"users", "u"}, nil},
+		{parser.TableRef{"orders", "o"}, []parser.JoinClause{
+			{"inner", parser.TableRef{"users", ""}, parser.NewTermCondition(parser.Term{parser.Expr{"o.user_id"}, "=", parser.Expr{"users.id"}})},
+		}},
+	}, selectStmt.From)
+	assert.Equal("o", selectStmt.From[1].Table.Name())
+	assert.Equal("users", selectStmt.From[1].Joins[0].Table.Name())
+	assert.Equal(parser.NewTermCondition(parser.Term{parser.Expr{"u.id"}, "=", parser.Expr{"o.user_id"}}), selectStmt.Condition)
+	assert.Equal([]string{"u.name", "o.id"}, selectStmt.GroupBy)
+	assert.Equal([]parser.OrderExpr{{parser.Expr{"username"}, false}}, selectStmt.OrderBy)
+}
+
 func TestParseDeleteStmt(t *testing.T) {
 	assert := assert.New(t)
 	input := "delete from foo where a=23 and f!=100"
-	ast := parser.ParseQuery(input)
-
-	stmts := ast.([]any)
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
 	assert.Equal(len(stmts), 1)
 
 	deleteStmt := stmts[0].(parser.DeleteStmt)
 	assert.Equal(parser.DeleteStmt{
 		"foo",
 		parser.Condition{
-			Left: parser.Term{
-				parser.Expr{"a"},
-				"=",
-				parser.Expr{parser.Literal{int64(23)}},
-			},
 			Op: "and",
-			Right: parser.Term{
-				parser.Expr{"f"},
-				"!=",
-				parser.Expr{parser.Literal{int64(100)}},
+			Children: []parser.Condition{
+				parser.NewTermCondition(parser.Term{
+					parser.Expr{"a"},
+					"=",
+					parser.Expr{parser.Literal{int64(23)}},
+				}),
+				parser.NewTermCondition(parser.Term{
+					parser.Expr{"f"},
+					"!=",
+					parser.Expr{parser.Literal{int64(100)}},
+				}),
 			},
 		},
 	}, deleteStmt)
 }
 
+func TestParseNestedCondition(t *testing.T) {
+	assert := assert.New(t)
+	input := "select a from foo where a >= 1 and not (b < 2 or b > 5) or a <= 0"
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
+	assert.Equal(len(stmts), 1)
+
+	selectStmt := stmts[0].(parser.SelectStmt)
+	assert.Equal(parser.Condition{
+		Op: "or",
+		Children: []parser.Condition{
+			{
+				Op: "and",
+				Children: []parser.Condition{
+					parser.NewTermCondition(parser.Term{
+						parser.Expr{"a"},
+						">=",
+						parser.Expr{parser.Literal{int64(1)}},
+					}),
+					{
+						Op: "not",
+						Children: []parser.Condition{
+							{
+								Op: "or",
+								Children: []parser.Condition{
+									parser.NewTermCondition(parser.Term{
+										parser.Expr{"b"},
+										"<",
+										parser.Expr{parser.Literal{int64(2)}},
+									}),
+									parser.NewTermCondition(parser.Term{
+										parser.Expr{"b"},
+										">",
+										parser.Expr{parser.Literal{int64(5)}},
+									}),
+								},
+							},
+						},
+					},
+				},
+			},
+			parser.NewTermCondition(parser.Term{
+				parser.Expr{"a"},
+				"<=",
+				parser.Expr{parser.Literal{int64(0)}},
+			}),
+		},
+	}, selectStmt.Condition)
+}
+
 func TestParseCreateViewStmt(t *testing.T) {
 	assert := assert.New(t)
 	input := "create view view1 as select * from foo where a=23"
-	ast := parser.ParseQuery(input)
-
-	stmts := ast.([]any)
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
 	assert.Equal(len(stmts), 1)
 
 	createViewStmt := stmts[0].(parser.CreateViewStmt)
@@ -135,29 +440,127 @@ func TestParseCreateViewStmt(t *testing.T) {
 		"view1",
 		parser.SelectStmt{
 			[]string{"*"},
+			nil,
 			[]string{"foo"},
-			parser.Condition{
-				Left: parser.Term{
-					parser.Expr{"a"},
-					"=",
-					parser.Expr{parser.Literal{int64(23)}},
-				},
-				Op:    "",
-				Right: parser.Term{},
-			},
+			[]parser.FromItem{{parser.TableRef{"foo", ""}, nil}},
+			parser.NewTermCondition(parser.Term{
+				parser.Expr{"a"},
+				"=",
+				parser.Expr{parser.Literal{int64(23)}},
+			}),
+			nil,
+			parser.Condition{},
+			nil,
 		},
-		"select*fromfoowherea=23",
+		"select * from foo where a=23",
 	}, createViewStmt)
 }
 
 func TestParseCreateIndexStmt(t *testing.T) {
 	assert := assert.New(t)
 	input := "create index index_b on foo (b)"
-	ast := parser.ParseQuery(input)
-
-	stmts := ast.([]any)
+	stmts, err := parser.ParseQuery(input)
+	assert.Nil(err)
 	assert.Equal(len(stmts), 1)
 
 	createIndexStmt := stmts[0].(parser.CreateIndexStmt)
-	assert.Equal(parser.CreateIndexStmt{"index_b", "foo", "b"}, createIndexStmt)
+	assert.Equal(parser.CreateIndexStmt{"index_b", "foo", "b", ""}, createIndexStmt)
+
+	input = "create index index_b on foo (b) using hash"
+	assert.Equal(parser.CreateIndexStmt{"index_b", "foo", "b", "hash"}, parseStatement(t, input))
+}
+
+func TestParseErrors(t *testing.T) {
+	assert := assert.New(t)
+	for input, expected := range map[string]parser.SyntaxError{
+		"select a from": {
+			Line: 1, Column: 14, Token: "", Expected: []string{"identifier"},
+			Msg: "unexpected end of input, expected identifier",
+		},
+		"select a from foo;\nupdate foo set a = 1 wher a = 2": {
+			Line: 2, Column: 22, Token: "wher", Expected: []string{"end of input"},
+			Msg: "unexpected `wher`, expected end of input",
+		},
+		"insert into foo(a) values (1": {
+			Line: 1, Column: 29, Token: "", Expected: []string{"')'"},
+			Msg: "unexpected end of input, expected ')'",
+		},
+		"create tabel foo(a int)": {
+			Line: 1, Column: 8, Token: "tabel", Expected: nil,
+			Msg: "unexpected `tabel`",
+		},
+		"select a from foo where a = 1 #": {
+			Line: 1, Column: 31, Token: "#", Expected: nil,
+			Msg: "unexpected character `#`",
+		},
+		"select a from foo where a = 99999999999999999999": {
+			Line: 1, Column: 29, Token: "99999999999999999999", Expected: nil,
+			Msg: "integer `99999999999999999999` is out of range",
+		},
+		`select "t.a" from foo`: {
+			Line: 1, Column: 8, Token: `"t.a"`, Expected: nil,
+			Msg: "name `t.a` cannot contain a dot",
+		},
+		`create table "my.table"(a int)`: {
+			Line: 1, Column: 14, Token: `"my.table"`, Expected: nil,
+			Msg: "name `my.table` cannot contain a dot",
+		},
+	} {
+		stmts, err := parser.ParseQuery(input)
+		assert.Nil(stmts, input)
+		var syntaxErrors parser.SyntaxErrors
+		assert.True(errors.As(err, &syntaxErrors), input)
+		assert.Equal(parser.SyntaxErrors{&expected}, syntaxErrors, input)
+	}
+
+	// Every syntax error of the input is reported, as the
+	// parser recovers from each one to look for the next.
+	_, err := parser.ParseQuery("select a frm foo; select from bar")
+	assert.EqualError(err, "syntax error at line 1, column 14: unexpected `foo`, expected 'from'\n"+
+		"syntax error at line 1, column 26: unexpected `from`, expected '(' or 'date' or 'timestamp' or 'true' "+
+		"or 'false' or 'count' or 'sum' or 'min' or 'max' or 'avg' or 'null' or '*' or '-' or identifier "+
+		"or integer or number or string or blob\n"+
+		"syntax error at line 1, column 34: unexpected end of input, expected 'from'")
+	var syntaxError *parser.SyntaxError
+	assert.True(errors.As(err, &syntaxError))
+	assert.Equal("foo", syntaxError.Token)
+}
+
+func TestParseCaseAndQuoting(t *testing.T) {
+	assert := assert.New(t)
+
+	// Keywords and names are case insensitive, and names stand for
+	// their lowercase form, unless they are quoted.
+	input := `SELECT Name, "Order", "a""b" FROM Users U -- the users
+		WHERE U.Id = 1 /* that are
+		named */ And Name != 'it''s'`
+	selectStmt := parseStatement(t, input).(parser.SelectStmt)
+	assert.Equal([]string{"name", "Order", `a"b`}, selectStmt.Fields)
+	assert.Equal([]parser.FromItem{{parser.TableRef{"users", "u"}, nil}}, selectStmt.From)
+	assert.Equal(parser.Condition{Op: "and", Children: []parser.Condition{
+		parser.NewTermCondition(parser.Term{parser.Expr{"u.id"}, "=", parser.Expr{parser.Literal{int64(1)}}}),
+		parser.NewTermCondition(parser.Term{parser.Expr{"name"}, "!=", parser.Expr{parser.Literal{"it's"}}}),
+	}}, selectStmt.Condition)
+
+	input = `Create Table "Select"("From" VarChar(10), Id Int) Format Slotted`
+	assert.Equal(parser.CreateTableStmt{"Select", []parser.FieldSpec{
+		{"From", parser.TypeSpec{record.STRING_TYPE, 10}},
+		{"id", parser.TypeSpec{record.INTEGER_TYPE, 0}},
+	}, "slotted"}, parseStatement(t, input))
+
+	// Only the outer quotes of a string are removed,
+	// and the quotes it holds are unescaped.
+	input = `insert into foo(a, b, c) values ('''quoted''', '', Date '2024-01-31')`
+	insertStmt := parseStatement(t, input).(parser.InsertStmt)
+	date, _ := parser.ParseDate("2024-01-31")
+	assert.Equal([]parser.Literal{{"'quoted'"}, {""}, {date}}, insertStmt.Values)
+
+	// A view keeps the text of its query as written.
+	input = "CREATE VIEW Recent AS SELECT Id FROM Orders -- recent\n"
+	createViewStmt := parseStatement(t, input).(parser.CreateViewStmt)
+	assert.Equal("recent", createViewStmt.Name)
+	assert.Equal("SELECT Id FROM Orders", createViewStmt.QueryStr)
+
+	_, err := parser.ParseQuery("select a from foo /* not closed")
+	assert.EqualError(err, "syntax error at line 1, column 19: unexpected `/`, expected end of input")
 }
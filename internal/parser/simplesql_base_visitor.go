@@ -47,14 +47,58 @@ func (v *BaseSimpleSqlVisitor) VisitConstant_list(ctx *Constant_listContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimpleSqlVisitor) VisitConstant(ctx *ConstantContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimpleSqlVisitor) VisitSelect_stmt(ctx *Select_stmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimpleSqlVisitor) VisitSelect_list(ctx *Select_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitSelect_expr(ctx *Select_exprContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitFrom_list(ctx *From_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitFrom_item(ctx *From_itemContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitTable_ref(ctx *Table_refContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitJoin_clause(ctx *Join_clauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitJoin_type(ctx *Join_typeContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitOrder_list(ctx *Order_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitOrder_expr(ctx *Order_exprContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimpleSqlVisitor) VisitIdent_list(ctx *Ident_listContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimpleSqlVisitor) VisitColumn_list(ctx *Column_listContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimpleSqlVisitor) VisitUpdate_stmt(ctx *Update_stmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -83,6 +127,14 @@ func (v *BaseSimpleSqlVisitor) VisitCondition(ctx *ConditionContext) interface{}
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimpleSqlVisitor) VisitAnd_condition(ctx *And_conditionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitNot_condition(ctx *Not_conditionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimpleSqlVisitor) VisitTerm(ctx *TermContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -91,6 +143,26 @@ func (v *BaseSimpleSqlVisitor) VisitExpression(ctx *ExpressionContext) interface
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSimpleSqlVisitor) VisitMul_expression(ctx *Mul_expressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitUnary_expression(ctx *Unary_expressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitPrimary_expression(ctx *Primary_expressionContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitAggregate(ctx *AggregateContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSimpleSqlVisitor) VisitColumn_ref(ctx *Column_refContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSimpleSqlVisitor) VisitLiteral(ctx *LiteralContext) interface{} {
 	return v.VisitChildren(ctx)
 }
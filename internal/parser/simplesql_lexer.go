@@ -14,100 +14,258 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 32, 217,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 78, 573,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
 	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
 	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9,
-	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 3, 2, 3, 2, 3, 3, 3, 3, 3,
-	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3,
-	5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3,
-	7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3,
-	9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3,
-	11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16,
-	3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3,
-	20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21,
-	3, 21, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3,
-	25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 7, 28, 185, 10, 28, 12, 28,
-	14, 28, 188, 11, 28, 3, 29, 3, 29, 5, 29, 192, 10, 29, 3, 29, 3, 29, 7,
-	29, 196, 10, 29, 12, 29, 14, 29, 199, 11, 29, 5, 29, 201, 10, 29, 3, 30,
-	3, 30, 3, 30, 3, 30, 7, 30, 207, 10, 30, 12, 30, 14, 30, 210, 11, 30, 3,
-	30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 2, 2, 32, 3, 3, 5, 4, 7, 5, 9, 6,
-	11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29,
-	16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47,
-	25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 3, 2, 9, 5,
-	2, 67, 92, 97, 97, 99, 124, 6, 2, 50, 59, 67, 92, 97, 97, 99, 124, 4, 2,
-	45, 45, 47, 47, 3, 2, 51, 59, 3, 2, 50, 59, 3, 2, 41, 41, 5, 2, 11, 12,
-	15, 15, 34, 34, 2, 222, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2,
-	2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3,
-	2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23,
-	3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2,
-	31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2,
-	2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2,
-	2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2,
-	2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3,
-	2, 2, 2, 3, 63, 3, 2, 2, 2, 5, 65, 3, 2, 2, 2, 7, 67, 3, 2, 2, 2, 9, 74,
-	3, 2, 2, 2, 11, 81, 3, 2, 2, 2, 13, 88, 3, 2, 2, 2, 15, 95, 3, 2, 2, 2,
-	17, 102, 3, 2, 2, 2, 19, 107, 3, 2, 2, 2, 21, 111, 3, 2, 2, 2, 23, 117,
-	3, 2, 2, 2, 25, 122, 3, 2, 2, 2, 27, 129, 3, 2, 2, 2, 29, 135, 3, 2, 2,
-	2, 31, 141, 3, 2, 2, 2, 33, 146, 3, 2, 2, 2, 35, 149, 3, 2, 2, 2, 37, 152,
-	3, 2, 2, 2, 39, 156, 3, 2, 2, 2, 41, 164, 3, 2, 2, 2, 43, 168, 3, 2, 2,
-	2, 45, 171, 3, 2, 2, 2, 47, 173, 3, 2, 2, 2, 49, 175, 3, 2, 2, 2, 51, 178,
-	3, 2, 2, 2, 53, 180, 3, 2, 2, 2, 55, 182, 3, 2, 2, 2, 57, 200, 3, 2, 2,
-	2, 59, 202, 3, 2, 2, 2, 61, 213, 3, 2, 2, 2, 63, 64, 7, 42, 2, 2, 64, 4,
-	3, 2, 2, 2, 65, 66, 7, 43, 2, 2, 66, 6, 3, 2, 2, 2, 67, 68, 7, 101, 2,
-	2, 68, 69, 7, 116, 2, 2, 69, 70, 7, 103, 2, 2, 70, 71, 7, 99, 2, 2, 71,
-	72, 7, 118, 2, 2, 72, 73, 7, 103, 2, 2, 73, 8, 3, 2, 2, 2, 74, 75, 7, 107,
-	2, 2, 75, 76, 7, 112, 2, 2, 76, 77, 7, 117, 2, 2, 77, 78, 7, 103, 2, 2,
-	78, 79, 7, 116, 2, 2, 79, 80, 7, 118, 2, 2, 80, 10, 3, 2, 2, 2, 81, 82,
-	7, 117, 2, 2, 82, 83, 7, 103, 2, 2, 83, 84, 7, 110, 2, 2, 84, 85, 7, 103,
-	2, 2, 85, 86, 7, 101, 2, 2, 86, 87, 7, 118, 2, 2, 87, 12, 3, 2, 2, 2, 88,
-	89, 7, 119, 2, 2, 89, 90, 7, 114, 2, 2, 90, 91, 7, 102, 2, 2, 91, 92, 7,
-	99, 2, 2, 92, 93, 7, 118, 2, 2, 93, 94, 7, 103, 2, 2, 94, 14, 3, 2, 2,
-	2, 95, 96, 7, 102, 2, 2, 96, 97, 7, 103, 2, 2, 97, 98, 7, 110, 2, 2, 98,
-	99, 7, 103, 2, 2, 99, 100, 7, 118, 2, 2, 100, 101, 7, 103, 2, 2, 101, 16,
-	3, 2, 2, 2, 102, 103, 7, 104, 2, 2, 103, 104, 7, 116, 2, 2, 104, 105, 7,
-	113, 2, 2, 105, 106, 7, 111, 2, 2, 106, 18, 3, 2, 2, 2, 107, 108, 7, 117,
-	2, 2, 108, 109, 7, 103, 2, 2, 109, 110, 7, 118, 2, 2, 110, 20, 3, 2, 2,
-	2, 111, 112, 7, 121, 2, 2, 112, 113, 7, 106, 2, 2, 113, 114, 7, 103, 2,
-	2, 114, 115, 7, 116, 2, 2, 115, 116, 7, 103, 2, 2, 116, 22, 3, 2, 2, 2,
-	117, 118, 7, 107, 2, 2, 118, 119, 7, 112, 2, 2, 119, 120, 7, 118, 2, 2,
-	120, 121, 7, 113, 2, 2, 121, 24, 3, 2, 2, 2, 122, 123, 7, 120, 2, 2, 123,
-	124, 7, 99, 2, 2, 124, 125, 7, 110, 2, 2, 125, 126, 7, 119, 2, 2, 126,
-	127, 7, 103, 2, 2, 127, 128, 7, 117, 2, 2, 128, 26, 3, 2, 2, 2, 129, 130,
-	7, 118, 2, 2, 130, 131, 7, 99, 2, 2, 131, 132, 7, 100, 2, 2, 132, 133,
-	7, 110, 2, 2, 133, 134, 7, 103, 2, 2, 134, 28, 3, 2, 2, 2, 135, 136, 7,
-	107, 2, 2, 136, 137, 7, 112, 2, 2, 137, 138, 7, 102, 2, 2, 138, 139, 7,
-	103, 2, 2, 139, 140, 7, 122, 2, 2, 140, 30, 3, 2, 2, 2, 141, 142, 7, 120,
-	2, 2, 142, 143, 7, 107, 2, 2, 143, 144, 7, 103, 2, 2, 144, 145, 7, 121,
-	2, 2, 145, 32, 3, 2, 2, 2, 146, 147, 7, 99, 2, 2, 147, 148, 7, 117, 2,
-	2, 148, 34, 3, 2, 2, 2, 149, 150, 7, 113, 2, 2, 150, 151, 7, 112, 2, 2,
-	151, 36, 3, 2, 2, 2, 152, 153, 7, 107, 2, 2, 153, 154, 7, 112, 2, 2, 154,
-	155, 7, 118, 2, 2, 155, 38, 3, 2, 2, 2, 156, 157, 7, 120, 2, 2, 157, 158,
-	7, 99, 2, 2, 158, 159, 7, 116, 2, 2, 159, 160, 7, 101, 2, 2, 160, 161,
-	7, 106, 2, 2, 161, 162, 7, 99, 2, 2, 162, 163, 7, 116, 2, 2, 163, 40, 3,
-	2, 2, 2, 164, 165, 7, 99, 2, 2, 165, 166, 7, 112, 2, 2, 166, 167, 7, 102,
-	2, 2, 167, 42, 3, 2, 2, 2, 168, 169, 7, 113, 2, 2, 169, 170, 7, 116, 2,
-	2, 170, 44, 3, 2, 2, 2, 171, 172, 7, 44, 2, 2, 172, 46, 3, 2, 2, 2, 173,
-	174, 7, 63, 2, 2, 174, 48, 3, 2, 2, 2, 175, 176, 7, 35, 2, 2, 176, 177,
-	7, 63, 2, 2, 177, 50, 3, 2, 2, 2, 178, 179, 7, 46, 2, 2, 179, 52, 3, 2,
-	2, 2, 180, 181, 7, 61, 2, 2, 181, 54, 3, 2, 2, 2, 182, 186, 9, 2, 2, 2,
-	183, 185, 9, 3, 2, 2, 184, 183, 3, 2, 2, 2, 185, 188, 3, 2, 2, 2, 186,
-	184, 3, 2, 2, 2, 186, 187, 3, 2, 2, 2, 187, 56, 3, 2, 2, 2, 188, 186, 3,
-	2, 2, 2, 189, 201, 7, 50, 2, 2, 190, 192, 9, 4, 2, 2, 191, 190, 3, 2, 2,
-	2, 191, 192, 3, 2, 2, 2, 192, 193, 3, 2, 2, 2, 193, 197, 9, 5, 2, 2, 194,
-	196, 9, 6, 2, 2, 195, 194, 3, 2, 2, 2, 196, 199, 3, 2, 2, 2, 197, 195,
-	3, 2, 2, 2, 197, 198, 3, 2, 2, 2, 198, 201, 3, 2, 2, 2, 199, 197, 3, 2,
-	2, 2, 200, 189, 3, 2, 2, 2, 200, 191, 3, 2, 2, 2, 201, 58, 3, 2, 2, 2,
-	202, 208, 7, 41, 2, 2, 203, 207, 10, 7, 2, 2, 204, 205, 7, 41, 2, 2, 205,
-	207, 7, 41, 2, 2, 206, 203, 3, 2, 2, 2, 206, 204, 3, 2, 2, 2, 207, 210,
-	3, 2, 2, 2, 208, 206, 3, 2, 2, 2, 208, 209, 3, 2, 2, 2, 209, 211, 3, 2,
-	2, 2, 210, 208, 3, 2, 2, 2, 211, 212, 7, 41, 2, 2, 212, 60, 3, 2, 2, 2,
-	213, 214, 9, 8, 2, 2, 214, 215, 3, 2, 2, 2, 215, 216, 8, 31, 2, 2, 216,
-	62, 3, 2, 2, 2, 9, 2, 186, 191, 197, 200, 206, 208, 3, 8, 2, 2,
+	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33,
+	4, 34, 9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4,
+	39, 9, 39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44,
+	9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9,
+	49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54,
+	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
+	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
+	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9,
+	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75,
+	4, 76, 9, 76, 4, 77, 9, 77, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3,
+	4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3,
+	6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3,
+	7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3,
+	9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11,
+	3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3,
+	13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15,
+	3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3,
+	17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18,
+	3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3,
+	22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23,
+	3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3,
+	24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26,
+	3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3,
+	28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30,
+	3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3,
+	31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 34,
+	3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3,
+	36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38,
+	3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3,
+	40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 42, 3, 42,
+	3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44, 3,
+	45, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47,
+	3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3,
+	49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51,
+	3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3,
+	52, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55,
+	3, 56, 3, 56, 3, 57, 3, 57, 3, 58, 3, 58, 3, 59, 3, 59, 3, 60, 3, 60, 3,
+	60, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64,
+	3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 68, 3, 68, 3, 69, 3,
+	69, 3, 70, 3, 70, 7, 70, 475, 10, 70, 12, 70, 14, 70, 478, 11, 70, 3, 70,
+	3, 70, 3, 70, 3, 70, 6, 70, 484, 10, 70, 13, 70, 14, 70, 485, 3, 70, 5,
+	70, 489, 10, 70, 3, 71, 3, 71, 3, 71, 7, 71, 494, 10, 71, 12, 71, 14, 71,
+	497, 11, 71, 5, 71, 499, 10, 71, 3, 72, 6, 72, 502, 10, 72, 13, 72, 14,
+	72, 503, 3, 72, 3, 72, 6, 72, 508, 10, 72, 13, 72, 14, 72, 509, 3, 72,
+	3, 72, 5, 72, 514, 10, 72, 3, 72, 6, 72, 517, 10, 72, 13, 72, 14, 72, 518,
+	5, 72, 521, 10, 72, 3, 73, 3, 73, 3, 73, 3, 73, 7, 73, 527, 10, 73, 12,
+	73, 14, 73, 530, 11, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 7, 74,
+	538, 10, 74, 12, 74, 14, 74, 541, 11, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 7, 76, 553, 10, 76, 12, 76, 14,
+	76, 556, 11, 76, 3, 76, 3, 76, 3, 77, 3, 77, 3, 77, 3, 77, 7, 77, 564,
+	10, 77, 12, 77, 14, 77, 567, 11, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77,
+	3, 565, 2, 78, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19,
+	11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37,
+	20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55,
+	29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73,
+	38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91,
+	47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55,
+	109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63,
+	125, 64, 127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71,
+	141, 72, 143, 73, 145, 74, 147, 75, 149, 76, 151, 77, 153, 78, 3, 2, 14,
+	5, 2, 67, 92, 97, 97, 99, 124, 6, 2, 50, 59, 67, 92, 97, 97, 99, 124, 3,
+	2, 36, 36, 3, 2, 51, 59, 3, 2, 50, 59, 4, 2, 71, 71, 103, 103, 4, 2, 45,
+	45, 47, 47, 3, 2, 41, 41, 4, 2, 90, 90, 122, 122, 5, 2, 50, 59, 67, 72,
+	99, 104, 5, 2, 11, 12, 15, 15, 34, 34, 4, 2, 12, 12, 15, 15, 2, 588, 2,
+	3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2,
+	11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2,
+	2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2,
+	2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2,
+	2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3,
+	2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49,
+	3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2,
+	57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2,
+	2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2,
+	2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2,
+	2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3,
+	2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95,
+	3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2,
+	103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2,
+	2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117,
+	3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2,
+	2, 125, 3, 2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3,
+	2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2,
+	139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2, 2, 2, 2, 145, 3, 2,
+	2, 2, 2, 147, 3, 2, 2, 2, 2, 149, 3, 2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 153,
+	3, 2, 2, 2, 3, 155, 3, 2, 2, 2, 5, 157, 3, 2, 2, 2, 7, 159, 3, 2, 2, 2,
+	9, 166, 3, 2, 2, 2, 11, 173, 3, 2, 2, 2, 13, 180, 3, 2, 2, 2, 15, 187,
+	3, 2, 2, 2, 17, 194, 3, 2, 2, 2, 19, 199, 3, 2, 2, 2, 21, 203, 3, 2, 2,
+	2, 23, 209, 3, 2, 2, 2, 25, 214, 3, 2, 2, 2, 27, 221, 3, 2, 2, 2, 29, 227,
+	3, 2, 2, 2, 31, 233, 3, 2, 2, 2, 33, 239, 3, 2, 2, 2, 35, 246, 3, 2, 2,
+	2, 37, 251, 3, 2, 2, 2, 39, 254, 3, 2, 2, 2, 41, 257, 3, 2, 2, 2, 43, 261,
+	3, 2, 2, 2, 45, 268, 3, 2, 2, 2, 47, 276, 3, 2, 2, 2, 49, 283, 3, 2, 2,
+	2, 51, 288, 3, 2, 2, 2, 53, 293, 3, 2, 2, 2, 55, 303, 3, 2, 2, 2, 57, 308,
+	3, 2, 2, 2, 59, 313, 3, 2, 2, 2, 61, 319, 3, 2, 2, 2, 63, 327, 3, 2, 2,
+	2, 65, 331, 3, 2, 2, 2, 67, 334, 3, 2, 2, 2, 69, 338, 3, 2, 2, 2, 71, 344,
+	3, 2, 2, 2, 73, 347, 3, 2, 2, 2, 75, 354, 3, 2, 2, 2, 77, 360, 3, 2, 2,
+	2, 79, 364, 3, 2, 2, 2, 81, 369, 3, 2, 2, 2, 83, 375, 3, 2, 2, 2, 85, 379,
+	3, 2, 2, 2, 87, 383, 3, 2, 2, 2, 89, 387, 3, 2, 2, 2, 91, 391, 3, 2, 2,
+	2, 93, 396, 3, 2, 2, 2, 95, 402, 3, 2, 2, 2, 97, 407, 3, 2, 2, 2, 99, 413,
+	3, 2, 2, 2, 101, 418, 3, 2, 2, 2, 103, 424, 3, 2, 2, 2, 105, 430, 3, 2,
+	2, 2, 107, 433, 3, 2, 2, 2, 109, 438, 3, 2, 2, 2, 111, 440, 3, 2, 2, 2,
+	113, 442, 3, 2, 2, 2, 115, 444, 3, 2, 2, 2, 117, 446, 3, 2, 2, 2, 119,
+	448, 3, 2, 2, 2, 121, 451, 3, 2, 2, 2, 123, 453, 3, 2, 2, 2, 125, 456,
+	3, 2, 2, 2, 127, 458, 3, 2, 2, 2, 129, 461, 3, 2, 2, 2, 131, 463, 3, 2,
+	2, 2, 133, 466, 3, 2, 2, 2, 135, 468, 3, 2, 2, 2, 137, 470, 3, 2, 2, 2,
+	139, 488, 3, 2, 2, 2, 141, 498, 3, 2, 2, 2, 143, 501, 3, 2, 2, 2, 145,
+	522, 3, 2, 2, 2, 147, 533, 3, 2, 2, 2, 149, 544, 3, 2, 2, 2, 151, 548,
+	3, 2, 2, 2, 153, 559, 3, 2, 2, 2, 155, 156, 7, 42, 2, 2, 156, 4, 3, 2,
+	2, 2, 157, 158, 7, 43, 2, 2, 158, 6, 3, 2, 2, 2, 159, 160, 7, 101, 2, 2,
+	160, 161, 7, 116, 2, 2, 161, 162, 7, 103, 2, 2, 162, 163, 7, 99, 2, 2,
+	163, 164, 7, 118, 2, 2, 164, 165, 7, 103, 2, 2, 165, 8, 3, 2, 2, 2, 166,
+	167, 7, 107, 2, 2, 167, 168, 7, 112, 2, 2, 168, 169, 7, 117, 2, 2, 169,
+	170, 7, 103, 2, 2, 170, 171, 7, 116, 2, 2, 171, 172, 7, 118, 2, 2, 172,
+	10, 3, 2, 2, 2, 173, 174, 7, 117, 2, 2, 174, 175, 7, 103, 2, 2, 175, 176,
+	7, 110, 2, 2, 176, 177, 7, 103, 2, 2, 177, 178, 7, 101, 2, 2, 178, 179,
+	7, 118, 2, 2, 179, 12, 3, 2, 2, 2, 180, 181, 7, 119, 2, 2, 181, 182, 7,
+	114, 2, 2, 182, 183, 7, 102, 2, 2, 183, 184, 7, 99, 2, 2, 184, 185, 7,
+	118, 2, 2, 185, 186, 7, 103, 2, 2, 186, 14, 3, 2, 2, 2, 187, 188, 7, 102,
+	2, 2, 188, 189, 7, 103, 2, 2, 189, 190, 7, 110, 2, 2, 190, 191, 7, 103,
+	2, 2, 191, 192, 7, 118, 2, 2, 192, 193, 7, 103, 2, 2, 193, 16, 3, 2, 2,
+	2, 194, 195, 7, 104, 2, 2, 195, 196, 7, 116, 2, 2, 196, 197, 7, 113, 2,
+	2, 197, 198, 7, 111, 2, 2, 198, 18, 3, 2, 2, 2, 199, 200, 7, 117, 2, 2,
+	200, 201, 7, 103, 2, 2, 201, 202, 7, 118, 2, 2, 202, 20, 3, 2, 2, 2, 203,
+	204, 7, 121, 2, 2, 204, 205, 7, 106, 2, 2, 205, 206, 7, 103, 2, 2, 206,
+	207, 7, 116, 2, 2, 207, 208, 7, 103, 2, 2, 208, 22, 3, 2, 2, 2, 209, 210,
+	7, 107, 2, 2, 210, 211, 7, 112, 2, 2, 211, 212, 7, 118, 2, 2, 212, 213,
+	7, 113, 2, 2, 213, 24, 3, 2, 2, 2, 214, 215, 7, 120, 2, 2, 215, 216, 7,
+	99, 2, 2, 216, 217, 7, 110, 2, 2, 217, 218, 7, 119, 2, 2, 218, 219, 7,
+	103, 2, 2, 219, 220, 7, 117, 2, 2, 220, 26, 3, 2, 2, 2, 221, 222, 7, 118,
+	2, 2, 222, 223, 7, 99, 2, 2, 223, 224, 7, 100, 2, 2, 224, 225, 7, 110,
+	2, 2, 225, 226, 7, 103, 2, 2, 226, 28, 3, 2, 2, 2, 227, 228, 7, 107, 2,
+	2, 228, 229, 7, 112, 2, 2, 229, 230, 7, 102, 2, 2, 230, 231, 7, 103, 2,
+	2, 231, 232, 7, 122, 2, 2, 232, 30, 3, 2, 2, 2, 233, 234, 7, 119, 2, 2,
+	234, 235, 7, 117, 2, 2, 235, 236, 7, 107, 2, 2, 236, 237, 7, 112, 2, 2,
+	237, 238, 7, 105, 2, 2, 238, 32, 3, 2, 2, 2, 239, 240, 7, 104, 2, 2, 240,
+	241, 7, 113, 2, 2, 241, 242, 7, 116, 2, 2, 242, 243, 7, 111, 2, 2, 243,
+	244, 7, 99, 2, 2, 244, 245, 7, 118, 2, 2, 245, 34, 3, 2, 2, 2, 246, 247,
+	7, 120, 2, 2, 247, 248, 7, 107, 2, 2, 248, 249, 7, 103, 2, 2, 249, 250,
+	7, 121, 2, 2, 250, 36, 3, 2, 2, 2, 251, 252, 7, 99, 2, 2, 252, 253, 7,
+	117, 2, 2, 253, 38, 3, 2, 2, 2, 254, 255, 7, 113, 2, 2, 255, 256, 7, 112,
+	2, 2, 256, 40, 3, 2, 2, 2, 257, 258, 7, 107, 2, 2, 258, 259, 7, 112, 2,
+	2, 259, 260, 7, 118, 2, 2, 260, 42, 3, 2, 2, 2, 261, 262, 7, 100, 2, 2,
+	262, 263, 7, 107, 2, 2, 263, 264, 7, 105, 2, 2, 264, 265, 7, 107, 2, 2,
+	265, 266, 7, 112, 2, 2, 266, 267, 7, 118, 2, 2, 267, 44, 3, 2, 2, 2, 268,
+	269, 7, 100, 2, 2, 269, 270, 7, 113, 2, 2, 270, 271, 7, 113, 2, 2, 271,
+	272, 7, 110, 2, 2, 272, 273, 7, 103, 2, 2, 273, 274, 7, 99, 2, 2, 274,
+	275, 7, 112, 2, 2, 275, 46, 3, 2, 2, 2, 276, 277, 7, 102, 2, 2, 277, 278,
+	7, 113, 2, 2, 278, 279, 7, 119, 2, 2, 279, 280, 7, 100, 2, 2, 280, 281,
+	7, 110, 2, 2, 281, 282, 7, 103, 2, 2, 282, 48, 3, 2, 2, 2, 283, 284, 7,
+	116, 2, 2, 284, 285, 7, 103, 2, 2, 285, 286, 7, 99, 2, 2, 286, 287, 7,
+	110, 2, 2, 287, 50, 3, 2, 2, 2, 288, 289, 7, 102, 2, 2, 289, 290, 7, 99,
+	2, 2, 290, 291, 7, 118, 2, 2, 291, 292, 7, 103, 2, 2, 292, 52, 3, 2, 2,
+	2, 293, 294, 7, 118, 2, 2, 294, 295, 7, 107, 2, 2, 295, 296, 7, 111, 2,
+	2, 296, 297, 7, 103, 2, 2, 297, 298, 7, 117, 2, 2, 298, 299, 7, 118, 2,
+	2, 299, 300, 7, 99, 2, 2, 300, 301, 7, 111, 2, 2, 301, 302, 7, 114, 2,
+	2, 302, 54, 3, 2, 2, 2, 303, 304, 7, 100, 2, 2, 304, 305, 7, 110, 2, 2,
+	305, 306, 7, 113, 2, 2, 306, 307, 7, 100, 2, 2, 307, 56, 3, 2, 2, 2, 308,
+	309, 7, 118, 2, 2, 309, 310, 7, 116, 2, 2, 310, 311, 7, 119, 2, 2, 311,
+	312, 7, 103, 2, 2, 312, 58, 3, 2, 2, 2, 313, 314, 7, 104, 2, 2, 314, 315,
+	7, 99, 2, 2, 315, 316, 7, 110, 2, 2, 316, 317, 7, 117, 2, 2, 317, 318,
+	7, 103, 2, 2, 318, 60, 3, 2, 2, 2, 319, 320, 7, 120, 2, 2, 320, 321, 7,
+	99, 2, 2, 321, 322, 7, 116, 2, 2, 322, 323, 7, 101, 2, 2, 323, 324, 7,
+	106, 2, 2, 324, 325, 7, 99, 2, 2, 325, 326, 7, 116, 2, 2, 326, 62, 3, 2,
+	2, 2, 327, 328, 7, 99, 2, 2, 328, 329, 7, 112, 2, 2, 329, 330, 7, 102,
+	2, 2, 330, 64, 3, 2, 2, 2, 331, 332, 7, 113, 2, 2, 332, 333, 7, 116, 2,
+	2, 333, 66, 3, 2, 2, 2, 334, 335, 7, 112, 2, 2, 335, 336, 7, 113, 2, 2,
+	336, 337, 7, 118, 2, 2, 337, 68, 3, 2, 2, 2, 338, 339, 7, 105, 2, 2, 339,
+	340, 7, 116, 2, 2, 340, 341, 7, 113, 2, 2, 341, 342, 7, 119, 2, 2, 342,
+	343, 7, 114, 2, 2, 343, 70, 3, 2, 2, 2, 344, 345, 7, 100, 2, 2, 345, 346,
+	7, 123, 2, 2, 346, 72, 3, 2, 2, 2, 347, 348, 7, 106, 2, 2, 348, 349, 7,
+	99, 2, 2, 349, 350, 7, 120, 2, 2, 350, 351, 7, 107, 2, 2, 351, 352, 7,
+	112, 2, 2, 352, 353, 7, 105, 2, 2, 353, 74, 3, 2, 2, 2, 354, 355, 7, 113,
+	2, 2, 355, 356, 7, 116, 2, 2, 356, 357, 7, 102, 2, 2, 357, 358, 7, 103,
+	2, 2, 358, 359, 7, 116, 2, 2, 359, 76, 3, 2, 2, 2, 360, 361, 7, 99, 2,
+	2, 361, 362, 7, 117, 2, 2, 362, 363, 7, 101, 2, 2, 363, 78, 3, 2, 2, 2,
+	364, 365, 7, 102, 2, 2, 365, 366, 7, 103, 2, 2, 366, 367, 7, 117, 2, 2,
+	367, 368, 7, 101, 2, 2, 368, 80, 3, 2, 2, 2, 369, 370, 7, 101, 2, 2, 370,
+	371, 7, 113, 2, 2, 371, 372, 7, 119, 2, 2, 372, 373, 7, 112, 2, 2, 373,
+	374, 7, 118, 2, 2, 374, 82, 3, 2, 2, 2, 375, 376, 7, 117, 2, 2, 376, 377,
+	7, 119, 2, 2, 377, 378, 7, 111, 2, 2, 378, 84, 3, 2, 2, 2, 379, 380, 7,
+	111, 2, 2, 380, 381, 7, 107, 2, 2, 381, 382, 7, 112, 2, 2, 382, 86, 3,
+	2, 2, 2, 383, 384, 7, 111, 2, 2, 384, 385, 7, 99, 2, 2, 385, 386, 7, 122,
+	2, 2, 386, 88, 3, 2, 2, 2, 387, 388, 7, 99, 2, 2, 388, 389, 7, 120, 2,
+	2, 389, 390, 7, 105, 2, 2, 390, 90, 3, 2, 2, 2, 391, 392, 7, 108, 2, 2,
+	392, 393, 7, 113, 2, 2, 393, 394, 7, 107, 2, 2, 394, 395, 7, 112, 2, 2,
+	395, 92, 3, 2, 2, 2, 396, 397, 7, 107, 2, 2, 397, 398, 7, 112, 2, 2, 398,
+	399, 7, 112, 2, 2, 399, 400, 7, 103, 2, 2, 400, 401, 7, 116, 2, 2, 401,
+	94, 3, 2, 2, 2, 402, 403, 7, 110, 2, 2, 403, 404, 7, 103, 2, 2, 404, 405,
+	7, 104, 2, 2, 405, 406, 7, 118, 2, 2, 406, 96, 3, 2, 2, 2, 407, 408, 7,
+	116, 2, 2, 408, 409, 7, 107, 2, 2, 409, 410, 7, 105, 2, 2, 410, 411, 7,
+	106, 2, 2, 411, 412, 7, 118, 2, 2, 412, 98, 3, 2, 2, 2, 413, 414, 7, 104,
+	2, 2, 414, 415, 7, 119, 2, 2, 415, 416, 7, 110, 2, 2, 416, 417, 7, 110,
+	2, 2, 417, 100, 3, 2, 2, 2, 418, 419, 7, 113, 2, 2, 419, 420, 7, 119, 2,
+	2, 420, 421, 7, 118, 2, 2, 421, 422, 7, 103, 2, 2, 422, 423, 7, 116, 2,
+	2, 423, 102, 3, 2, 2, 2, 424, 425, 7, 101, 2, 2, 425, 426, 7, 116, 2, 2,
+	426, 427, 7, 113, 2, 2, 427, 428, 7, 117, 2, 2, 428, 429, 7, 117, 2, 2,
+	429, 104, 3, 2, 2, 2, 430, 431, 7, 107, 2, 2, 431, 432, 7, 117, 2, 2, 432,
+	106, 3, 2, 2, 2, 433, 434, 7, 112, 2, 2, 434, 435, 7, 119, 2, 2, 435, 436,
+	7, 110, 2, 2, 436, 437, 7, 110, 2, 2, 437, 108, 3, 2, 2, 2, 438, 439, 7,
+	44, 2, 2, 439, 110, 3, 2, 2, 2, 440, 441, 7, 45, 2, 2, 441, 112, 3, 2,
+	2, 2, 442, 443, 7, 47, 2, 2, 443, 114, 3, 2, 2, 2, 444, 445, 7, 49, 2,
+	2, 445, 116, 3, 2, 2, 2, 446, 447, 7, 39, 2, 2, 447, 118, 3, 2, 2, 2, 448,
+	449, 7, 126, 2, 2, 449, 450, 7, 126, 2, 2, 450, 120, 3, 2, 2, 2, 451, 452,
+	7, 63, 2, 2, 452, 122, 3, 2, 2, 2, 453, 454, 7, 35, 2, 2, 454, 455, 7,
+	63, 2, 2, 455, 124, 3, 2, 2, 2, 456, 457, 7, 62, 2, 2, 457, 126, 3, 2,
+	2, 2, 458, 459, 7, 62, 2, 2, 459, 460, 7, 63, 2, 2, 460, 128, 3, 2, 2,
+	2, 461, 462, 7, 64, 2, 2, 462, 130, 3, 2, 2, 2, 463, 464, 7, 64, 2, 2,
+	464, 465, 7, 63, 2, 2, 465, 132, 3, 2, 2, 2, 466, 467, 7, 46, 2, 2, 467,
+	134, 3, 2, 2, 2, 468, 469, 7, 48, 2, 2, 469, 136, 3, 2, 2, 2, 470, 471,
+	7, 61, 2, 2, 471, 138, 3, 2, 2, 2, 472, 476, 9, 2, 2, 2, 473, 475, 9, 3,
+	2, 2, 474, 473, 3, 2, 2, 2, 475, 478, 3, 2, 2, 2, 476, 474, 3, 2, 2, 2,
+	476, 477, 3, 2, 2, 2, 477, 489, 3, 2, 2, 2, 478, 476, 3, 2, 2, 2, 479,
+	483, 7, 36, 2, 2, 480, 484, 10, 4, 2, 2, 481, 482, 7, 36, 2, 2, 482, 484,
+	7, 36, 2, 2, 483, 480, 3, 2, 2, 2, 483, 481, 3, 2, 2, 2, 484, 485, 3, 2,
+	2, 2, 485, 483, 3, 2, 2, 2, 485, 486, 3, 2, 2, 2, 486, 487, 3, 2, 2, 2,
+	487, 489, 7, 36, 2, 2, 488, 472, 3, 2, 2, 2, 488, 479, 3, 2, 2, 2, 489,
+	140, 3, 2, 2, 2, 490, 499, 7, 50, 2, 2, 491, 495, 9, 5, 2, 2, 492, 494,
+	9, 6, 2, 2, 493, 492, 3, 2, 2, 2, 494, 497, 3, 2, 2, 2, 495, 493, 3, 2,
+	2, 2, 495, 496, 3, 2, 2, 2, 496, 499, 3, 2, 2, 2, 497, 495, 3, 2, 2, 2,
+	498, 490, 3, 2, 2, 2, 498, 491, 3, 2, 2, 2, 499, 142, 3, 2, 2, 2, 500,
+	502, 9, 6, 2, 2, 501, 500, 3, 2, 2, 2, 502, 503, 3, 2, 2, 2, 503, 501,
+	3, 2, 2, 2, 503, 504, 3, 2, 2, 2, 504, 505, 3, 2, 2, 2, 505, 507, 7, 48,
+	2, 2, 506, 508, 9, 6, 2, 2, 507, 506, 3, 2, 2, 2, 508, 509, 3, 2, 2, 2,
+	509, 507, 3, 2, 2, 2, 509, 510, 3, 2, 2, 2, 510, 520, 3, 2, 2, 2, 511,
+	513, 9, 7, 2, 2, 512, 514, 9, 8, 2, 2, 513, 512, 3, 2, 2, 2, 513, 514,
+	3, 2, 2, 2, 514, 516, 3, 2, 2, 2, 515, 517, 9, 6, 2, 2, 516, 515, 3, 2,
+	2, 2, 517, 518, 3, 2, 2, 2, 518, 516, 3, 2, 2, 2, 518, 519, 3, 2, 2, 2,
+	519, 521, 3, 2, 2, 2, 520, 511, 3, 2, 2, 2, 520, 521, 3, 2, 2, 2, 521,
+	144, 3, 2, 2, 2, 522, 528, 7, 41, 2, 2, 523, 527, 10, 9, 2, 2, 524, 525,
+	7, 41, 2, 2, 525, 527, 7, 41, 2, 2, 526, 523, 3, 2, 2, 2, 526, 524, 3,
+	2, 2, 2, 527, 530, 3, 2, 2, 2, 528, 526, 3, 2, 2, 2, 528, 529, 3, 2, 2,
+	2, 529, 531, 3, 2, 2, 2, 530, 528, 3, 2, 2, 2, 531, 532, 7, 41, 2, 2, 532,
+	146, 3, 2, 2, 2, 533, 534, 9, 10, 2, 2, 534, 539, 7, 41, 2, 2, 535, 536,
+	9, 11, 2, 2, 536, 538, 9, 11, 2, 2, 537, 535, 3, 2, 2, 2, 538, 541, 3,
+	2, 2, 2, 539, 537, 3, 2, 2, 2, 539, 540, 3, 2, 2, 2, 540, 542, 3, 2, 2,
+	2, 541, 539, 3, 2, 2, 2, 542, 543, 7, 41, 2, 2, 543, 148, 3, 2, 2, 2, 544,
+	545, 9, 12, 2, 2, 545, 546, 3, 2, 2, 2, 546, 547, 8, 75, 2, 2, 547, 150,
+	3, 2, 2, 2, 548, 549, 7, 47, 2, 2, 549, 550, 7, 47, 2, 2, 550, 554, 3,
+	2, 2, 2, 551, 553, 10, 13, 2, 2, 552, 551, 3, 2, 2, 2, 553, 556, 3, 2,
+	2, 2, 554, 552, 3, 2, 2, 2, 554, 555, 3, 2, 2, 2, 555, 557, 3, 2, 2, 2,
+	556, 554, 3, 2, 2, 2, 557, 558, 8, 76, 2, 2, 558, 152, 3, 2, 2, 2, 559,
+	560, 7, 49, 2, 2, 560, 561, 7, 44, 2, 2, 561, 565, 3, 2, 2, 2, 562, 564,
+	11, 2, 2, 2, 563, 562, 3, 2, 2, 2, 564, 567, 3, 2, 2, 2, 565, 566, 3, 2,
+	2, 2, 565, 563, 3, 2, 2, 2, 566, 568, 3, 2, 2, 2, 567, 565, 3, 2, 2, 2,
+	568, 569, 7, 44, 2, 2, 569, 570, 7, 49, 2, 2, 570, 571, 3, 2, 2, 2, 571,
+	572, 8, 77, 2, 2, 572, 154, 3, 2, 2, 2, 19, 2, 476, 483, 485, 488, 495,
+	498, 503, 509, 513, 518, 520, 526, 528, 539, 554, 565, 3, 8, 2, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -124,22 +282,40 @@ var lexerModeNames = []string{
 var lexerLiteralNames = []string{
 	"", "'('", "')'", "'create'", "'insert'", "'select'", "'update'", "'delete'",
 	"'from'", "'set'", "'where'", "'into'", "'values'", "'table'", "'index'",
-	"'view'", "'as'", "'on'", "'int'", "'varchar'", "'and'", "'or'", "'*'",
-	"'='", "'!='", "','", "';'",
+	"'using'", "'format'", "'view'", "'as'", "'on'", "'int'", "'bigint'", "'boolean'",
+	"'double'", "'real'", "'date'", "'timestamp'", "'blob'", "'true'", "'false'",
+	"'varchar'", "'and'", "'or'", "'not'", "'group'", "'by'", "'having'", "'order'",
+	"'asc'", "'desc'", "'count'", "'sum'", "'min'", "'max'", "'avg'", "'join'",
+	"'inner'", "'left'", "'right'", "'full'", "'outer'", "'cross'", "'is'",
+	"'null'", "'*'", "'+'", "'-'", "'/'", "'%'", "'||'", "'='", "'!='", "'<'",
+	"'<='", "'>'", "'>='", "','", "'.'", "';'",
 }
 
 var lexerSymbolicNames = []string{
 	"", "", "", "CREATE_", "INSERT_", "SELECT_", "UPDATE_", "DELETE_", "FROM_",
-	"SET_", "WHERE_", "INTO_", "VALUES_", "TABLE_", "INDEX_", "VIEW_", "AS_",
-	"ON_", "INT_", "VAR_CHAR_", "AND_", "OR_", "STAR", "EQUAL", "NOT_EQUAL",
-	"COMMA", "SEMI_COLON", "IDENT", "INT_LITERAL", "STR_LITERAL", "SPACES",
+	"SET_", "WHERE_", "INTO_", "VALUES_", "TABLE_", "INDEX_", "USING_", "FORMAT_",
+	"VIEW_", "AS_", "ON_", "INT_", "BIGINT_", "BOOLEAN_", "DOUBLE_", "REAL_",
+	"DATE_", "TIMESTAMP_", "BLOB_", "TRUE_", "FALSE_", "VAR_CHAR_", "AND_",
+	"OR_", "NOT_", "GROUP_", "BY_", "HAVING_", "ORDER_", "ASC_", "DESC_", "COUNT_",
+	"SUM_", "MIN_", "MAX_", "AVG_", "JOIN_", "INNER_", "LEFT_", "RIGHT_", "FULL_",
+	"OUTER_", "CROSS_", "IS_", "NULL_", "STAR", "PLUS", "MINUS", "SLASH", "PERCENT",
+	"CONCAT", "EQUAL", "NOT_EQUAL", "LESS", "LESS_EQUAL", "GREATER", "GREATER_EQUAL",
+	"COMMA", "DOT", "SEMI_COLON", "IDENT", "INT_LITERAL", "FLOAT_LITERAL",
+	"STR_LITERAL", "BLOB_LITERAL", "SPACES", "LINE_COMMENT", "BLOCK_COMMENT",
 }
 
 var lexerRuleNames = []string{
 	"T__0", "T__1", "CREATE_", "INSERT_", "SELECT_", "UPDATE_", "DELETE_",
-	"FROM_", "SET_", "WHERE_", "INTO_", "VALUES_", "TABLE_", "INDEX_", "VIEW_",
-	"AS_", "ON_", "INT_", "VAR_CHAR_", "AND_", "OR_", "STAR", "EQUAL", "NOT_EQUAL",
-	"COMMA", "SEMI_COLON", "IDENT", "INT_LITERAL", "STR_LITERAL", "SPACES",
+	"FROM_", "SET_", "WHERE_", "INTO_", "VALUES_", "TABLE_", "INDEX_", "USING_",
+	"FORMAT_", "VIEW_", "AS_", "ON_", "INT_", "BIGINT_", "BOOLEAN_", "DOUBLE_",
+	"REAL_", "DATE_", "TIMESTAMP_", "BLOB_", "TRUE_", "FALSE_", "VAR_CHAR_",
+	"AND_", "OR_", "NOT_", "GROUP_", "BY_", "HAVING_", "ORDER_", "ASC_", "DESC_",
+	"COUNT_", "SUM_", "MIN_", "MAX_", "AVG_", "JOIN_", "INNER_", "LEFT_", "RIGHT_",
+	"FULL_", "OUTER_", "CROSS_", "IS_", "NULL_", "STAR", "PLUS", "MINUS", "SLASH",
+	"PERCENT", "CONCAT", "EQUAL", "NOT_EQUAL", "LESS", "LESS_EQUAL", "GREATER",
+	"GREATER_EQUAL", "COMMA", "DOT", "SEMI_COLON", "IDENT", "INT_LITERAL",
+	"FLOAT_LITERAL", "STR_LITERAL", "BLOB_LITERAL", "SPACES", "LINE_COMMENT",
+	"BLOCK_COMMENT",
 }
 
 type SimpleSqlLexer struct {
@@ -177,34 +353,80 @@ func NewSimpleSqlLexer(input antlr.CharStream) *SimpleSqlLexer {
 
 // SimpleSqlLexer tokens.
 const (
-	SimpleSqlLexerT__0        = 1
-	SimpleSqlLexerT__1        = 2
-	SimpleSqlLexerCREATE_     = 3
-	SimpleSqlLexerINSERT_     = 4
-	SimpleSqlLexerSELECT_     = 5
-	SimpleSqlLexerUPDATE_     = 6
-	SimpleSqlLexerDELETE_     = 7
-	SimpleSqlLexerFROM_       = 8
-	SimpleSqlLexerSET_        = 9
-	SimpleSqlLexerWHERE_      = 10
-	SimpleSqlLexerINTO_       = 11
-	SimpleSqlLexerVALUES_     = 12
-	SimpleSqlLexerTABLE_      = 13
-	SimpleSqlLexerINDEX_      = 14
-	SimpleSqlLexerVIEW_       = 15
-	SimpleSqlLexerAS_         = 16
-	SimpleSqlLexerON_         = 17
-	SimpleSqlLexerINT_        = 18
-	SimpleSqlLexerVAR_CHAR_   = 19
-	SimpleSqlLexerAND_        = 20
-	SimpleSqlLexerOR_         = 21
-	SimpleSqlLexerSTAR        = 22
-	SimpleSqlLexerEQUAL       = 23
-	SimpleSqlLexerNOT_EQUAL   = 24
-	SimpleSqlLexerCOMMA       = 25
-	SimpleSqlLexerSEMI_COLON  = 26
-	SimpleSqlLexerIDENT       = 27
-	SimpleSqlLexerINT_LITERAL = 28
-	SimpleSqlLexerSTR_LITERAL = 29
-	SimpleSqlLexerSPACES      = 30
+	SimpleSqlLexerT__0          = 1
+	SimpleSqlLexerT__1          = 2
+	SimpleSqlLexerCREATE_       = 3
+	SimpleSqlLexerINSERT_       = 4
+	SimpleSqlLexerSELECT_       = 5
+	SimpleSqlLexerUPDATE_       = 6
+	SimpleSqlLexerDELETE_       = 7
+	SimpleSqlLexerFROM_         = 8
+	SimpleSqlLexerSET_          = 9
+	SimpleSqlLexerWHERE_        = 10
+	SimpleSqlLexerINTO_         = 11
+	SimpleSqlLexerVALUES_       = 12
+	SimpleSqlLexerTABLE_        = 13
+	SimpleSqlLexerINDEX_        = 14
+	SimpleSqlLexerUSING_        = 15
+	SimpleSqlLexerFORMAT_       = 16
+	SimpleSqlLexerVIEW_         = 17
+	SimpleSqlLexerAS_           = 18
+	SimpleSqlLexerON_           = 19
+	SimpleSqlLexerINT_          = 20
+	SimpleSqlLexerBIGINT_       = 21
+	SimpleSqlLexerBOOLEAN_      = 22
+	SimpleSqlLexerDOUBLE_       = 23
+	SimpleSqlLexerREAL_         = 24
+	SimpleSqlLexerDATE_         = 25
+	SimpleSqlLexerTIMESTAMP_    = 26
+	SimpleSqlLexerBLOB_         = 27
+	SimpleSqlLexerTRUE_         = 28
+	SimpleSqlLexerFALSE_        = 29
+	SimpleSqlLexerVAR_CHAR_     = 30
+	SimpleSqlLexerAND_          = 31
+	SimpleSqlLexerOR_           = 32
+	SimpleSqlLexerNOT_          = 33
+	SimpleSqlLexerGROUP_        = 34
+	SimpleSqlLexerBY_           = 35
+	SimpleSqlLexerHAVING_       = 36
+	SimpleSqlLexerORDER_        = 37
+	SimpleSqlLexerASC_          = 38
+	SimpleSqlLexerDESC_         = 39
+	SimpleSqlLexerCOUNT_        = 40
+	SimpleSqlLexerSUM_          = 41
+	SimpleSqlLexerMIN_          = 42
+	SimpleSqlLexerMAX_          = 43
+	SimpleSqlLexerAVG_          = 44
+	SimpleSqlLexerJOIN_         = 45
+	SimpleSqlLexerINNER_        = 46
+	SimpleSqlLexerLEFT_         = 47
+	SimpleSqlLexerRIGHT_        = 48
+	SimpleSqlLexerFULL_         = 49
+	SimpleSqlLexerOUTER_        = 50
+	SimpleSqlLexerCROSS_        = 51
+	SimpleSqlLexerIS_           = 52
+	SimpleSqlLexerNULL_         = 53
+	SimpleSqlLexerSTAR          = 54
+	SimpleSqlLexerPLUS          = 55
+	SimpleSqlLexerMINUS         = 56
+	SimpleSqlLexerSLASH         = 57
+	SimpleSqlLexerPERCENT       = 58
+	SimpleSqlLexerCONCAT        = 59
+	SimpleSqlLexerEQUAL         = 60
+	SimpleSqlLexerNOT_EQUAL     = 61
+	SimpleSqlLexerLESS          = 62
+	SimpleSqlLexerLESS_EQUAL    = 63
+	SimpleSqlLexerGREATER       = 64
+	SimpleSqlLexerGREATER_EQUAL = 65
+	SimpleSqlLexerCOMMA         = 66
+	SimpleSqlLexerDOT           = 67
+	SimpleSqlLexerSEMI_COLON    = 68
+	SimpleSqlLexerIDENT         = 69
+	SimpleSqlLexerINT_LITERAL   = 70
+	SimpleSqlLexerFLOAT_LITERAL = 71
+	SimpleSqlLexerSTR_LITERAL   = 72
+	SimpleSqlLexerBLOB_LITERAL  = 73
+	SimpleSqlLexerSPACES        = 74
+	SimpleSqlLexerLINE_COMMENT  = 75
+	SimpleSqlLexerBLOCK_COMMENT = 76
 )
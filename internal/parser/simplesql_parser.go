@@ -15,87 +15,202 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 32, 197,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 78, 434,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
 	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
-	3, 2, 7, 2, 48, 10, 2, 12, 2, 14, 2, 51, 11, 2, 3, 2, 3, 2, 3, 3, 3, 3,
-	3, 3, 7, 3, 58, 10, 3, 12, 3, 14, 3, 61, 11, 3, 3, 4, 3, 4, 3, 4, 3, 4,
-	3, 4, 3, 4, 3, 4, 5, 4, 70, 10, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
-	3, 5, 3, 6, 3, 6, 3, 6, 7, 6, 82, 10, 6, 12, 6, 14, 6, 85, 11, 6, 3, 7,
-	3, 7, 3, 7, 3, 8, 3, 8, 5, 8, 92, 10, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9,
-	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 106, 10, 10, 3,
-	10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 7, 11, 116, 10, 11,
-	12, 11, 14, 11, 119, 11, 11, 3, 12, 3, 12, 3, 12, 5, 12, 124, 10, 12, 3,
-	12, 3, 12, 3, 12, 3, 12, 5, 12, 130, 10, 12, 3, 13, 3, 13, 3, 13, 7, 13,
-	135, 10, 13, 12, 13, 14, 13, 138, 11, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3,
-	14, 3, 14, 5, 14, 146, 10, 14, 3, 15, 3, 15, 3, 15, 7, 15, 151, 10, 15,
-	12, 15, 14, 15, 154, 11, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17,
-	3, 17, 3, 17, 3, 17, 5, 17, 165, 10, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3,
-	18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19,
-	3, 20, 3, 20, 3, 20, 5, 20, 185, 10, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3,
-	22, 3, 22, 5, 22, 193, 10, 22, 3, 23, 3, 23, 3, 23, 2, 2, 24, 2, 4, 6,
-	8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
-	44, 2, 5, 3, 2, 22, 23, 3, 2, 25, 26, 3, 2, 30, 31, 2, 194, 2, 49, 3, 2,
-	2, 2, 4, 54, 3, 2, 2, 2, 6, 69, 3, 2, 2, 2, 8, 71, 3, 2, 2, 2, 10, 78,
-	3, 2, 2, 2, 12, 86, 3, 2, 2, 2, 14, 91, 3, 2, 2, 2, 16, 93, 3, 2, 2, 2,
-	18, 98, 3, 2, 2, 2, 20, 112, 3, 2, 2, 2, 22, 120, 3, 2, 2, 2, 24, 131,
-	3, 2, 2, 2, 26, 139, 3, 2, 2, 2, 28, 147, 3, 2, 2, 2, 30, 155, 3, 2, 2,
-	2, 32, 159, 3, 2, 2, 2, 34, 166, 3, 2, 2, 2, 36, 172, 3, 2, 2, 2, 38, 181,
-	3, 2, 2, 2, 40, 186, 3, 2, 2, 2, 42, 192, 3, 2, 2, 2, 44, 194, 3, 2, 2,
-	2, 46, 48, 5, 4, 3, 2, 47, 46, 3, 2, 2, 2, 48, 51, 3, 2, 2, 2, 49, 47,
-	3, 2, 2, 2, 49, 50, 3, 2, 2, 2, 50, 52, 3, 2, 2, 2, 51, 49, 3, 2, 2, 2,
-	52, 53, 7, 2, 2, 3, 53, 3, 3, 2, 2, 2, 54, 59, 5, 6, 4, 2, 55, 56, 7, 28,
-	2, 2, 56, 58, 5, 6, 4, 2, 57, 55, 3, 2, 2, 2, 58, 61, 3, 2, 2, 2, 59, 57,
-	3, 2, 2, 2, 59, 60, 3, 2, 2, 2, 60, 5, 3, 2, 2, 2, 61, 59, 3, 2, 2, 2,
-	62, 70, 5, 8, 5, 2, 63, 70, 5, 18, 10, 2, 64, 70, 5, 22, 12, 2, 65, 70,
-	5, 26, 14, 2, 66, 70, 5, 32, 17, 2, 67, 70, 5, 34, 18, 2, 68, 70, 5, 36,
-	19, 2, 69, 62, 3, 2, 2, 2, 69, 63, 3, 2, 2, 2, 69, 64, 3, 2, 2, 2, 69,
-	65, 3, 2, 2, 2, 69, 66, 3, 2, 2, 2, 69, 67, 3, 2, 2, 2, 69, 68, 3, 2, 2,
-	2, 70, 7, 3, 2, 2, 2, 71, 72, 7, 5, 2, 2, 72, 73, 7, 15, 2, 2, 73, 74,
-	7, 29, 2, 2, 74, 75, 7, 3, 2, 2, 75, 76, 5, 10, 6, 2, 76, 77, 7, 4, 2,
-	2, 77, 9, 3, 2, 2, 2, 78, 83, 5, 12, 7, 2, 79, 80, 7, 27, 2, 2, 80, 82,
-	5, 12, 7, 2, 81, 79, 3, 2, 2, 2, 82, 85, 3, 2, 2, 2, 83, 81, 3, 2, 2, 2,
-	83, 84, 3, 2, 2, 2, 84, 11, 3, 2, 2, 2, 85, 83, 3, 2, 2, 2, 86, 87, 7,
-	29, 2, 2, 87, 88, 5, 14, 8, 2, 88, 13, 3, 2, 2, 2, 89, 92, 7, 20, 2, 2,
-	90, 92, 5, 16, 9, 2, 91, 89, 3, 2, 2, 2, 91, 90, 3, 2, 2, 2, 92, 15, 3,
-	2, 2, 2, 93, 94, 7, 21, 2, 2, 94, 95, 7, 3, 2, 2, 95, 96, 7, 30, 2, 2,
-	96, 97, 7, 4, 2, 2, 97, 17, 3, 2, 2, 2, 98, 99, 7, 6, 2, 2, 99, 100, 7,
-	13, 2, 2, 100, 105, 7, 29, 2, 2, 101, 102, 7, 3, 2, 2, 102, 103, 5, 24,
-	13, 2, 103, 104, 7, 4, 2, 2, 104, 106, 3, 2, 2, 2, 105, 101, 3, 2, 2, 2,
-	105, 106, 3, 2, 2, 2, 106, 107, 3, 2, 2, 2, 107, 108, 7, 14, 2, 2, 108,
-	109, 7, 3, 2, 2, 109, 110, 5, 20, 11, 2, 110, 111, 7, 4, 2, 2, 111, 19,
-	3, 2, 2, 2, 112, 117, 5, 44, 23, 2, 113, 114, 7, 27, 2, 2, 114, 116, 5,
-	44, 23, 2, 115, 113, 3, 2, 2, 2, 116, 119, 3, 2, 2, 2, 117, 115, 3, 2,
-	2, 2, 117, 118, 3, 2, 2, 2, 118, 21, 3, 2, 2, 2, 119, 117, 3, 2, 2, 2,
-	120, 123, 7, 7, 2, 2, 121, 124, 7, 24, 2, 2, 122, 124, 5, 24, 13, 2, 123,
-	121, 3, 2, 2, 2, 123, 122, 3, 2, 2, 2, 124, 125, 3, 2, 2, 2, 125, 126,
-	7, 10, 2, 2, 126, 129, 5, 24, 13, 2, 127, 128, 7, 12, 2, 2, 128, 130, 5,
-	38, 20, 2, 129, 127, 3, 2, 2, 2, 129, 130, 3, 2, 2, 2, 130, 23, 3, 2, 2,
-	2, 131, 136, 7, 29, 2, 2, 132, 133, 7, 27, 2, 2, 133, 135, 7, 29, 2, 2,
-	134, 132, 3, 2, 2, 2, 135, 138, 3, 2, 2, 2, 136, 134, 3, 2, 2, 2, 136,
-	137, 3, 2, 2, 2, 137, 25, 3, 2, 2, 2, 138, 136, 3, 2, 2, 2, 139, 140, 7,
-	8, 2, 2, 140, 141, 7, 29, 2, 2, 141, 142, 7, 11, 2, 2, 142, 145, 5, 28,
-	15, 2, 143, 144, 7, 12, 2, 2, 144, 146, 5, 38, 20, 2, 145, 143, 3, 2, 2,
-	2, 145, 146, 3, 2, 2, 2, 146, 27, 3, 2, 2, 2, 147, 152, 5, 30, 16, 2, 148,
-	149, 7, 27, 2, 2, 149, 151, 5, 30, 16, 2, 150, 148, 3, 2, 2, 2, 151, 154,
-	3, 2, 2, 2, 152, 150, 3, 2, 2, 2, 152, 153, 3, 2, 2, 2, 153, 29, 3, 2,
-	2, 2, 154, 152, 3, 2, 2, 2, 155, 156, 7, 29, 2, 2, 156, 157, 7, 25, 2,
-	2, 157, 158, 5, 42, 22, 2, 158, 31, 3, 2, 2, 2, 159, 160, 7, 9, 2, 2, 160,
-	161, 7, 10, 2, 2, 161, 164, 7, 29, 2, 2, 162, 163, 7, 12, 2, 2, 163, 165,
-	5, 38, 20, 2, 164, 162, 3, 2, 2, 2, 164, 165, 3, 2, 2, 2, 165, 33, 3, 2,
-	2, 2, 166, 167, 7, 5, 2, 2, 167, 168, 7, 17, 2, 2, 168, 169, 7, 29, 2,
-	2, 169, 170, 7, 18, 2, 2, 170, 171, 5, 22, 12, 2, 171, 35, 3, 2, 2, 2,
-	172, 173, 7, 5, 2, 2, 173, 174, 7, 16, 2, 2, 174, 175, 7, 29, 2, 2, 175,
-	176, 7, 19, 2, 2, 176, 177, 7, 29, 2, 2, 177, 178, 7, 3, 2, 2, 178, 179,
-	7, 29, 2, 2, 179, 180, 7, 4, 2, 2, 180, 37, 3, 2, 2, 2, 181, 184, 5, 40,
-	21, 2, 182, 183, 9, 2, 2, 2, 183, 185, 5, 40, 21, 2, 184, 182, 3, 2, 2,
-	2, 184, 185, 3, 2, 2, 2, 185, 39, 3, 2, 2, 2, 186, 187, 5, 42, 22, 2, 187,
-	188, 9, 3, 2, 2, 188, 189, 5, 42, 22, 2, 189, 41, 3, 2, 2, 2, 190, 193,
-	7, 29, 2, 2, 191, 193, 5, 44, 23, 2, 192, 190, 3, 2, 2, 2, 192, 191, 3,
-	2, 2, 2, 193, 43, 3, 2, 2, 2, 194, 195, 9, 4, 2, 2, 195, 45, 3, 2, 2, 2,
-	17, 49, 59, 69, 83, 91, 105, 117, 123, 129, 136, 145, 152, 164, 184, 192,
+	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
+	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
+	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4, 39, 9,
+	39, 4, 40, 9, 40, 4, 41, 9, 41, 3, 2, 5, 2, 84, 10, 2, 3, 2, 3, 2, 3, 3,
+	7, 3, 89, 10, 3, 12, 3, 14, 3, 92, 11, 3, 3, 3, 3, 3, 6, 3, 96, 10, 3,
+	13, 3, 14, 3, 97, 3, 3, 7, 3, 101, 10, 3, 12, 3, 14, 3, 104, 11, 3, 3,
+	3, 7, 3, 107, 10, 3, 12, 3, 14, 3, 110, 11, 3, 3, 4, 3, 4, 3, 4, 3, 4,
+	3, 4, 3, 4, 3, 4, 5, 4, 119, 10, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
+	3, 5, 3, 5, 5, 5, 129, 10, 5, 3, 6, 3, 6, 3, 6, 7, 6, 134, 10, 6, 12, 6,
+	14, 6, 137, 11, 6, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8,
+	3, 8, 3, 8, 3, 8, 5, 8, 151, 10, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10,
+	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 165, 10, 10, 3, 10, 3,
+	10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 7, 11, 175, 10, 11, 12, 11,
+	14, 11, 178, 11, 11, 3, 12, 3, 12, 3, 12, 5, 12, 183, 10, 12, 3, 13, 3,
+	13, 3, 13, 5, 13, 188, 10, 13, 3, 13, 3, 13, 3, 13, 3, 13, 5, 13, 194,
+	10, 13, 3, 13, 3, 13, 3, 13, 5, 13, 199, 10, 13, 3, 13, 3, 13, 5, 13, 203,
+	10, 13, 3, 13, 3, 13, 3, 13, 5, 13, 208, 10, 13, 3, 14, 3, 14, 3, 14, 7,
+	14, 213, 10, 14, 12, 14, 14, 14, 216, 11, 14, 3, 15, 3, 15, 5, 15, 220,
+	10, 15, 3, 15, 5, 15, 223, 10, 15, 3, 16, 3, 16, 3, 16, 7, 16, 228, 10,
+	16, 12, 16, 14, 16, 231, 11, 16, 3, 17, 3, 17, 7, 17, 235, 10, 17, 12,
+	17, 14, 17, 238, 11, 17, 3, 18, 3, 18, 5, 18, 242, 10, 18, 3, 18, 5, 18,
+	245, 10, 18, 3, 19, 3, 19, 3, 19, 3, 19, 5, 19, 251, 10, 19, 3, 19, 3,
+	19, 3, 19, 3, 19, 3, 19, 5, 19, 258, 10, 19, 3, 20, 3, 20, 3, 20, 5, 20,
+	263, 10, 20, 5, 20, 265, 10, 20, 3, 21, 3, 21, 3, 21, 7, 21, 270, 10, 21,
+	12, 21, 14, 21, 273, 11, 21, 3, 22, 3, 22, 5, 22, 277, 10, 22, 3, 23, 3,
+	23, 3, 23, 7, 23, 282, 10, 23, 12, 23, 14, 23, 285, 11, 23, 3, 24, 3, 24,
+	3, 24, 7, 24, 290, 10, 24, 12, 24, 14, 24, 293, 11, 24, 3, 25, 3, 25, 3,
+	25, 3, 25, 3, 25, 3, 25, 5, 25, 301, 10, 25, 3, 26, 3, 26, 3, 26, 7, 26,
+	306, 10, 26, 12, 26, 14, 26, 309, 11, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3,
+	28, 3, 28, 3, 28, 3, 28, 3, 28, 5, 28, 320, 10, 28, 3, 29, 3, 29, 3, 29,
+	3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3,
+	30, 3, 30, 3, 30, 5, 30, 338, 10, 30, 3, 31, 3, 31, 3, 31, 7, 31, 343,
+	10, 31, 12, 31, 14, 31, 346, 11, 31, 3, 32, 3, 32, 3, 32, 7, 32, 351, 10,
+	32, 12, 32, 14, 32, 354, 11, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3,
+	33, 3, 33, 5, 33, 363, 10, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
+	3, 34, 5, 34, 372, 10, 34, 3, 34, 3, 34, 5, 34, 376, 10, 34, 3, 35, 3,
+	35, 3, 35, 7, 35, 381, 10, 35, 12, 35, 14, 35, 384, 11, 35, 3, 36, 3, 36,
+	3, 36, 7, 36, 389, 10, 36, 12, 36, 14, 36, 392, 11, 36, 3, 37, 3, 37, 3,
+	37, 5, 37, 397, 10, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
+	5, 38, 406, 10, 38, 3, 39, 3, 39, 3, 39, 3, 39, 5, 39, 412, 10, 39, 3,
+	39, 3, 39, 3, 40, 3, 40, 3, 40, 5, 40, 419, 10, 40, 3, 41, 3, 41, 3, 41,
+	3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 5, 41, 432, 10,
+	41, 3, 41, 2, 2, 42, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28,
+	30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64,
+	66, 68, 70, 72, 74, 76, 78, 80, 2, 9, 3, 2, 72, 73, 3, 2, 49, 51, 3, 2,
+	40, 41, 3, 2, 62, 67, 4, 2, 57, 58, 61, 61, 4, 2, 56, 56, 59, 60, 3, 2,
+	42, 46, 2, 463, 2, 83, 3, 2, 2, 2, 4, 90, 3, 2, 2, 2, 6, 118, 3, 2, 2,
+	2, 8, 120, 3, 2, 2, 2, 10, 130, 3, 2, 2, 2, 12, 138, 3, 2, 2, 2, 14, 150,
+	3, 2, 2, 2, 16, 152, 3, 2, 2, 2, 18, 157, 3, 2, 2, 2, 20, 171, 3, 2, 2,
+	2, 22, 182, 3, 2, 2, 2, 24, 184, 3, 2, 2, 2, 26, 209, 3, 2, 2, 2, 28, 217,
+	3, 2, 2, 2, 30, 224, 3, 2, 2, 2, 32, 232, 3, 2, 2, 2, 34, 239, 3, 2, 2,
+	2, 36, 257, 3, 2, 2, 2, 38, 264, 3, 2, 2, 2, 40, 266, 3, 2, 2, 2, 42, 274,
+	3, 2, 2, 2, 44, 278, 3, 2, 2, 2, 46, 286, 3, 2, 2, 2, 48, 294, 3, 2, 2,
+	2, 50, 302, 3, 2, 2, 2, 52, 310, 3, 2, 2, 2, 54, 314, 3, 2, 2, 2, 56, 321,
+	3, 2, 2, 2, 58, 327, 3, 2, 2, 2, 60, 339, 3, 2, 2, 2, 62, 347, 3, 2, 2,
+	2, 64, 362, 3, 2, 2, 2, 66, 375, 3, 2, 2, 2, 68, 377, 3, 2, 2, 2, 70, 385,
+	3, 2, 2, 2, 72, 396, 3, 2, 2, 2, 74, 405, 3, 2, 2, 2, 76, 407, 3, 2, 2,
+	2, 78, 415, 3, 2, 2, 2, 80, 431, 3, 2, 2, 2, 82, 84, 5, 4, 3, 2, 83, 82,
+	3, 2, 2, 2, 83, 84, 3, 2, 2, 2, 84, 85, 3, 2, 2, 2, 85, 86, 7, 2, 2, 3,
+	86, 3, 3, 2, 2, 2, 87, 89, 7, 70, 2, 2, 88, 87, 3, 2, 2, 2, 89, 92, 3,
+	2, 2, 2, 90, 88, 3, 2, 2, 2, 90, 91, 3, 2, 2, 2, 91, 93, 3, 2, 2, 2, 92,
+	90, 3, 2, 2, 2, 93, 102, 5, 6, 4, 2, 94, 96, 7, 70, 2, 2, 95, 94, 3, 2,
+	2, 2, 96, 97, 3, 2, 2, 2, 97, 95, 3, 2, 2, 2, 97, 98, 3, 2, 2, 2, 98, 99,
+	3, 2, 2, 2, 99, 101, 5, 6, 4, 2, 100, 95, 3, 2, 2, 2, 101, 104, 3, 2, 2,
+	2, 102, 100, 3, 2, 2, 2, 102, 103, 3, 2, 2, 2, 103, 108, 3, 2, 2, 2, 104,
+	102, 3, 2, 2, 2, 105, 107, 7, 70, 2, 2, 106, 105, 3, 2, 2, 2, 107, 110,
+	3, 2, 2, 2, 108, 106, 3, 2, 2, 2, 108, 109, 3, 2, 2, 2, 109, 5, 3, 2, 2,
+	2, 110, 108, 3, 2, 2, 2, 111, 119, 5, 8, 5, 2, 112, 119, 5, 18, 10, 2,
+	113, 119, 5, 24, 13, 2, 114, 119, 5, 48, 25, 2, 115, 119, 5, 54, 28, 2,
+	116, 119, 5, 56, 29, 2, 117, 119, 5, 58, 30, 2, 118, 111, 3, 2, 2, 2, 118,
+	112, 3, 2, 2, 2, 118, 113, 3, 2, 2, 2, 118, 114, 3, 2, 2, 2, 118, 115,
+	3, 2, 2, 2, 118, 116, 3, 2, 2, 2, 118, 117, 3, 2, 2, 2, 119, 7, 3, 2, 2,
+	2, 120, 121, 7, 5, 2, 2, 121, 122, 7, 15, 2, 2, 122, 123, 7, 71, 2, 2,
+	123, 124, 7, 3, 2, 2, 124, 125, 5, 10, 6, 2, 125, 128, 7, 4, 2, 2, 126,
+	127, 7, 18, 2, 2, 127, 129, 7, 71, 2, 2, 128, 126, 3, 2, 2, 2, 128, 129,
+	3, 2, 2, 2, 129, 9, 3, 2, 2, 2, 130, 135, 5, 12, 7, 2, 131, 132, 7, 68,
+	2, 2, 132, 134, 5, 12, 7, 2, 133, 131, 3, 2, 2, 2, 134, 137, 3, 2, 2, 2,
+	135, 133, 3, 2, 2, 2, 135, 136, 3, 2, 2, 2, 136, 11, 3, 2, 2, 2, 137, 135,
+	3, 2, 2, 2, 138, 139, 7, 71, 2, 2, 139, 140, 5, 14, 8, 2, 140, 13, 3, 2,
+	2, 2, 141, 151, 7, 22, 2, 2, 142, 151, 7, 23, 2, 2, 143, 151, 7, 24, 2,
+	2, 144, 151, 7, 25, 2, 2, 145, 151, 7, 26, 2, 2, 146, 151, 7, 27, 2, 2,
+	147, 151, 7, 28, 2, 2, 148, 151, 7, 29, 2, 2, 149, 151, 5, 16, 9, 2, 150,
+	141, 3, 2, 2, 2, 150, 142, 3, 2, 2, 2, 150, 143, 3, 2, 2, 2, 150, 144,
+	3, 2, 2, 2, 150, 145, 3, 2, 2, 2, 150, 146, 3, 2, 2, 2, 150, 147, 3, 2,
+	2, 2, 150, 148, 3, 2, 2, 2, 150, 149, 3, 2, 2, 2, 151, 15, 3, 2, 2, 2,
+	152, 153, 7, 32, 2, 2, 153, 154, 7, 3, 2, 2, 154, 155, 7, 72, 2, 2, 155,
+	156, 7, 4, 2, 2, 156, 17, 3, 2, 2, 2, 157, 158, 7, 6, 2, 2, 158, 159, 7,
+	13, 2, 2, 159, 164, 7, 71, 2, 2, 160, 161, 7, 3, 2, 2, 161, 162, 5, 44,
+	23, 2, 162, 163, 7, 4, 2, 2, 163, 165, 3, 2, 2, 2, 164, 160, 3, 2, 2, 2,
+	164, 165, 3, 2, 2, 2, 165, 166, 3, 2, 2, 2, 166, 167, 7, 14, 2, 2, 167,
+	168, 7, 3, 2, 2, 168, 169, 5, 20, 11, 2, 169, 170, 7, 4, 2, 2, 170, 19,
+	3, 2, 2, 2, 171, 176, 5, 22, 12, 2, 172, 173, 7, 68, 2, 2, 173, 175, 5,
+	22, 12, 2, 174, 172, 3, 2, 2, 2, 175, 178, 3, 2, 2, 2, 176, 174, 3, 2,
+	2, 2, 176, 177, 3, 2, 2, 2, 177, 21, 3, 2, 2, 2, 178, 176, 3, 2, 2, 2,
+	179, 180, 7, 58, 2, 2, 180, 183, 9, 2, 2, 2, 181, 183, 5, 80, 41, 2, 182,
+	179, 3, 2, 2, 2, 182, 181, 3, 2, 2, 2, 183, 23, 3, 2, 2, 2, 184, 187, 7,
+	7, 2, 2, 185, 188, 7, 56, 2, 2, 186, 188, 5, 26, 14, 2, 187, 185, 3, 2,
+	2, 2, 187, 186, 3, 2, 2, 2, 188, 189, 3, 2, 2, 2, 189, 190, 7, 10, 2, 2,
+	190, 193, 5, 30, 16, 2, 191, 192, 7, 12, 2, 2, 192, 194, 5, 60, 31, 2,
+	193, 191, 3, 2, 2, 2, 193, 194, 3, 2, 2, 2, 194, 198, 3, 2, 2, 2, 195,
+	196, 7, 36, 2, 2, 196, 197, 7, 37, 2, 2, 197, 199, 5, 46, 24, 2, 198, 195,
+	3, 2, 2, 2, 198, 199, 3, 2, 2, 2, 199, 202, 3, 2, 2, 2, 200, 201, 7, 38,
+	2, 2, 201, 203, 5, 60, 31, 2, 202, 200, 3, 2, 2, 2, 202, 203, 3, 2, 2,
+	2, 203, 207, 3, 2, 2, 2, 204, 205, 7, 39, 2, 2, 205, 206, 7, 37, 2, 2,
+	206, 208, 5, 40, 21, 2, 207, 204, 3, 2, 2, 2, 207, 208, 3, 2, 2, 2, 208,
+	25, 3, 2, 2, 2, 209, 214, 5, 28, 15, 2, 210, 211, 7, 68, 2, 2, 211, 213,
+	5, 28, 15, 2, 212, 210, 3, 2, 2, 2, 213, 216, 3, 2, 2, 2, 214, 212, 3,
+	2, 2, 2, 214, 215, 3, 2, 2, 2, 215, 27, 3, 2, 2, 2, 216, 214, 3, 2, 2,
+	2, 217, 222, 5, 68, 35, 2, 218, 220, 7, 20, 2, 2, 219, 218, 3, 2, 2, 2,
+	219, 220, 3, 2, 2, 2, 220, 221, 3, 2, 2, 2, 221, 223, 7, 71, 2, 2, 222,
+	219, 3, 2, 2, 2, 222, 223, 3, 2, 2, 2, 223, 29, 3, 2, 2, 2, 224, 229, 5,
+	32, 17, 2, 225, 226, 7, 68, 2, 2, 226, 228, 5, 32, 17, 2, 227, 225, 3,
+	2, 2, 2, 228, 231, 3, 2, 2, 2, 229, 227, 3, 2, 2, 2, 229, 230, 3, 2, 2,
+	2, 230, 31, 3, 2, 2, 2, 231, 229, 3, 2, 2, 2, 232, 236, 5, 34, 18, 2, 233,
+	235, 5, 36, 19, 2, 234, 233, 3, 2, 2, 2, 235, 238, 3, 2, 2, 2, 236, 234,
+	3, 2, 2, 2, 236, 237, 3, 2, 2, 2, 237, 33, 3, 2, 2, 2, 238, 236, 3, 2,
+	2, 2, 239, 244, 7, 71, 2, 2, 240, 242, 7, 20, 2, 2, 241, 240, 3, 2, 2,
+	2, 241, 242, 3, 2, 2, 2, 242, 243, 3, 2, 2, 2, 243, 245, 7, 71, 2, 2, 244,
+	241, 3, 2, 2, 2, 244, 245, 3, 2, 2, 2, 245, 35, 3, 2, 2, 2, 246, 247, 7,
+	53, 2, 2, 247, 248, 7, 47, 2, 2, 248, 258, 5, 34, 18, 2, 249, 251, 5, 38,
+	20, 2, 250, 249, 3, 2, 2, 2, 250, 251, 3, 2, 2, 2, 251, 252, 3, 2, 2, 2,
+	252, 253, 7, 47, 2, 2, 253, 254, 5, 34, 18, 2, 254, 255, 7, 21, 2, 2, 255,
+	256, 5, 60, 31, 2, 256, 258, 3, 2, 2, 2, 257, 246, 3, 2, 2, 2, 257, 250,
+	3, 2, 2, 2, 258, 37, 3, 2, 2, 2, 259, 265, 7, 48, 2, 2, 260, 262, 9, 3,
+	2, 2, 261, 263, 7, 52, 2, 2, 262, 261, 3, 2, 2, 2, 262, 263, 3, 2, 2, 2,
+	263, 265, 3, 2, 2, 2, 264, 259, 3, 2, 2, 2, 264, 260, 3, 2, 2, 2, 265,
+	39, 3, 2, 2, 2, 266, 271, 5, 42, 22, 2, 267, 268, 7, 68, 2, 2, 268, 270,
+	5, 42, 22, 2, 269, 267, 3, 2, 2, 2, 270, 273, 3, 2, 2, 2, 271, 269, 3,
+	2, 2, 2, 271, 272, 3, 2, 2, 2, 272, 41, 3, 2, 2, 2, 273, 271, 3, 2, 2,
+	2, 274, 276, 5, 68, 35, 2, 275, 277, 9, 4, 2, 2, 276, 275, 3, 2, 2, 2,
+	276, 277, 3, 2, 2, 2, 277, 43, 3, 2, 2, 2, 278, 283, 7, 71, 2, 2, 279,
+	280, 7, 68, 2, 2, 280, 282, 7, 71, 2, 2, 281, 279, 3, 2, 2, 2, 282, 285,
+	3, 2, 2, 2, 283, 281, 3, 2, 2, 2, 283, 284, 3, 2, 2, 2, 284, 45, 3, 2,
+	2, 2, 285, 283, 3, 2, 2, 2, 286, 291, 5, 78, 40, 2, 287, 288, 7, 68, 2,
+	2, 288, 290, 5, 78, 40, 2, 289, 287, 3, 2, 2, 2, 290, 293, 3, 2, 2, 2,
+	291, 289, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2, 292, 47, 3, 2, 2, 2, 293, 291,
+	3, 2, 2, 2, 294, 295, 7, 8, 2, 2, 295, 296, 7, 71, 2, 2, 296, 297, 7, 11,
+	2, 2, 297, 300, 5, 50, 26, 2, 298, 299, 7, 12, 2, 2, 299, 301, 5, 60, 31,
+	2, 300, 298, 3, 2, 2, 2, 300, 301, 3, 2, 2, 2, 301, 49, 3, 2, 2, 2, 302,
+	307, 5, 52, 27, 2, 303, 304, 7, 68, 2, 2, 304, 306, 5, 52, 27, 2, 305,
+	303, 3, 2, 2, 2, 306, 309, 3, 2, 2, 2, 307, 305, 3, 2, 2, 2, 307, 308,
+	3, 2, 2, 2, 308, 51, 3, 2, 2, 2, 309, 307, 3, 2, 2, 2, 310, 311, 7, 71,
+	2, 2, 311, 312, 7, 62, 2, 2, 312, 313, 5, 68, 35, 2, 313, 53, 3, 2, 2,
+	2, 314, 315, 7, 9, 2, 2, 315, 316, 7, 10, 2, 2, 316, 319, 7, 71, 2, 2,
+	317, 318, 7, 12, 2, 2, 318, 320, 5, 60, 31, 2, 319, 317, 3, 2, 2, 2, 319,
+	320, 3, 2, 2, 2, 320, 55, 3, 2, 2, 2, 321, 322, 7, 5, 2, 2, 322, 323, 7,
+	19, 2, 2, 323, 324, 7, 71, 2, 2, 324, 325, 7, 20, 2, 2, 325, 326, 5, 24,
+	13, 2, 326, 57, 3, 2, 2, 2, 327, 328, 7, 5, 2, 2, 328, 329, 7, 16, 2, 2,
+	329, 330, 7, 71, 2, 2, 330, 331, 7, 21, 2, 2, 331, 332, 7, 71, 2, 2, 332,
+	333, 7, 3, 2, 2, 333, 334, 7, 71, 2, 2, 334, 337, 7, 4, 2, 2, 335, 336,
+	7, 17, 2, 2, 336, 338, 7, 71, 2, 2, 337, 335, 3, 2, 2, 2, 337, 338, 3,
+	2, 2, 2, 338, 59, 3, 2, 2, 2, 339, 344, 5, 62, 32, 2, 340, 341, 7, 34,
+	2, 2, 341, 343, 5, 62, 32, 2, 342, 340, 3, 2, 2, 2, 343, 346, 3, 2, 2,
+	2, 344, 342, 3, 2, 2, 2, 344, 345, 3, 2, 2, 2, 345, 61, 3, 2, 2, 2, 346,
+	344, 3, 2, 2, 2, 347, 352, 5, 64, 33, 2, 348, 349, 7, 33, 2, 2, 349, 351,
+	5, 64, 33, 2, 350, 348, 3, 2, 2, 2, 351, 354, 3, 2, 2, 2, 352, 350, 3,
+	2, 2, 2, 352, 353, 3, 2, 2, 2, 353, 63, 3, 2, 2, 2, 354, 352, 3, 2, 2,
+	2, 355, 356, 7, 35, 2, 2, 356, 363, 5, 64, 33, 2, 357, 358, 7, 3, 2, 2,
+	358, 359, 5, 60, 31, 2, 359, 360, 7, 4, 2, 2, 360, 363, 3, 2, 2, 2, 361,
+	363, 5, 66, 34, 2, 362, 355, 3, 2, 2, 2, 362, 357, 3, 2, 2, 2, 362, 361,
+	3, 2, 2, 2, 363, 65, 3, 2, 2, 2, 364, 365, 5, 68, 35, 2, 365, 366, 9, 5,
+	2, 2, 366, 367, 5, 68, 35, 2, 367, 376, 3, 2, 2, 2, 368, 369, 5, 68, 35,
+	2, 369, 371, 7, 54, 2, 2, 370, 372, 7, 35, 2, 2, 371, 370, 3, 2, 2, 2,
+	371, 372, 3, 2, 2, 2, 372, 373, 3, 2, 2, 2, 373, 374, 7, 55, 2, 2, 374,
+	376, 3, 2, 2, 2, 375, 364, 3, 2, 2, 2, 375, 368, 3, 2, 2, 2, 376, 67, 3,
+	2, 2, 2, 377, 382, 5, 70, 36, 2, 378, 379, 9, 6, 2, 2, 379, 381, 5, 70,
+	36, 2, 380, 378, 3, 2, 2, 2, 381, 384, 3, 2, 2, 2, 382, 380, 3, 2, 2, 2,
+	382, 383, 3, 2, 2, 2, 383, 69, 3, 2, 2, 2, 384, 382, 3, 2, 2, 2, 385, 390,
+	5, 72, 37, 2, 386, 387, 9, 7, 2, 2, 387, 389, 5, 72, 37, 2, 388, 386, 3,
+	2, 2, 2, 389, 392, 3, 2, 2, 2, 390, 388, 3, 2, 2, 2, 390, 391, 3, 2, 2,
+	2, 391, 71, 3, 2, 2, 2, 392, 390, 3, 2, 2, 2, 393, 394, 7, 58, 2, 2, 394,
+	397, 5, 72, 37, 2, 395, 397, 5, 74, 38, 2, 396, 393, 3, 2, 2, 2, 396, 395,
+	3, 2, 2, 2, 397, 73, 3, 2, 2, 2, 398, 406, 5, 78, 40, 2, 399, 406, 5, 80,
+	41, 2, 400, 406, 5, 76, 39, 2, 401, 402, 7, 3, 2, 2, 402, 403, 5, 68, 35,
+	2, 403, 404, 7, 4, 2, 2, 404, 406, 3, 2, 2, 2, 405, 398, 3, 2, 2, 2, 405,
+	399, 3, 2, 2, 2, 405, 400, 3, 2, 2, 2, 405, 401, 3, 2, 2, 2, 406, 75, 3,
+	2, 2, 2, 407, 408, 9, 8, 2, 2, 408, 411, 7, 3, 2, 2, 409, 412, 7, 56, 2,
+	2, 410, 412, 5, 68, 35, 2, 411, 409, 3, 2, 2, 2, 411, 410, 3, 2, 2, 2,
+	412, 413, 3, 2, 2, 2, 413, 414, 7, 4, 2, 2, 414, 77, 3, 2, 2, 2, 415, 418,
+	7, 71, 2, 2, 416, 417, 7, 69, 2, 2, 417, 419, 7, 71, 2, 2, 418, 416, 3,
+	2, 2, 2, 418, 419, 3, 2, 2, 2, 419, 79, 3, 2, 2, 2, 420, 432, 7, 72, 2,
+	2, 421, 432, 7, 73, 2, 2, 422, 432, 7, 74, 2, 2, 423, 432, 7, 75, 2, 2,
+	424, 432, 7, 30, 2, 2, 425, 432, 7, 31, 2, 2, 426, 427, 7, 27, 2, 2, 427,
+	432, 7, 74, 2, 2, 428, 429, 7, 28, 2, 2, 429, 432, 7, 74, 2, 2, 430, 432,
+	7, 55, 2, 2, 431, 420, 3, 2, 2, 2, 431, 421, 3, 2, 2, 2, 431, 422, 3, 2,
+	2, 2, 431, 423, 3, 2, 2, 2, 431, 424, 3, 2, 2, 2, 431, 425, 3, 2, 2, 2,
+	431, 426, 3, 2, 2, 2, 431, 428, 3, 2, 2, 2, 431, 430, 3, 2, 2, 2, 432,
+	81, 3, 2, 2, 2, 50, 83, 90, 97, 102, 108, 118, 128, 135, 150, 164, 176,
+	182, 187, 193, 198, 202, 207, 214, 219, 222, 229, 236, 241, 244, 250, 257,
+	262, 264, 271, 276, 283, 291, 300, 307, 319, 337, 344, 352, 362, 371, 375,
+	382, 390, 396, 405, 411, 418, 431,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -103,22 +218,36 @@ var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 var literalNames = []string{
 	"", "'('", "')'", "'create'", "'insert'", "'select'", "'update'", "'delete'",
 	"'from'", "'set'", "'where'", "'into'", "'values'", "'table'", "'index'",
-	"'view'", "'as'", "'on'", "'int'", "'varchar'", "'and'", "'or'", "'*'",
-	"'='", "'!='", "','", "';'",
+	"'using'", "'format'", "'view'", "'as'", "'on'", "'int'", "'bigint'", "'boolean'",
+	"'double'", "'real'", "'date'", "'timestamp'", "'blob'", "'true'", "'false'",
+	"'varchar'", "'and'", "'or'", "'not'", "'group'", "'by'", "'having'", "'order'",
+	"'asc'", "'desc'", "'count'", "'sum'", "'min'", "'max'", "'avg'", "'join'",
+	"'inner'", "'left'", "'right'", "'full'", "'outer'", "'cross'", "'is'",
+	"'null'", "'*'", "'+'", "'-'", "'/'", "'%'", "'||'", "'='", "'!='", "'<'",
+	"'<='", "'>'", "'>='", "','", "'.'", "';'",
 }
 var symbolicNames = []string{
 	"", "", "", "CREATE_", "INSERT_", "SELECT_", "UPDATE_", "DELETE_", "FROM_",
-	"SET_", "WHERE_", "INTO_", "VALUES_", "TABLE_", "INDEX_", "VIEW_", "AS_",
-	"ON_", "INT_", "VAR_CHAR_", "AND_", "OR_", "STAR", "EQUAL", "NOT_EQUAL",
-	"COMMA", "SEMI_COLON", "IDENT", "INT_LITERAL", "STR_LITERAL", "SPACES",
+	"SET_", "WHERE_", "INTO_", "VALUES_", "TABLE_", "INDEX_", "USING_", "FORMAT_",
+	"VIEW_", "AS_", "ON_", "INT_", "BIGINT_", "BOOLEAN_", "DOUBLE_", "REAL_",
+	"DATE_", "TIMESTAMP_", "BLOB_", "TRUE_", "FALSE_", "VAR_CHAR_", "AND_",
+	"OR_", "NOT_", "GROUP_", "BY_", "HAVING_", "ORDER_", "ASC_", "DESC_", "COUNT_",
+	"SUM_", "MIN_", "MAX_", "AVG_", "JOIN_", "INNER_", "LEFT_", "RIGHT_", "FULL_",
+	"OUTER_", "CROSS_", "IS_", "NULL_", "STAR", "PLUS", "MINUS", "SLASH", "PERCENT",
+	"CONCAT", "EQUAL", "NOT_EQUAL", "LESS", "LESS_EQUAL", "GREATER", "GREATER_EQUAL",
+	"COMMA", "DOT", "SEMI_COLON", "IDENT", "INT_LITERAL", "FLOAT_LITERAL",
+	"STR_LITERAL", "BLOB_LITERAL", "SPACES", "LINE_COMMENT", "BLOCK_COMMENT",
 }
 
 var ruleNames = []string{
 	"parse", "statementList", "statement", "create_table_stmt", "field_specs",
 	"field_spec", "type_spec", "varchar_spec", "insert_stmt", "constant_list",
-	"select_stmt", "ident_list", "update_stmt", "update_expr_list", "update_expr",
-	"delete_stmt", "create_view_stmt", "create_index_stmt", "condition", "term",
-	"expression", "literal",
+	"constant", "select_stmt", "select_list", "select_expr", "from_list", "from_item",
+	"table_ref", "join_clause", "join_type", "order_list", "order_expr", "ident_list",
+	"column_list", "update_stmt", "update_expr_list", "update_expr", "delete_stmt",
+	"create_view_stmt", "create_index_stmt", "condition", "and_condition",
+	"not_condition", "term", "expression", "mul_expression", "unary_expression",
+	"primary_expression", "aggregate", "column_ref", "literal",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -148,63 +277,127 @@ func NewSimpleSqlParser(input antlr.TokenStream) *SimpleSqlParser {
 
 // SimpleSqlParser tokens.
 const (
-	SimpleSqlParserEOF         = antlr.TokenEOF
-	SimpleSqlParserT__0        = 1
-	SimpleSqlParserT__1        = 2
-	SimpleSqlParserCREATE_     = 3
-	SimpleSqlParserINSERT_     = 4
-	SimpleSqlParserSELECT_     = 5
-	SimpleSqlParserUPDATE_     = 6
-	SimpleSqlParserDELETE_     = 7
-	SimpleSqlParserFROM_       = 8
-	SimpleSqlParserSET_        = 9
-	SimpleSqlParserWHERE_      = 10
-	SimpleSqlParserINTO_       = 11
-	SimpleSqlParserVALUES_     = 12
-	SimpleSqlParserTABLE_      = 13
-	SimpleSqlParserINDEX_      = 14
-	SimpleSqlParserVIEW_       = 15
-	SimpleSqlParserAS_         = 16
-	SimpleSqlParserON_         = 17
-	SimpleSqlParserINT_        = 18
-	SimpleSqlParserVAR_CHAR_   = 19
-	SimpleSqlParserAND_        = 20
-	SimpleSqlParserOR_         = 21
-	SimpleSqlParserSTAR        = 22
-	SimpleSqlParserEQUAL       = 23
-	SimpleSqlParserNOT_EQUAL   = 24
-	SimpleSqlParserCOMMA       = 25
-	SimpleSqlParserSEMI_COLON  = 26
-	SimpleSqlParserIDENT       = 27
-	SimpleSqlParserINT_LITERAL = 28
-	SimpleSqlParserSTR_LITERAL = 29
-	SimpleSqlParserSPACES      = 30
+	SimpleSqlParserEOF           = antlr.TokenEOF
+	SimpleSqlParserT__0          = 1
+	SimpleSqlParserT__1          = 2
+	SimpleSqlParserCREATE_       = 3
+	SimpleSqlParserINSERT_       = 4
+	SimpleSqlParserSELECT_       = 5
+	SimpleSqlParserUPDATE_       = 6
+	SimpleSqlParserDELETE_       = 7
+	SimpleSqlParserFROM_         = 8
+	SimpleSqlParserSET_          = 9
+	SimpleSqlParserWHERE_        = 10
+	SimpleSqlParserINTO_         = 11
+	SimpleSqlParserVALUES_       = 12
+	SimpleSqlParserTABLE_        = 13
+	SimpleSqlParserINDEX_        = 14
+	SimpleSqlParserUSING_        = 15
+	SimpleSqlParserFORMAT_       = 16
+	SimpleSqlParserVIEW_         = 17
+	SimpleSqlParserAS_           = 18
+	SimpleSqlParserON_           = 19
+	SimpleSqlParserINT_          = 20
+	SimpleSqlParserBIGINT_       = 21
+	SimpleSqlParserBOOLEAN_      = 22
+	SimpleSqlParserDOUBLE_       = 23
+	SimpleSqlParserREAL_         = 24
+	SimpleSqlParserDATE_         = 25
+	SimpleSqlParserTIMESTAMP_    = 26
+	SimpleSqlParserBLOB_         = 27
+	SimpleSqlParserTRUE_         = 28
+	SimpleSqlParserFALSE_        = 29
+	SimpleSqlParserVAR_CHAR_     = 30
+	SimpleSqlParserAND_          = 31
+	SimpleSqlParserOR_           = 32
+	SimpleSqlParserNOT_          = 33
+	SimpleSqlParserGROUP_        = 34
+	SimpleSqlParserBY_           = 35
+	SimpleSqlParserHAVING_       = 36
+	SimpleSqlParserORDER_        = 37
+	SimpleSqlParserASC_          = 38
+	SimpleSqlParserDESC_         = 39
+	SimpleSqlParserCOUNT_        = 40
+	SimpleSqlParserSUM_          = 41
+	SimpleSqlParserMIN_          = 42
+	SimpleSqlParserMAX_          = 43
+	SimpleSqlParserAVG_          = 44
+	SimpleSqlParserJOIN_         = 45
+	SimpleSqlParserINNER_        = 46
+	SimpleSqlParserLEFT_         = 47
+	SimpleSqlParserRIGHT_        = 48
+	SimpleSqlParserFULL_         = 49
+	SimpleSqlParserOUTER_        = 50
+	SimpleSqlParserCROSS_        = 51
+	SimpleSqlParserIS_           = 52
+	SimpleSqlParserNULL_         = 53
+	SimpleSqlParserSTAR          = 54
+	SimpleSqlParserPLUS          = 55
+	SimpleSqlParserMINUS         = 56
+	SimpleSqlParserSLASH         = 57
+	SimpleSqlParserPERCENT       = 58
+	SimpleSqlParserCONCAT        = 59
+	SimpleSqlParserEQUAL         = 60
+	SimpleSqlParserNOT_EQUAL     = 61
+	SimpleSqlParserLESS          = 62
+	SimpleSqlParserLESS_EQUAL    = 63
+	SimpleSqlParserGREATER       = 64
+	SimpleSqlParserGREATER_EQUAL = 65
+	SimpleSqlParserCOMMA         = 66
+	SimpleSqlParserDOT           = 67
+	SimpleSqlParserSEMI_COLON    = 68
+	SimpleSqlParserIDENT         = 69
+	SimpleSqlParserINT_LITERAL   = 70
+	SimpleSqlParserFLOAT_LITERAL = 71
+	SimpleSqlParserSTR_LITERAL   = 72
+	SimpleSqlParserBLOB_LITERAL  = 73
+	SimpleSqlParserSPACES        = 74
+	SimpleSqlParserLINE_COMMENT  = 75
+	SimpleSqlParserBLOCK_COMMENT = 76
 )
 
 // SimpleSqlParser rules.
 const (
-	SimpleSqlParserRULE_parse             = 0
-	SimpleSqlParserRULE_statementList     = 1
-	SimpleSqlParserRULE_statement         = 2
-	SimpleSqlParserRULE_create_table_stmt = 3
-	SimpleSqlParserRULE_field_specs       = 4
-	SimpleSqlParserRULE_field_spec        = 5
-	SimpleSqlParserRULE_type_spec         = 6
-	SimpleSqlParserRULE_varchar_spec      = 7
-	SimpleSqlParserRULE_insert_stmt       = 8
-	SimpleSqlParserRULE_constant_list     = 9
-	SimpleSqlParserRULE_select_stmt       = 10
-	SimpleSqlParserRULE_ident_list        = 11
-	SimpleSqlParserRULE_update_stmt       = 12
-	SimpleSqlParserRULE_update_expr_list  = 13
-	SimpleSqlParserRULE_update_expr       = 14
-	SimpleSqlParserRULE_delete_stmt       = 15
-	SimpleSqlParserRULE_create_view_stmt  = 16
-	SimpleSqlParserRULE_create_index_stmt = 17
-	SimpleSqlParserRULE_condition         = 18
-	SimpleSqlParserRULE_term              = 19
-	SimpleSqlParserRULE_expression        = 20
-	SimpleSqlParserRULE_literal           = 21
+	SimpleSqlParserRULE_parse              = 0
+	SimpleSqlParserRULE_statementList      = 1
+	SimpleSqlParserRULE_statement          = 2
+	SimpleSqlParserRULE_create_table_stmt  = 3
+	SimpleSqlParserRULE_field_specs        = 4
+	SimpleSqlParserRULE_field_spec         = 5
+	SimpleSqlParserRULE_type_spec          = 6
+	SimpleSqlParserRULE_varchar_spec       = 7
+	SimpleSqlParserRULE_insert_stmt        = 8
+	SimpleSqlParserRULE_constant_list      = 9
+	SimpleSqlParserRULE_constant           = 10
+	SimpleSqlParserRULE_select_stmt        = 11
+	SimpleSqlParserRULE_select_list        = 12
+	SimpleSqlParserRULE_select_expr        = 13
+	SimpleSqlParserRULE_from_list          = 14
+	SimpleSqlParserRULE_from_item          = 15
+	SimpleSqlParserRULE_table_ref          = 16
+	SimpleSqlParserRULE_join_clause        = 17
+	SimpleSqlParserRULE_join_type          = 18
+	SimpleSqlParserRULE_order_list         = 19
+	SimpleSqlParserRULE_order_expr         = 20
+	SimpleSqlParserRULE_ident_list         = 21
+	SimpleSqlParserRULE_column_list        = 22
+	SimpleSqlParserRULE_update_stmt        = 23
+	SimpleSqlParserRULE_update_expr_list   = 24
+	SimpleSqlParserRULE_update_expr        = 25
+	SimpleSqlParserRULE_delete_stmt        = 26
+	SimpleSqlParserRULE_create_view_stmt   = 27
+	SimpleSqlParserRULE_create_index_stmt  = 28
+	SimpleSqlParserRULE_condition          = 29
+	SimpleSqlParserRULE_and_condition      = 30
+	SimpleSqlParserRULE_not_condition      = 31
+	SimpleSqlParserRULE_term               = 32
+	SimpleSqlParserRULE_expression         = 33
+	SimpleSqlParserRULE_mul_expression     = 34
+	SimpleSqlParserRULE_unary_expression   = 35
+	SimpleSqlParserRULE_primary_expression = 36
+	SimpleSqlParserRULE_aggregate          = 37
+	SimpleSqlParserRULE_column_ref         = 38
+	SimpleSqlParserRULE_literal            = 39
 )
 
 // IParseContext is an interface to support dynamic dispatch.
@@ -249,21 +442,8 @@ func (s *ParseContext) EOF() antlr.TerminalNode {
 	return s.GetToken(SimpleSqlParserEOF, 0)
 }
 
-func (s *ParseContext) AllStatementList() []IStatementListContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementListContext)(nil)).Elem())
-	var tst = make([]IStatementListContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IStatementListContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *ParseContext) StatementList(i int) IStatementListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), i)
+func (s *ParseContext) StatementList() IStatementListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -312,22 +492,19 @@ func (p *SimpleSqlParser) Parse() (localctx IParseContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(47)
+	p.SetState(81)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimpleSqlParserCREATE_)|(1<<SimpleSqlParserINSERT_)|(1<<SimpleSqlParserSELECT_)|(1<<SimpleSqlParserUPDATE_)|(1<<SimpleSqlParserDELETE_))) != 0 {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SimpleSqlParserCREATE_)|(1<<SimpleSqlParserINSERT_)|(1<<SimpleSqlParserSELECT_)|(1<<SimpleSqlParserUPDATE_)|(1<<SimpleSqlParserDELETE_))) != 0) || _la == SimpleSqlParserSEMI_COLON {
 		{
-			p.SetState(44)
+			p.SetState(80)
 			p.StatementList()
 		}
 
-		p.SetState(49)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(50)
+		p.SetState(83)
 		p.Match(SimpleSqlParserEOF)
 	}
 
@@ -442,26 +619,67 @@ func (p *SimpleSqlParser) StatementList() (localctx IStatementListContext) {
 		}
 	}()
 
+	var _alt int
 	p.EnterOuterAlt(localctx, 1)
+	p.SetState(88)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserSEMI_COLON {
+		{
+			p.SetState(85)
+			p.Match(SimpleSqlParserSEMI_COLON)
+		}
+
+		p.SetState(90)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
 	{
-		p.SetState(52)
+		p.SetState(91)
 		p.Statement()
 	}
-	p.SetState(57)
+	p.SetState(100)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(93)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for ok := true; ok; ok = _la == SimpleSqlParserSEMI_COLON {
+				{
+					p.SetState(92)
+					p.Match(SimpleSqlParserSEMI_COLON)
+				}
+
+				p.SetState(95)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+			{
+				p.SetState(97)
+				p.Statement()
+			}
+
+		}
+		p.SetState(102)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+	}
+	p.SetState(106)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SimpleSqlParserSEMI_COLON {
 		{
-			p.SetState(53)
+			p.SetState(103)
 			p.Match(SimpleSqlParserSEMI_COLON)
 		}
-		{
-			p.SetState(54)
-			p.Statement()
-		}
 
-		p.SetState(59)
+		p.SetState(108)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -615,55 +833,55 @@ func (p *SimpleSqlParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(67)
+	p.SetState(116)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 5, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(60)
+			p.SetState(109)
 			p.Create_table_stmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(61)
+			p.SetState(110)
 			p.Insert_stmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(62)
+			p.SetState(111)
 			p.Select_stmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(63)
+			p.SetState(112)
 			p.Update_stmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(64)
+			p.SetState(113)
 			p.Delete_stmt()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(65)
+			p.SetState(114)
 			p.Create_view_stmt()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(66)
+			p.SetState(115)
 			p.Create_index_stmt()
 		}
 
@@ -679,6 +897,12 @@ type ICreate_table_stmtContext interface {
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
+	// GetFormat returns the format token.
+	GetFormat() antlr.Token
+
+	// SetFormat sets the format token.
+	SetFormat(antlr.Token)
+
 	// IsCreate_table_stmtContext differentiates from other interfaces.
 	IsCreate_table_stmtContext()
 }
@@ -686,6 +910,7 @@ type ICreate_table_stmtContext interface {
 type Create_table_stmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
+	format antlr.Token
 }
 
 func NewEmptyCreate_table_stmtContext() *Create_table_stmtContext {
@@ -710,6 +935,10 @@ func NewCreate_table_stmtContext(parser antlr.Parser, parent antlr.ParserRuleCon
 
 func (s *Create_table_stmtContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *Create_table_stmtContext) GetFormat() antlr.Token { return s.format }
+
+func (s *Create_table_stmtContext) SetFormat(v antlr.Token) { s.format = v }
+
 func (s *Create_table_stmtContext) CREATE_() antlr.TerminalNode {
 	return s.GetToken(SimpleSqlParserCREATE_, 0)
 }
@@ -718,8 +947,12 @@ func (s *Create_table_stmtContext) TABLE_() antlr.TerminalNode {
 	return s.GetToken(SimpleSqlParserTABLE_, 0)
 }
 
-func (s *Create_table_stmtContext) IDENT() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, 0)
+func (s *Create_table_stmtContext) AllIDENT() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserIDENT)
+}
+
+func (s *Create_table_stmtContext) IDENT(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, i)
 }
 
 func (s *Create_table_stmtContext) Field_specs() IField_specsContext {
@@ -732,6 +965,10 @@ func (s *Create_table_stmtContext) Field_specs() IField_specsContext {
 	return t.(IField_specsContext)
 }
 
+func (s *Create_table_stmtContext) FORMAT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserFORMAT_, 0)
+}
+
 func (s *Create_table_stmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -753,6 +990,7 @@ func (s *Create_table_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interf
 func (p *SimpleSqlParser) Create_table_stmt() (localctx ICreate_table_stmtContext) {
 	localctx = NewCreate_table_stmtContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 6, SimpleSqlParserRULE_create_table_stmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -772,29 +1010,47 @@ func (p *SimpleSqlParser) Create_table_stmt() (localctx ICreate_table_stmtContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(69)
+		p.SetState(118)
 		p.Match(SimpleSqlParserCREATE_)
 	}
 	{
-		p.SetState(70)
+		p.SetState(119)
 		p.Match(SimpleSqlParserTABLE_)
 	}
 	{
-		p.SetState(71)
+		p.SetState(120)
 		p.Match(SimpleSqlParserIDENT)
 	}
 	{
-		p.SetState(72)
+		p.SetState(121)
 		p.Match(SimpleSqlParserT__0)
 	}
 	{
-		p.SetState(73)
+		p.SetState(122)
 		p.Field_specs()
 	}
 	{
-		p.SetState(74)
+		p.SetState(123)
 		p.Match(SimpleSqlParserT__1)
 	}
+	p.SetState(126)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserFORMAT_ {
+		{
+			p.SetState(124)
+			p.Match(SimpleSqlParserFORMAT_)
+		}
+		{
+			p.SetState(125)
+
+			var _m = p.Match(SimpleSqlParserIDENT)
+
+			localctx.(*Create_table_stmtContext).format = _m
+		}
+
+	}
 
 	return localctx
 }
@@ -909,24 +1165,24 @@ func (p *SimpleSqlParser) Field_specs() (localctx IField_specsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(76)
+		p.SetState(128)
 		p.Field_spec()
 	}
-	p.SetState(81)
+	p.SetState(133)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SimpleSqlParserCOMMA {
 		{
-			p.SetState(77)
+			p.SetState(129)
 			p.Match(SimpleSqlParserCOMMA)
 		}
 		{
-			p.SetState(78)
+			p.SetState(130)
 			p.Field_spec()
 		}
 
-		p.SetState(83)
+		p.SetState(135)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -1026,11 +1282,11 @@ func (p *SimpleSqlParser) Field_spec() (localctx IField_specContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(84)
+		p.SetState(136)
 		p.Match(SimpleSqlParserIDENT)
 	}
 	{
-		p.SetState(85)
+		p.SetState(137)
 		p.Type_spec()
 	}
 
@@ -1079,6 +1335,34 @@ func (s *Type_specContext) INT_() antlr.TerminalNode {
 	return s.GetToken(SimpleSqlParserINT_, 0)
 }
 
+func (s *Type_specContext) BIGINT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserBIGINT_, 0)
+}
+
+func (s *Type_specContext) BOOLEAN_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserBOOLEAN_, 0)
+}
+
+func (s *Type_specContext) DOUBLE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserDOUBLE_, 0)
+}
+
+func (s *Type_specContext) REAL_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserREAL_, 0)
+}
+
+func (s *Type_specContext) DATE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserDATE_, 0)
+}
+
+func (s *Type_specContext) TIMESTAMP_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserTIMESTAMP_, 0)
+}
+
+func (s *Type_specContext) BLOB_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserBLOB_, 0)
+}
+
 func (s *Type_specContext) Varchar_spec() IVarchar_specContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVarchar_specContext)(nil)).Elem(), 0)
 
@@ -1127,21 +1411,70 @@ func (p *SimpleSqlParser) Type_spec() (localctx IType_specContext) {
 		}
 	}()
 
-	p.SetState(89)
+	p.SetState(148)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SimpleSqlParserINT_:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(87)
+			p.SetState(139)
 			p.Match(SimpleSqlParserINT_)
 		}
 
-	case SimpleSqlParserVAR_CHAR_:
+	case SimpleSqlParserBIGINT_:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(88)
+			p.SetState(140)
+			p.Match(SimpleSqlParserBIGINT_)
+		}
+
+	case SimpleSqlParserBOOLEAN_:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(141)
+			p.Match(SimpleSqlParserBOOLEAN_)
+		}
+
+	case SimpleSqlParserDOUBLE_:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(142)
+			p.Match(SimpleSqlParserDOUBLE_)
+		}
+
+	case SimpleSqlParserREAL_:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(143)
+			p.Match(SimpleSqlParserREAL_)
+		}
+
+	case SimpleSqlParserDATE_:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(144)
+			p.Match(SimpleSqlParserDATE_)
+		}
+
+	case SimpleSqlParserTIMESTAMP_:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(145)
+			p.Match(SimpleSqlParserTIMESTAMP_)
+		}
+
+	case SimpleSqlParserBLOB_:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(146)
+			p.Match(SimpleSqlParserBLOB_)
+		}
+
+	case SimpleSqlParserVAR_CHAR_:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(147)
 			p.Varchar_spec()
 		}
 
@@ -1238,19 +1571,19 @@ func (p *SimpleSqlParser) Varchar_spec() (localctx IVarchar_specContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(91)
+		p.SetState(150)
 		p.Match(SimpleSqlParserVAR_CHAR_)
 	}
 	{
-		p.SetState(92)
+		p.SetState(151)
 		p.Match(SimpleSqlParserT__0)
 	}
 	{
-		p.SetState(93)
+		p.SetState(152)
 		p.Match(SimpleSqlParserINT_LITERAL)
 	}
 	{
-		p.SetState(94)
+		p.SetState(153)
 		p.Match(SimpleSqlParserT__1)
 	}
 
@@ -1372,50 +1705,50 @@ func (p *SimpleSqlParser) Insert_stmt() (localctx IInsert_stmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(96)
+		p.SetState(155)
 		p.Match(SimpleSqlParserINSERT_)
 	}
 	{
-		p.SetState(97)
+		p.SetState(156)
 		p.Match(SimpleSqlParserINTO_)
 	}
 	{
-		p.SetState(98)
+		p.SetState(157)
 		p.Match(SimpleSqlParserIDENT)
 	}
-	p.SetState(103)
+	p.SetState(162)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SimpleSqlParserT__0 {
 		{
-			p.SetState(99)
+			p.SetState(158)
 			p.Match(SimpleSqlParserT__0)
 		}
 		{
-			p.SetState(100)
+			p.SetState(159)
 			p.Ident_list()
 		}
 		{
-			p.SetState(101)
+			p.SetState(160)
 			p.Match(SimpleSqlParserT__1)
 		}
 
 	}
 	{
-		p.SetState(105)
+		p.SetState(164)
 		p.Match(SimpleSqlParserVALUES_)
 	}
 	{
-		p.SetState(106)
+		p.SetState(165)
 		p.Match(SimpleSqlParserT__0)
 	}
 	{
-		p.SetState(107)
+		p.SetState(166)
 		p.Constant_list()
 	}
 	{
-		p.SetState(108)
+		p.SetState(167)
 		p.Match(SimpleSqlParserT__1)
 	}
 
@@ -1460,27 +1793,27 @@ func NewConstant_listContext(parser antlr.Parser, parent antlr.ParserRuleContext
 
 func (s *Constant_listContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Constant_listContext) AllLiteral() []ILiteralContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ILiteralContext)(nil)).Elem())
-	var tst = make([]ILiteralContext, len(ts))
+func (s *Constant_listContext) AllConstant() []IConstantContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IConstantContext)(nil)).Elem())
+	var tst = make([]IConstantContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(ILiteralContext)
+			tst[i] = t.(IConstantContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *Constant_listContext) Literal(i int) ILiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), i)
+func (s *Constant_listContext) Constant(i int) IConstantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstantContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILiteralContext)
+	return t.(IConstantContext)
 }
 
 func (s *Constant_listContext) AllCOMMA() []antlr.TerminalNode {
@@ -1532,24 +1865,24 @@ func (p *SimpleSqlParser) Constant_list() (localctx IConstant_listContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(110)
-		p.Literal()
+		p.SetState(169)
+		p.Constant()
 	}
-	p.SetState(115)
+	p.SetState(174)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SimpleSqlParserCOMMA {
 		{
-			p.SetState(111)
+			p.SetState(170)
 			p.Match(SimpleSqlParserCOMMA)
 		}
 		{
-			p.SetState(112)
-			p.Literal()
+			p.SetState(171)
+			p.Constant()
 		}
 
-		p.SetState(117)
+		p.SetState(176)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -1557,114 +1890,87 @@ func (p *SimpleSqlParser) Constant_list() (localctx IConstant_listContext) {
 	return localctx
 }
 
-// ISelect_stmtContext is an interface to support dynamic dispatch.
-type ISelect_stmtContext interface {
+// IConstantContext is an interface to support dynamic dispatch.
+type IConstantContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSelect_stmtContext differentiates from other interfaces.
-	IsSelect_stmtContext()
+	// IsConstantContext differentiates from other interfaces.
+	IsConstantContext()
 }
 
-type Select_stmtContext struct {
+type ConstantContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelect_stmtContext() *Select_stmtContext {
-	var p = new(Select_stmtContext)
+func NewEmptyConstantContext() *ConstantContext {
+	var p = new(ConstantContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_select_stmt
+	p.RuleIndex = SimpleSqlParserRULE_constant
 	return p
 }
 
-func (*Select_stmtContext) IsSelect_stmtContext() {}
+func (*ConstantContext) IsConstantContext() {}
 
-func NewSelect_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Select_stmtContext {
-	var p = new(Select_stmtContext)
+func NewConstantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstantContext {
+	var p = new(ConstantContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_select_stmt
+	p.RuleIndex = SimpleSqlParserRULE_constant
 
 	return p
 }
 
-func (s *Select_stmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Select_stmtContext) SELECT_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserSELECT_, 0)
-}
-
-func (s *Select_stmtContext) FROM_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserFROM_, 0)
-}
-
-func (s *Select_stmtContext) AllIdent_list() []IIdent_listContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IIdent_listContext)(nil)).Elem())
-	var tst = make([]IIdent_listContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IIdent_listContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *Select_stmtContext) Ident_list(i int) IIdent_listContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdent_listContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
+func (s *ConstantContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IIdent_listContext)
+func (s *ConstantContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserMINUS, 0)
 }
 
-func (s *Select_stmtContext) STAR() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserSTAR, 0)
+func (s *ConstantContext) INT_LITERAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserINT_LITERAL, 0)
 }
 
-func (s *Select_stmtContext) WHERE_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserWHERE_, 0)
+func (s *ConstantContext) FLOAT_LITERAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserFLOAT_LITERAL, 0)
 }
 
-func (s *Select_stmtContext) Condition() IConditionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), 0)
+func (s *ConstantContext) Literal() ILiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IConditionContext)
+	return t.(ILiteralContext)
 }
 
-func (s *Select_stmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstantContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Select_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Select_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ConstantContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitSelect_stmt(s)
+		return t.VisitConstant(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Select_stmt() (localctx ISelect_stmtContext) {
-	localctx = NewSelect_stmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SimpleSqlParserRULE_select_stmt)
+func (p *SimpleSqlParser) Constant() (localctx IConstantContext) {
+	localctx = NewConstantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, SimpleSqlParserRULE_constant)
 	var _la int
 
 	defer func() {
@@ -1683,272 +1989,233 @@ func (p *SimpleSqlParser) Select_stmt() (localctx ISelect_stmtContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(118)
-		p.Match(SimpleSqlParserSELECT_)
-	}
-	p.SetState(121)
+	p.SetState(180)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SimpleSqlParserSTAR:
+	case SimpleSqlParserMINUS:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(119)
-			p.Match(SimpleSqlParserSTAR)
+			p.SetState(177)
+			p.Match(SimpleSqlParserMINUS)
 		}
-
-	case SimpleSqlParserIDENT:
 		{
-			p.SetState(120)
-			p.Ident_list()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-	}
-	{
-		p.SetState(123)
-		p.Match(SimpleSqlParserFROM_)
-	}
-	{
-		p.SetState(124)
-		p.Ident_list()
-	}
-	p.SetState(127)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+			p.SetState(178)
+			_la = p.GetTokenStream().LA(1)
 
-	if _la == SimpleSqlParserWHERE_ {
-		{
-			p.SetState(125)
-			p.Match(SimpleSqlParserWHERE_)
+			if !(_la == SimpleSqlParserINT_LITERAL || _la == SimpleSqlParserFLOAT_LITERAL) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
+
+	case SimpleSqlParserDATE_, SimpleSqlParserTIMESTAMP_, SimpleSqlParserTRUE_, SimpleSqlParserFALSE_, SimpleSqlParserNULL_, SimpleSqlParserINT_LITERAL, SimpleSqlParserFLOAT_LITERAL, SimpleSqlParserSTR_LITERAL, SimpleSqlParserBLOB_LITERAL:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(126)
-			p.Condition()
+			p.SetState(179)
+			p.Literal()
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IIdent_listContext is an interface to support dynamic dispatch.
-type IIdent_listContext interface {
+// ISelect_stmtContext is an interface to support dynamic dispatch.
+type ISelect_stmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsIdent_listContext differentiates from other interfaces.
-	IsIdent_listContext()
+	// GetWhere returns the where rule contexts.
+	GetWhere() IConditionContext
+
+	// GetGroups returns the groups rule contexts.
+	GetGroups() IColumn_listContext
+
+	// GetHaving returns the having rule contexts.
+	GetHaving() IConditionContext
+
+	// SetWhere sets the where rule contexts.
+	SetWhere(IConditionContext)
+
+	// SetGroups sets the groups rule contexts.
+	SetGroups(IColumn_listContext)
+
+	// SetHaving sets the having rule contexts.
+	SetHaving(IConditionContext)
+
+	// IsSelect_stmtContext differentiates from other interfaces.
+	IsSelect_stmtContext()
 }
 
-type Ident_listContext struct {
+type Select_stmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
+	where  IConditionContext
+	groups IColumn_listContext
+	having IConditionContext
 }
 
-func NewEmptyIdent_listContext() *Ident_listContext {
-	var p = new(Ident_listContext)
+func NewEmptySelect_stmtContext() *Select_stmtContext {
+	var p = new(Select_stmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_ident_list
+	p.RuleIndex = SimpleSqlParserRULE_select_stmt
 	return p
 }
 
-func (*Ident_listContext) IsIdent_listContext() {}
+func (*Select_stmtContext) IsSelect_stmtContext() {}
 
-func NewIdent_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Ident_listContext {
-	var p = new(Ident_listContext)
+func NewSelect_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Select_stmtContext {
+	var p = new(Select_stmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_ident_list
+	p.RuleIndex = SimpleSqlParserRULE_select_stmt
 
 	return p
 }
 
-func (s *Ident_listContext) GetParser() antlr.Parser { return s.parser }
+func (s *Select_stmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Ident_listContext) AllIDENT() []antlr.TerminalNode {
-	return s.GetTokens(SimpleSqlParserIDENT)
-}
+func (s *Select_stmtContext) GetWhere() IConditionContext { return s.where }
 
-func (s *Ident_listContext) IDENT(i int) antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, i)
-}
+func (s *Select_stmtContext) GetGroups() IColumn_listContext { return s.groups }
 
-func (s *Ident_listContext) AllCOMMA() []antlr.TerminalNode {
-	return s.GetTokens(SimpleSqlParserCOMMA)
-}
+func (s *Select_stmtContext) GetHaving() IConditionContext { return s.having }
 
-func (s *Ident_listContext) COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserCOMMA, i)
-}
+func (s *Select_stmtContext) SetWhere(v IConditionContext) { s.where = v }
 
-func (s *Ident_listContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
+func (s *Select_stmtContext) SetGroups(v IColumn_listContext) { s.groups = v }
 
-func (s *Ident_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
+func (s *Select_stmtContext) SetHaving(v IConditionContext) { s.having = v }
 
-func (s *Ident_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SimpleSqlVisitor:
-		return t.VisitIdent_list(s)
+func (s *Select_stmtContext) SELECT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserSELECT_, 0)
+}
 
-	default:
-		return t.VisitChildren(s)
-	}
+func (s *Select_stmtContext) FROM_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserFROM_, 0)
 }
 
-func (p *SimpleSqlParser) Ident_list() (localctx IIdent_listContext) {
-	localctx = NewIdent_listContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SimpleSqlParserRULE_ident_list)
-	var _la int
+func (s *Select_stmtContext) From_list() IFrom_listContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFrom_listContext)(nil)).Elem(), 0)
 
-	defer func() {
-		p.ExitRule()
-	}()
+	if t == nil {
+		return nil
+	}
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
+	return t.(IFrom_listContext)
+}
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(129)
-		p.Match(SimpleSqlParserIDENT)
-	}
-	p.SetState(134)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+func (s *Select_stmtContext) STAR() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserSTAR, 0)
+}
 
-	for _la == SimpleSqlParserCOMMA {
-		{
-			p.SetState(130)
-			p.Match(SimpleSqlParserCOMMA)
-		}
-		{
-			p.SetState(131)
-			p.Match(SimpleSqlParserIDENT)
-		}
+func (s *Select_stmtContext) Select_list() ISelect_listContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelect_listContext)(nil)).Elem(), 0)
 
-		p.SetState(136)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	if t == nil {
+		return nil
 	}
 
-	return localctx
+	return t.(ISelect_listContext)
 }
 
-// IUpdate_stmtContext is an interface to support dynamic dispatch.
-type IUpdate_stmtContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
+func (s *Select_stmtContext) WHERE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserWHERE_, 0)
+}
 
-	// IsUpdate_stmtContext differentiates from other interfaces.
-	IsUpdate_stmtContext()
+func (s *Select_stmtContext) GROUP_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserGROUP_, 0)
 }
 
-type Update_stmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
+func (s *Select_stmtContext) AllBY_() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserBY_)
 }
 
-func NewEmptyUpdate_stmtContext() *Update_stmtContext {
-	var p = new(Update_stmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_update_stmt
-	return p
+func (s *Select_stmtContext) BY_(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserBY_, i)
 }
 
-func (*Update_stmtContext) IsUpdate_stmtContext() {}
+func (s *Select_stmtContext) HAVING_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserHAVING_, 0)
+}
 
-func NewUpdate_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Update_stmtContext {
-	var p = new(Update_stmtContext)
+func (s *Select_stmtContext) ORDER_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserORDER_, 0)
+}
 
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+func (s *Select_stmtContext) Order_list() IOrder_listContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrder_listContext)(nil)).Elem(), 0)
 
-	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_update_stmt
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IOrder_listContext)
 }
 
-func (s *Update_stmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *Update_stmtContext) UPDATE_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserUPDATE_, 0)
-}
+func (s *Select_stmtContext) AllCondition() []IConditionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IConditionContext)(nil)).Elem())
+	var tst = make([]IConditionContext, len(ts))
 
-func (s *Update_stmtContext) IDENT() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, 0)
-}
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IConditionContext)
+		}
+	}
 
-func (s *Update_stmtContext) SET_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserSET_, 0)
+	return tst
 }
 
-func (s *Update_stmtContext) Update_expr_list() IUpdate_expr_listContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUpdate_expr_listContext)(nil)).Elem(), 0)
+func (s *Select_stmtContext) Condition(i int) IConditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IUpdate_expr_listContext)
-}
-
-func (s *Update_stmtContext) WHERE_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserWHERE_, 0)
+	return t.(IConditionContext)
 }
 
-func (s *Update_stmtContext) Condition() IConditionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), 0)
+func (s *Select_stmtContext) Column_list() IColumn_listContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumn_listContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IConditionContext)
+	return t.(IColumn_listContext)
 }
 
-func (s *Update_stmtContext) GetRuleContext() antlr.RuleContext {
+func (s *Select_stmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Update_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Select_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Update_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *Select_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitUpdate_stmt(s)
+		return t.VisitSelect_stmt(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Update_stmt() (localctx IUpdate_stmtContext) {
-	localctx = NewUpdate_stmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SimpleSqlParserRULE_update_stmt)
+func (p *SimpleSqlParser) Select_stmt() (localctx ISelect_stmtContext) {
+	localctx = NewSelect_stmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SimpleSqlParserRULE_select_stmt)
 	var _la int
 
 	defer func() {
@@ -1969,33 +2236,110 @@ func (p *SimpleSqlParser) Update_stmt() (localctx IUpdate_stmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(137)
-		p.Match(SimpleSqlParserUPDATE_)
+		p.SetState(182)
+		p.Match(SimpleSqlParserSELECT_)
 	}
-	{
-		p.SetState(138)
-		p.Match(SimpleSqlParserIDENT)
+	p.SetState(185)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SimpleSqlParserSTAR:
+		{
+			p.SetState(183)
+			p.Match(SimpleSqlParserSTAR)
+		}
+
+	case SimpleSqlParserT__0, SimpleSqlParserDATE_, SimpleSqlParserTIMESTAMP_, SimpleSqlParserTRUE_, SimpleSqlParserFALSE_, SimpleSqlParserCOUNT_, SimpleSqlParserSUM_, SimpleSqlParserMIN_, SimpleSqlParserMAX_, SimpleSqlParserAVG_, SimpleSqlParserNULL_, SimpleSqlParserMINUS, SimpleSqlParserIDENT, SimpleSqlParserINT_LITERAL, SimpleSqlParserFLOAT_LITERAL, SimpleSqlParserSTR_LITERAL, SimpleSqlParserBLOB_LITERAL:
+		{
+			p.SetState(184)
+			p.Select_list()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(139)
-		p.Match(SimpleSqlParserSET_)
+		p.SetState(187)
+		p.Match(SimpleSqlParserFROM_)
 	}
 	{
-		p.SetState(140)
-		p.Update_expr_list()
+		p.SetState(188)
+		p.From_list()
 	}
-	p.SetState(143)
+	p.SetState(191)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SimpleSqlParserWHERE_ {
 		{
-			p.SetState(141)
+			p.SetState(189)
 			p.Match(SimpleSqlParserWHERE_)
 		}
 		{
-			p.SetState(142)
-			p.Condition()
+			p.SetState(190)
+
+			var _x = p.Condition()
+
+			localctx.(*Select_stmtContext).where = _x
+		}
+
+	}
+	p.SetState(196)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserGROUP_ {
+		{
+			p.SetState(193)
+			p.Match(SimpleSqlParserGROUP_)
+		}
+		{
+			p.SetState(194)
+			p.Match(SimpleSqlParserBY_)
+		}
+		{
+			p.SetState(195)
+
+			var _x = p.Column_list()
+
+			localctx.(*Select_stmtContext).groups = _x
+		}
+
+	}
+	p.SetState(200)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserHAVING_ {
+		{
+			p.SetState(198)
+			p.Match(SimpleSqlParserHAVING_)
+		}
+		{
+			p.SetState(199)
+
+			var _x = p.Condition()
+
+			localctx.(*Select_stmtContext).having = _x
+		}
+
+	}
+	p.SetState(205)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserORDER_ {
+		{
+			p.SetState(202)
+			p.Match(SimpleSqlParserORDER_)
+		}
+		{
+			p.SetState(203)
+			p.Match(SimpleSqlParserBY_)
+		}
+		{
+			p.SetState(204)
+			p.Order_list()
 		}
 
 	}
@@ -2003,96 +2347,96 @@ func (p *SimpleSqlParser) Update_stmt() (localctx IUpdate_stmtContext) {
 	return localctx
 }
 
-// IUpdate_expr_listContext is an interface to support dynamic dispatch.
-type IUpdate_expr_listContext interface {
+// ISelect_listContext is an interface to support dynamic dispatch.
+type ISelect_listContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsUpdate_expr_listContext differentiates from other interfaces.
-	IsUpdate_expr_listContext()
+	// IsSelect_listContext differentiates from other interfaces.
+	IsSelect_listContext()
 }
 
-type Update_expr_listContext struct {
+type Select_listContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyUpdate_expr_listContext() *Update_expr_listContext {
-	var p = new(Update_expr_listContext)
+func NewEmptySelect_listContext() *Select_listContext {
+	var p = new(Select_listContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_update_expr_list
+	p.RuleIndex = SimpleSqlParserRULE_select_list
 	return p
 }
 
-func (*Update_expr_listContext) IsUpdate_expr_listContext() {}
+func (*Select_listContext) IsSelect_listContext() {}
 
-func NewUpdate_expr_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Update_expr_listContext {
-	var p = new(Update_expr_listContext)
+func NewSelect_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Select_listContext {
+	var p = new(Select_listContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_update_expr_list
+	p.RuleIndex = SimpleSqlParserRULE_select_list
 
 	return p
 }
 
-func (s *Update_expr_listContext) GetParser() antlr.Parser { return s.parser }
+func (s *Select_listContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Update_expr_listContext) AllUpdate_expr() []IUpdate_exprContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IUpdate_exprContext)(nil)).Elem())
-	var tst = make([]IUpdate_exprContext, len(ts))
+func (s *Select_listContext) AllSelect_expr() []ISelect_exprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISelect_exprContext)(nil)).Elem())
+	var tst = make([]ISelect_exprContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IUpdate_exprContext)
+			tst[i] = t.(ISelect_exprContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *Update_expr_listContext) Update_expr(i int) IUpdate_exprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUpdate_exprContext)(nil)).Elem(), i)
+func (s *Select_listContext) Select_expr(i int) ISelect_exprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelect_exprContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IUpdate_exprContext)
+	return t.(ISelect_exprContext)
 }
 
-func (s *Update_expr_listContext) AllCOMMA() []antlr.TerminalNode {
+func (s *Select_listContext) AllCOMMA() []antlr.TerminalNode {
 	return s.GetTokens(SimpleSqlParserCOMMA)
 }
 
-func (s *Update_expr_listContext) COMMA(i int) antlr.TerminalNode {
+func (s *Select_listContext) COMMA(i int) antlr.TerminalNode {
 	return s.GetToken(SimpleSqlParserCOMMA, i)
 }
 
-func (s *Update_expr_listContext) GetRuleContext() antlr.RuleContext {
+func (s *Select_listContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Update_expr_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Select_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Update_expr_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *Select_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitUpdate_expr_list(s)
+		return t.VisitSelect_list(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Update_expr_list() (localctx IUpdate_expr_listContext) {
-	localctx = NewUpdate_expr_listContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SimpleSqlParserRULE_update_expr_list)
+func (p *SimpleSqlParser) Select_list() (localctx ISelect_listContext) {
+	localctx = NewSelect_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, SimpleSqlParserRULE_select_list)
 	var _la int
 
 	defer func() {
@@ -2113,24 +2457,24 @@ func (p *SimpleSqlParser) Update_expr_list() (localctx IUpdate_expr_listContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(145)
-		p.Update_expr()
+		p.SetState(207)
+		p.Select_expr()
 	}
-	p.SetState(150)
+	p.SetState(212)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SimpleSqlParserCOMMA {
 		{
-			p.SetState(146)
+			p.SetState(208)
 			p.Match(SimpleSqlParserCOMMA)
 		}
 		{
-			p.SetState(147)
-			p.Update_expr()
+			p.SetState(209)
+			p.Select_expr()
 		}
 
-		p.SetState(152)
+		p.SetState(214)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -2138,53 +2482,56 @@ func (p *SimpleSqlParser) Update_expr_list() (localctx IUpdate_expr_listContext)
 	return localctx
 }
 
-// IUpdate_exprContext is an interface to support dynamic dispatch.
-type IUpdate_exprContext interface {
+// ISelect_exprContext is an interface to support dynamic dispatch.
+type ISelect_exprContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsUpdate_exprContext differentiates from other interfaces.
-	IsUpdate_exprContext()
+	// GetAlias returns the alias token.
+	GetAlias() antlr.Token
+
+	// SetAlias sets the alias token.
+	SetAlias(antlr.Token)
+
+	// IsSelect_exprContext differentiates from other interfaces.
+	IsSelect_exprContext()
 }
 
-type Update_exprContext struct {
+type Select_exprContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
+	alias  antlr.Token
 }
 
-func NewEmptyUpdate_exprContext() *Update_exprContext {
-	var p = new(Update_exprContext)
+func NewEmptySelect_exprContext() *Select_exprContext {
+	var p = new(Select_exprContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_update_expr
+	p.RuleIndex = SimpleSqlParserRULE_select_expr
 	return p
 }
 
-func (*Update_exprContext) IsUpdate_exprContext() {}
+func (*Select_exprContext) IsSelect_exprContext() {}
 
-func NewUpdate_exprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Update_exprContext {
-	var p = new(Update_exprContext)
+func NewSelect_exprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Select_exprContext {
+	var p = new(Select_exprContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_update_expr
+	p.RuleIndex = SimpleSqlParserRULE_select_expr
 
 	return p
 }
 
-func (s *Update_exprContext) GetParser() antlr.Parser { return s.parser }
+func (s *Select_exprContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Update_exprContext) IDENT() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, 0)
-}
+func (s *Select_exprContext) GetAlias() antlr.Token { return s.alias }
 
-func (s *Update_exprContext) EQUAL() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserEQUAL, 0)
-}
+func (s *Select_exprContext) SetAlias(v antlr.Token) { s.alias = v }
 
-func (s *Update_exprContext) Expression() IExpressionContext {
+func (s *Select_exprContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -2194,27 +2541,36 @@ func (s *Update_exprContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *Update_exprContext) GetRuleContext() antlr.RuleContext {
+func (s *Select_exprContext) IDENT() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, 0)
+}
+
+func (s *Select_exprContext) AS_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserAS_, 0)
+}
+
+func (s *Select_exprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Update_exprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Select_exprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Update_exprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *Select_exprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitUpdate_expr(s)
+		return t.VisitSelect_expr(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Update_expr() (localctx IUpdate_exprContext) {
-	localctx = NewUpdate_exprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SimpleSqlParserRULE_update_expr)
+func (p *SimpleSqlParser) Select_expr() (localctx ISelect_exprContext) {
+	localctx = NewSelect_exprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, SimpleSqlParserRULE_select_expr)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -2234,106 +2590,2873 @@ func (p *SimpleSqlParser) Update_expr() (localctx IUpdate_exprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(153)
-		p.Match(SimpleSqlParserIDENT)
+		p.SetState(215)
+		p.Expression()
+	}
+	p.SetState(220)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserAS_ || _la == SimpleSqlParserIDENT {
+		p.SetState(217)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimpleSqlParserAS_ {
+			{
+				p.SetState(216)
+				p.Match(SimpleSqlParserAS_)
+			}
+
+		}
+		{
+			p.SetState(219)
+
+			var _m = p.Match(SimpleSqlParserIDENT)
+
+			localctx.(*Select_exprContext).alias = _m
+		}
+
+	}
+
+	return localctx
+}
+
+// IFrom_listContext is an interface to support dynamic dispatch.
+type IFrom_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFrom_listContext differentiates from other interfaces.
+	IsFrom_listContext()
+}
+
+type From_listContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFrom_listContext() *From_listContext {
+	var p = new(From_listContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_from_list
+	return p
+}
+
+func (*From_listContext) IsFrom_listContext() {}
+
+func NewFrom_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *From_listContext {
+	var p = new(From_listContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_from_list
+
+	return p
+}
+
+func (s *From_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *From_listContext) AllFrom_item() []IFrom_itemContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFrom_itemContext)(nil)).Elem())
+	var tst = make([]IFrom_itemContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IFrom_itemContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *From_listContext) From_item(i int) IFrom_itemContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFrom_itemContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFrom_itemContext)
+}
+
+func (s *From_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserCOMMA)
+}
+
+func (s *From_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCOMMA, i)
+}
+
+func (s *From_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *From_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *From_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitFrom_list(s)
+
+	default:
+		return t.VisitChildren(s)
 	}
+}
+
+func (p *SimpleSqlParser) From_list() (localctx IFrom_listContext) {
+	localctx = NewFrom_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, SimpleSqlParserRULE_from_list)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(154)
-		p.Match(SimpleSqlParserEQUAL)
+		p.SetState(222)
+		p.From_item()
+	}
+	p.SetState(227)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserCOMMA {
+		{
+			p.SetState(223)
+			p.Match(SimpleSqlParserCOMMA)
+		}
+		{
+			p.SetState(224)
+			p.From_item()
+		}
+
+		p.SetState(229)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IFrom_itemContext is an interface to support dynamic dispatch.
+type IFrom_itemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFrom_itemContext differentiates from other interfaces.
+	IsFrom_itemContext()
+}
+
+type From_itemContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFrom_itemContext() *From_itemContext {
+	var p = new(From_itemContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_from_item
+	return p
+}
+
+func (*From_itemContext) IsFrom_itemContext() {}
+
+func NewFrom_itemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *From_itemContext {
+	var p = new(From_itemContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_from_item
+
+	return p
+}
+
+func (s *From_itemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *From_itemContext) Table_ref() ITable_refContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITable_refContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITable_refContext)
+}
+
+func (s *From_itemContext) AllJoin_clause() []IJoin_clauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IJoin_clauseContext)(nil)).Elem())
+	var tst = make([]IJoin_clauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IJoin_clauseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *From_itemContext) Join_clause(i int) IJoin_clauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IJoin_clauseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJoin_clauseContext)
+}
+
+func (s *From_itemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *From_itemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *From_itemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitFrom_item(s)
+
+	default:
+		return t.VisitChildren(s)
 	}
+}
+
+func (p *SimpleSqlParser) From_item() (localctx IFrom_itemContext) {
+	localctx = NewFrom_itemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, SimpleSqlParserRULE_from_item)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(155)
-		p.Expression()
+		p.SetState(230)
+		p.Table_ref()
+	}
+	p.SetState(234)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(SimpleSqlParserJOIN_-45))|(1<<(SimpleSqlParserINNER_-45))|(1<<(SimpleSqlParserLEFT_-45))|(1<<(SimpleSqlParserRIGHT_-45))|(1<<(SimpleSqlParserFULL_-45))|(1<<(SimpleSqlParserCROSS_-45)))) != 0 {
+		{
+			p.SetState(231)
+			p.Join_clause()
+		}
+
+		p.SetState(236)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// IDelete_stmtContext is an interface to support dynamic dispatch.
-type IDelete_stmtContext interface {
+// ITable_refContext is an interface to support dynamic dispatch.
+type ITable_refContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDelete_stmtContext differentiates from other interfaces.
-	IsDelete_stmtContext()
+	// GetTable returns the table token.
+	GetTable() antlr.Token
+
+	// GetAlias returns the alias token.
+	GetAlias() antlr.Token
+
+	// SetTable sets the table token.
+	SetTable(antlr.Token)
+
+	// SetAlias sets the alias token.
+	SetAlias(antlr.Token)
+
+	// IsTable_refContext differentiates from other interfaces.
+	IsTable_refContext()
 }
 
-type Delete_stmtContext struct {
+type Table_refContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
+	table  antlr.Token
+	alias  antlr.Token
 }
 
-func NewEmptyDelete_stmtContext() *Delete_stmtContext {
-	var p = new(Delete_stmtContext)
+func NewEmptyTable_refContext() *Table_refContext {
+	var p = new(Table_refContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_table_ref
+	return p
+}
+
+func (*Table_refContext) IsTable_refContext() {}
+
+func NewTable_refContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Table_refContext {
+	var p = new(Table_refContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_table_ref
+
+	return p
+}
+
+func (s *Table_refContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Table_refContext) GetTable() antlr.Token { return s.table }
+
+func (s *Table_refContext) GetAlias() antlr.Token { return s.alias }
+
+func (s *Table_refContext) SetTable(v antlr.Token) { s.table = v }
+
+func (s *Table_refContext) SetAlias(v antlr.Token) { s.alias = v }
+
+func (s *Table_refContext) AllIDENT() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserIDENT)
+}
+
+func (s *Table_refContext) IDENT(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, i)
+}
+
+func (s *Table_refContext) AS_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserAS_, 0)
+}
+
+func (s *Table_refContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Table_refContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Table_refContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitTable_ref(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Table_ref() (localctx ITable_refContext) {
+	localctx = NewTable_refContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, SimpleSqlParserRULE_table_ref)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(237)
+
+		var _m = p.Match(SimpleSqlParserIDENT)
+
+		localctx.(*Table_refContext).table = _m
+	}
+	p.SetState(242)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserAS_ || _la == SimpleSqlParserIDENT {
+		p.SetState(239)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimpleSqlParserAS_ {
+			{
+				p.SetState(238)
+				p.Match(SimpleSqlParserAS_)
+			}
+
+		}
+		{
+			p.SetState(241)
+
+			var _m = p.Match(SimpleSqlParserIDENT)
+
+			localctx.(*Table_refContext).alias = _m
+		}
+
+	}
+
+	return localctx
+}
+
+// IJoin_clauseContext is an interface to support dynamic dispatch.
+type IJoin_clauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsJoin_clauseContext differentiates from other interfaces.
+	IsJoin_clauseContext()
+}
+
+type Join_clauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyJoin_clauseContext() *Join_clauseContext {
+	var p = new(Join_clauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_join_clause
+	return p
+}
+
+func (*Join_clauseContext) IsJoin_clauseContext() {}
+
+func NewJoin_clauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Join_clauseContext {
+	var p = new(Join_clauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_join_clause
+
+	return p
+}
+
+func (s *Join_clauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Join_clauseContext) CROSS_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCROSS_, 0)
+}
+
+func (s *Join_clauseContext) JOIN_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserJOIN_, 0)
+}
+
+func (s *Join_clauseContext) Table_ref() ITable_refContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITable_refContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITable_refContext)
+}
+
+func (s *Join_clauseContext) ON_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserON_, 0)
+}
+
+func (s *Join_clauseContext) Condition() IConditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionContext)
+}
+
+func (s *Join_clauseContext) Join_type() IJoin_typeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IJoin_typeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJoin_typeContext)
+}
+
+func (s *Join_clauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Join_clauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Join_clauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitJoin_clause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Join_clause() (localctx IJoin_clauseContext) {
+	localctx = NewJoin_clauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SimpleSqlParserRULE_join_clause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(255)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SimpleSqlParserCROSS_:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(244)
+			p.Match(SimpleSqlParserCROSS_)
+		}
+		{
+			p.SetState(245)
+			p.Match(SimpleSqlParserJOIN_)
+		}
+		{
+			p.SetState(246)
+			p.Table_ref()
+		}
+
+	case SimpleSqlParserJOIN_, SimpleSqlParserINNER_, SimpleSqlParserLEFT_, SimpleSqlParserRIGHT_, SimpleSqlParserFULL_:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(248)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if ((_la-46)&-(0x1f+1)) == 0 && ((1<<uint((_la-46)))&((1<<(SimpleSqlParserINNER_-46))|(1<<(SimpleSqlParserLEFT_-46))|(1<<(SimpleSqlParserRIGHT_-46))|(1<<(SimpleSqlParserFULL_-46)))) != 0 {
+			{
+				p.SetState(247)
+				p.Join_type()
+			}
+
+		}
+		{
+			p.SetState(250)
+			p.Match(SimpleSqlParserJOIN_)
+		}
+		{
+			p.SetState(251)
+			p.Table_ref()
+		}
+		{
+			p.SetState(252)
+			p.Match(SimpleSqlParserON_)
+		}
+		{
+			p.SetState(253)
+			p.Condition()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IJoin_typeContext is an interface to support dynamic dispatch.
+type IJoin_typeContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsJoin_typeContext differentiates from other interfaces.
+	IsJoin_typeContext()
+}
+
+type Join_typeContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyJoin_typeContext() *Join_typeContext {
+	var p = new(Join_typeContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_join_type
+	return p
+}
+
+func (*Join_typeContext) IsJoin_typeContext() {}
+
+func NewJoin_typeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Join_typeContext {
+	var p = new(Join_typeContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_join_type
+
+	return p
+}
+
+func (s *Join_typeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Join_typeContext) INNER_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserINNER_, 0)
+}
+
+func (s *Join_typeContext) LEFT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserLEFT_, 0)
+}
+
+func (s *Join_typeContext) RIGHT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserRIGHT_, 0)
+}
+
+func (s *Join_typeContext) FULL_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserFULL_, 0)
+}
+
+func (s *Join_typeContext) OUTER_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserOUTER_, 0)
+}
+
+func (s *Join_typeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Join_typeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Join_typeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitJoin_type(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Join_type() (localctx IJoin_typeContext) {
+	localctx = NewJoin_typeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, SimpleSqlParserRULE_join_type)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(262)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SimpleSqlParserINNER_:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(257)
+			p.Match(SimpleSqlParserINNER_)
+		}
+
+	case SimpleSqlParserLEFT_, SimpleSqlParserRIGHT_, SimpleSqlParserFULL_:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(258)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((_la-47)&-(0x1f+1)) == 0 && ((1<<uint((_la-47)))&((1<<(SimpleSqlParserLEFT_-47))|(1<<(SimpleSqlParserRIGHT_-47))|(1<<(SimpleSqlParserFULL_-47)))) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		p.SetState(260)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimpleSqlParserOUTER_ {
+			{
+				p.SetState(259)
+				p.Match(SimpleSqlParserOUTER_)
+			}
+
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IOrder_listContext is an interface to support dynamic dispatch.
+type IOrder_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOrder_listContext differentiates from other interfaces.
+	IsOrder_listContext()
+}
+
+type Order_listContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOrder_listContext() *Order_listContext {
+	var p = new(Order_listContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_order_list
+	return p
+}
+
+func (*Order_listContext) IsOrder_listContext() {}
+
+func NewOrder_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Order_listContext {
+	var p = new(Order_listContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_order_list
+
+	return p
+}
+
+func (s *Order_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Order_listContext) AllOrder_expr() []IOrder_exprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IOrder_exprContext)(nil)).Elem())
+	var tst = make([]IOrder_exprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IOrder_exprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *Order_listContext) Order_expr(i int) IOrder_exprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrder_exprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrder_exprContext)
+}
+
+func (s *Order_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserCOMMA)
+}
+
+func (s *Order_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCOMMA, i)
+}
+
+func (s *Order_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Order_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Order_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitOrder_list(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Order_list() (localctx IOrder_listContext) {
+	localctx = NewOrder_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, SimpleSqlParserRULE_order_list)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(264)
+		p.Order_expr()
+	}
+	p.SetState(269)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserCOMMA {
+		{
+			p.SetState(265)
+			p.Match(SimpleSqlParserCOMMA)
+		}
+		{
+			p.SetState(266)
+			p.Order_expr()
+		}
+
+		p.SetState(271)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IOrder_exprContext is an interface to support dynamic dispatch.
+type IOrder_exprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOrder_exprContext differentiates from other interfaces.
+	IsOrder_exprContext()
+}
+
+type Order_exprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOrder_exprContext() *Order_exprContext {
+	var p = new(Order_exprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_order_expr
+	return p
+}
+
+func (*Order_exprContext) IsOrder_exprContext() {}
+
+func NewOrder_exprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Order_exprContext {
+	var p = new(Order_exprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_order_expr
+
+	return p
+}
+
+func (s *Order_exprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Order_exprContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Order_exprContext) ASC_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserASC_, 0)
+}
+
+func (s *Order_exprContext) DESC_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserDESC_, 0)
+}
+
+func (s *Order_exprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Order_exprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Order_exprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitOrder_expr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Order_expr() (localctx IOrder_exprContext) {
+	localctx = NewOrder_exprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, SimpleSqlParserRULE_order_expr)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(272)
+		p.Expression()
+	}
+	p.SetState(274)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserASC_ || _la == SimpleSqlParserDESC_ {
+		{
+			p.SetState(273)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SimpleSqlParserASC_ || _la == SimpleSqlParserDESC_) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	}
+
+	return localctx
+}
+
+// IIdent_listContext is an interface to support dynamic dispatch.
+type IIdent_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsIdent_listContext differentiates from other interfaces.
+	IsIdent_listContext()
+}
+
+type Ident_listContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyIdent_listContext() *Ident_listContext {
+	var p = new(Ident_listContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_ident_list
+	return p
+}
+
+func (*Ident_listContext) IsIdent_listContext() {}
+
+func NewIdent_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Ident_listContext {
+	var p = new(Ident_listContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_ident_list
+
+	return p
+}
+
+func (s *Ident_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Ident_listContext) AllIDENT() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserIDENT)
+}
+
+func (s *Ident_listContext) IDENT(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, i)
+}
+
+func (s *Ident_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserCOMMA)
+}
+
+func (s *Ident_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCOMMA, i)
+}
+
+func (s *Ident_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Ident_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Ident_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitIdent_list(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Ident_list() (localctx IIdent_listContext) {
+	localctx = NewIdent_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SimpleSqlParserRULE_ident_list)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(276)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	p.SetState(281)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserCOMMA {
+		{
+			p.SetState(277)
+			p.Match(SimpleSqlParserCOMMA)
+		}
+		{
+			p.SetState(278)
+			p.Match(SimpleSqlParserIDENT)
+		}
+
+		p.SetState(283)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IColumn_listContext is an interface to support dynamic dispatch.
+type IColumn_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsColumn_listContext differentiates from other interfaces.
+	IsColumn_listContext()
+}
+
+type Column_listContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyColumn_listContext() *Column_listContext {
+	var p = new(Column_listContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_column_list
+	return p
+}
+
+func (*Column_listContext) IsColumn_listContext() {}
+
+func NewColumn_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Column_listContext {
+	var p = new(Column_listContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_column_list
+
+	return p
+}
+
+func (s *Column_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Column_listContext) AllColumn_ref() []IColumn_refContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IColumn_refContext)(nil)).Elem())
+	var tst = make([]IColumn_refContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IColumn_refContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *Column_listContext) Column_ref(i int) IColumn_refContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumn_refContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IColumn_refContext)
+}
+
+func (s *Column_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserCOMMA)
+}
+
+func (s *Column_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCOMMA, i)
+}
+
+func (s *Column_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Column_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Column_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitColumn_list(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Column_list() (localctx IColumn_listContext) {
+	localctx = NewColumn_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SimpleSqlParserRULE_column_list)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(284)
+		p.Column_ref()
+	}
+	p.SetState(289)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserCOMMA {
+		{
+			p.SetState(285)
+			p.Match(SimpleSqlParserCOMMA)
+		}
+		{
+			p.SetState(286)
+			p.Column_ref()
+		}
+
+		p.SetState(291)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IUpdate_stmtContext is an interface to support dynamic dispatch.
+type IUpdate_stmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUpdate_stmtContext differentiates from other interfaces.
+	IsUpdate_stmtContext()
+}
+
+type Update_stmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUpdate_stmtContext() *Update_stmtContext {
+	var p = new(Update_stmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_update_stmt
+	return p
+}
+
+func (*Update_stmtContext) IsUpdate_stmtContext() {}
+
+func NewUpdate_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Update_stmtContext {
+	var p = new(Update_stmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_update_stmt
+
+	return p
+}
+
+func (s *Update_stmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Update_stmtContext) UPDATE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserUPDATE_, 0)
+}
+
+func (s *Update_stmtContext) IDENT() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, 0)
+}
+
+func (s *Update_stmtContext) SET_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserSET_, 0)
+}
+
+func (s *Update_stmtContext) Update_expr_list() IUpdate_expr_listContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUpdate_expr_listContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUpdate_expr_listContext)
+}
+
+func (s *Update_stmtContext) WHERE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserWHERE_, 0)
+}
+
+func (s *Update_stmtContext) Condition() IConditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionContext)
+}
+
+func (s *Update_stmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Update_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Update_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitUpdate_stmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Update_stmt() (localctx IUpdate_stmtContext) {
+	localctx = NewUpdate_stmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SimpleSqlParserRULE_update_stmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(292)
+		p.Match(SimpleSqlParserUPDATE_)
+	}
+	{
+		p.SetState(293)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	{
+		p.SetState(294)
+		p.Match(SimpleSqlParserSET_)
+	}
+	{
+		p.SetState(295)
+		p.Update_expr_list()
+	}
+	p.SetState(298)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserWHERE_ {
+		{
+			p.SetState(296)
+			p.Match(SimpleSqlParserWHERE_)
+		}
+		{
+			p.SetState(297)
+			p.Condition()
+		}
+
+	}
+
+	return localctx
+}
+
+// IUpdate_expr_listContext is an interface to support dynamic dispatch.
+type IUpdate_expr_listContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUpdate_expr_listContext differentiates from other interfaces.
+	IsUpdate_expr_listContext()
+}
+
+type Update_expr_listContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUpdate_expr_listContext() *Update_expr_listContext {
+	var p = new(Update_expr_listContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_update_expr_list
+	return p
+}
+
+func (*Update_expr_listContext) IsUpdate_expr_listContext() {}
+
+func NewUpdate_expr_listContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Update_expr_listContext {
+	var p = new(Update_expr_listContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_update_expr_list
+
+	return p
+}
+
+func (s *Update_expr_listContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Update_expr_listContext) AllUpdate_expr() []IUpdate_exprContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IUpdate_exprContext)(nil)).Elem())
+	var tst = make([]IUpdate_exprContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IUpdate_exprContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *Update_expr_listContext) Update_expr(i int) IUpdate_exprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUpdate_exprContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUpdate_exprContext)
+}
+
+func (s *Update_expr_listContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserCOMMA)
+}
+
+func (s *Update_expr_listContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCOMMA, i)
+}
+
+func (s *Update_expr_listContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Update_expr_listContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Update_expr_listContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitUpdate_expr_list(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Update_expr_list() (localctx IUpdate_expr_listContext) {
+	localctx = NewUpdate_expr_listContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SimpleSqlParserRULE_update_expr_list)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(300)
+		p.Update_expr()
+	}
+	p.SetState(305)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserCOMMA {
+		{
+			p.SetState(301)
+			p.Match(SimpleSqlParserCOMMA)
+		}
+		{
+			p.SetState(302)
+			p.Update_expr()
+		}
+
+		p.SetState(307)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IUpdate_exprContext is an interface to support dynamic dispatch.
+type IUpdate_exprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsUpdate_exprContext differentiates from other interfaces.
+	IsUpdate_exprContext()
+}
+
+type Update_exprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyUpdate_exprContext() *Update_exprContext {
+	var p = new(Update_exprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_update_expr
+	return p
+}
+
+func (*Update_exprContext) IsUpdate_exprContext() {}
+
+func NewUpdate_exprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Update_exprContext {
+	var p = new(Update_exprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_update_expr
+
+	return p
+}
+
+func (s *Update_exprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Update_exprContext) IDENT() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, 0)
+}
+
+func (s *Update_exprContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserEQUAL, 0)
+}
+
+func (s *Update_exprContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *Update_exprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Update_exprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Update_exprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitUpdate_expr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Update_expr() (localctx IUpdate_exprContext) {
+	localctx = NewUpdate_exprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SimpleSqlParserRULE_update_expr)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(308)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	{
+		p.SetState(309)
+		p.Match(SimpleSqlParserEQUAL)
+	}
+	{
+		p.SetState(310)
+		p.Expression()
+	}
+
+	return localctx
+}
+
+// IDelete_stmtContext is an interface to support dynamic dispatch.
+type IDelete_stmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDelete_stmtContext differentiates from other interfaces.
+	IsDelete_stmtContext()
+}
+
+type Delete_stmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDelete_stmtContext() *Delete_stmtContext {
+	var p = new(Delete_stmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_delete_stmt
+	return p
+}
+
+func (*Delete_stmtContext) IsDelete_stmtContext() {}
+
+func NewDelete_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Delete_stmtContext {
+	var p = new(Delete_stmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_delete_stmt
+
+	return p
+}
+
+func (s *Delete_stmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Delete_stmtContext) DELETE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserDELETE_, 0)
+}
+
+func (s *Delete_stmtContext) FROM_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserFROM_, 0)
+}
+
+func (s *Delete_stmtContext) IDENT() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, 0)
+}
+
+func (s *Delete_stmtContext) WHERE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserWHERE_, 0)
+}
+
+func (s *Delete_stmtContext) Condition() IConditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionContext)
+}
+
+func (s *Delete_stmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Delete_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Delete_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitDelete_stmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Delete_stmt() (localctx IDelete_stmtContext) {
+	localctx = NewDelete_stmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SimpleSqlParserRULE_delete_stmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(312)
+		p.Match(SimpleSqlParserDELETE_)
+	}
+	{
+		p.SetState(313)
+		p.Match(SimpleSqlParserFROM_)
+	}
+	{
+		p.SetState(314)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	p.SetState(317)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserWHERE_ {
+		{
+			p.SetState(315)
+			p.Match(SimpleSqlParserWHERE_)
+		}
+		{
+			p.SetState(316)
+			p.Condition()
+		}
+
+	}
+
+	return localctx
+}
+
+// ICreate_view_stmtContext is an interface to support dynamic dispatch.
+type ICreate_view_stmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreate_view_stmtContext differentiates from other interfaces.
+	IsCreate_view_stmtContext()
+}
+
+type Create_view_stmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreate_view_stmtContext() *Create_view_stmtContext {
+	var p = new(Create_view_stmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_create_view_stmt
+	return p
+}
+
+func (*Create_view_stmtContext) IsCreate_view_stmtContext() {}
+
+func NewCreate_view_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Create_view_stmtContext {
+	var p = new(Create_view_stmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_create_view_stmt
+
+	return p
+}
+
+func (s *Create_view_stmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Create_view_stmtContext) CREATE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCREATE_, 0)
+}
+
+func (s *Create_view_stmtContext) VIEW_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserVIEW_, 0)
+}
+
+func (s *Create_view_stmtContext) IDENT() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, 0)
+}
+
+func (s *Create_view_stmtContext) AS_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserAS_, 0)
+}
+
+func (s *Create_view_stmtContext) Select_stmt() ISelect_stmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelect_stmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelect_stmtContext)
+}
+
+func (s *Create_view_stmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Create_view_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Create_view_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitCreate_view_stmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Create_view_stmt() (localctx ICreate_view_stmtContext) {
+	localctx = NewCreate_view_stmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SimpleSqlParserRULE_create_view_stmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(319)
+		p.Match(SimpleSqlParserCREATE_)
+	}
+	{
+		p.SetState(320)
+		p.Match(SimpleSqlParserVIEW_)
+	}
+	{
+		p.SetState(321)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	{
+		p.SetState(322)
+		p.Match(SimpleSqlParserAS_)
+	}
+	{
+		p.SetState(323)
+		p.Select_stmt()
+	}
+
+	return localctx
+}
+
+// ICreate_index_stmtContext is an interface to support dynamic dispatch.
+type ICreate_index_stmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetMethod returns the method token.
+	GetMethod() antlr.Token
+
+	// SetMethod sets the method token.
+	SetMethod(antlr.Token)
+
+	// IsCreate_index_stmtContext differentiates from other interfaces.
+	IsCreate_index_stmtContext()
+}
+
+type Create_index_stmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+	method antlr.Token
+}
+
+func NewEmptyCreate_index_stmtContext() *Create_index_stmtContext {
+	var p = new(Create_index_stmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_create_index_stmt
+	return p
+}
+
+func (*Create_index_stmtContext) IsCreate_index_stmtContext() {}
+
+func NewCreate_index_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Create_index_stmtContext {
+	var p = new(Create_index_stmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_create_index_stmt
+
+	return p
+}
+
+func (s *Create_index_stmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Create_index_stmtContext) GetMethod() antlr.Token { return s.method }
+
+func (s *Create_index_stmtContext) SetMethod(v antlr.Token) { s.method = v }
+
+func (s *Create_index_stmtContext) CREATE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCREATE_, 0)
+}
+
+func (s *Create_index_stmtContext) INDEX_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserINDEX_, 0)
+}
+
+func (s *Create_index_stmtContext) AllIDENT() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserIDENT)
+}
+
+func (s *Create_index_stmtContext) IDENT(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, i)
+}
+
+func (s *Create_index_stmtContext) ON_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserON_, 0)
+}
+
+func (s *Create_index_stmtContext) USING_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserUSING_, 0)
+}
+
+func (s *Create_index_stmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Create_index_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Create_index_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitCreate_index_stmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Create_index_stmt() (localctx ICreate_index_stmtContext) {
+	localctx = NewCreate_index_stmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SimpleSqlParserRULE_create_index_stmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(325)
+		p.Match(SimpleSqlParserCREATE_)
+	}
+	{
+		p.SetState(326)
+		p.Match(SimpleSqlParserINDEX_)
+	}
+	{
+		p.SetState(327)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	{
+		p.SetState(328)
+		p.Match(SimpleSqlParserON_)
+	}
+	{
+		p.SetState(329)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	{
+		p.SetState(330)
+		p.Match(SimpleSqlParserT__0)
+	}
+	{
+		p.SetState(331)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	{
+		p.SetState(332)
+		p.Match(SimpleSqlParserT__1)
+	}
+	p.SetState(335)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SimpleSqlParserUSING_ {
+		{
+			p.SetState(333)
+			p.Match(SimpleSqlParserUSING_)
+		}
+		{
+			p.SetState(334)
+
+			var _m = p.Match(SimpleSqlParserIDENT)
+
+			localctx.(*Create_index_stmtContext).method = _m
+		}
+
+	}
+
+	return localctx
+}
+
+// IConditionContext is an interface to support dynamic dispatch.
+type IConditionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsConditionContext differentiates from other interfaces.
+	IsConditionContext()
+}
+
+type ConditionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyConditionContext() *ConditionContext {
+	var p = new(ConditionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_condition
+	return p
+}
+
+func (*ConditionContext) IsConditionContext() {}
+
+func NewConditionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConditionContext {
+	var p = new(ConditionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_condition
+
+	return p
+}
+
+func (s *ConditionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ConditionContext) AllAnd_condition() []IAnd_conditionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IAnd_conditionContext)(nil)).Elem())
+	var tst = make([]IAnd_conditionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IAnd_conditionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ConditionContext) And_condition(i int) IAnd_conditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAnd_conditionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAnd_conditionContext)
+}
+
+func (s *ConditionContext) AllOR_() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserOR_)
+}
+
+func (s *ConditionContext) OR_(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserOR_, i)
+}
+
+func (s *ConditionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ConditionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ConditionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitCondition(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Condition() (localctx IConditionContext) {
+	localctx = NewConditionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SimpleSqlParserRULE_condition)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(337)
+		p.And_condition()
+	}
+	p.SetState(342)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserOR_ {
+		{
+			p.SetState(338)
+			p.Match(SimpleSqlParserOR_)
+		}
+		{
+			p.SetState(339)
+			p.And_condition()
+		}
+
+		p.SetState(344)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IAnd_conditionContext is an interface to support dynamic dispatch.
+type IAnd_conditionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAnd_conditionContext differentiates from other interfaces.
+	IsAnd_conditionContext()
+}
+
+type And_conditionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAnd_conditionContext() *And_conditionContext {
+	var p = new(And_conditionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_and_condition
+	return p
+}
+
+func (*And_conditionContext) IsAnd_conditionContext() {}
+
+func NewAnd_conditionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *And_conditionContext {
+	var p = new(And_conditionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_and_condition
+
+	return p
+}
+
+func (s *And_conditionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *And_conditionContext) AllNot_condition() []INot_conditionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INot_conditionContext)(nil)).Elem())
+	var tst = make([]INot_conditionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(INot_conditionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *And_conditionContext) Not_condition(i int) INot_conditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INot_conditionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INot_conditionContext)
+}
+
+func (s *And_conditionContext) AllAND_() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserAND_)
+}
+
+func (s *And_conditionContext) AND_(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserAND_, i)
+}
+
+func (s *And_conditionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *And_conditionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *And_conditionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitAnd_condition(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) And_condition() (localctx IAnd_conditionContext) {
+	localctx = NewAnd_conditionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SimpleSqlParserRULE_and_condition)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(345)
+		p.Not_condition()
+	}
+	p.SetState(350)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SimpleSqlParserAND_ {
+		{
+			p.SetState(346)
+			p.Match(SimpleSqlParserAND_)
+		}
+		{
+			p.SetState(347)
+			p.Not_condition()
+		}
+
+		p.SetState(352)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// INot_conditionContext is an interface to support dynamic dispatch.
+type INot_conditionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNot_conditionContext differentiates from other interfaces.
+	IsNot_conditionContext()
+}
+
+type Not_conditionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNot_conditionContext() *Not_conditionContext {
+	var p = new(Not_conditionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_not_condition
+	return p
+}
+
+func (*Not_conditionContext) IsNot_conditionContext() {}
+
+func NewNot_conditionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Not_conditionContext {
+	var p = new(Not_conditionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_not_condition
+
+	return p
+}
+
+func (s *Not_conditionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *Not_conditionContext) NOT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserNOT_, 0)
+}
+
+func (s *Not_conditionContext) Not_condition() INot_conditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INot_conditionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INot_conditionContext)
+}
+
+func (s *Not_conditionContext) Condition() IConditionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConditionContext)
+}
+
+func (s *Not_conditionContext) Term() ITermContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITermContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITermContext)
+}
+
+func (s *Not_conditionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Not_conditionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Not_conditionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitNot_condition(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Not_condition() (localctx INot_conditionContext) {
+	localctx = NewNot_conditionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SimpleSqlParserRULE_not_condition)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(360)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(353)
+			p.Match(SimpleSqlParserNOT_)
+		}
+		{
+			p.SetState(354)
+			p.Not_condition()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(355)
+			p.Match(SimpleSqlParserT__0)
+		}
+		{
+			p.SetState(356)
+			p.Condition()
+		}
+		{
+			p.SetState(357)
+			p.Match(SimpleSqlParserT__1)
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(359)
+			p.Term()
+		}
+
+	}
+
+	return localctx
+}
+
+// ITermContext is an interface to support dynamic dispatch.
+type ITermContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// GetOperator returns the operator token.
+	GetOperator() antlr.Token
+
+	// SetOperator sets the operator token.
+	SetOperator(antlr.Token)
+
+	// GetLeft returns the left rule contexts.
+	GetLeft() IExpressionContext
+
+	// GetRight returns the right rule contexts.
+	GetRight() IExpressionContext
+
+	// SetLeft sets the left rule contexts.
+	SetLeft(IExpressionContext)
+
+	// SetRight sets the right rule contexts.
+	SetRight(IExpressionContext)
+
+	// IsTermContext differentiates from other interfaces.
+	IsTermContext()
+}
+
+type TermContext struct {
+	*antlr.BaseParserRuleContext
+	parser   antlr.Parser
+	left     IExpressionContext
+	operator antlr.Token
+	right    IExpressionContext
+}
+
+func NewEmptyTermContext() *TermContext {
+	var p = new(TermContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SimpleSqlParserRULE_term
+	return p
+}
+
+func (*TermContext) IsTermContext() {}
+
+func NewTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TermContext {
+	var p = new(TermContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SimpleSqlParserRULE_term
+
+	return p
+}
+
+func (s *TermContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TermContext) GetOperator() antlr.Token { return s.operator }
+
+func (s *TermContext) SetOperator(v antlr.Token) { s.operator = v }
+
+func (s *TermContext) GetLeft() IExpressionContext { return s.left }
+
+func (s *TermContext) GetRight() IExpressionContext { return s.right }
+
+func (s *TermContext) SetLeft(v IExpressionContext) { s.left = v }
+
+func (s *TermContext) SetRight(v IExpressionContext) { s.right = v }
+
+func (s *TermContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *TermContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *TermContext) EQUAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserEQUAL, 0)
+}
+
+func (s *TermContext) NOT_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserNOT_EQUAL, 0)
+}
+
+func (s *TermContext) LESS() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserLESS, 0)
+}
+
+func (s *TermContext) LESS_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserLESS_EQUAL, 0)
+}
+
+func (s *TermContext) GREATER() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserGREATER, 0)
+}
+
+func (s *TermContext) GREATER_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserGREATER_EQUAL, 0)
+}
+
+func (s *TermContext) IS_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIS_, 0)
+}
+
+func (s *TermContext) NULL_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserNULL_, 0)
+}
+
+func (s *TermContext) NOT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserNOT_, 0)
+}
+
+func (s *TermContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SimpleSqlVisitor:
+		return t.VisitTerm(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SimpleSqlParser) Term() (localctx ITermContext) {
+	localctx = NewTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SimpleSqlParserRULE_term)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(373)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(362)
+
+			var _x = p.Expression()
+
+			localctx.(*TermContext).left = _x
+		}
+		{
+			p.SetState(363)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*TermContext).operator = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((_la-60)&-(0x1f+1)) == 0 && ((1<<uint((_la-60)))&((1<<(SimpleSqlParserEQUAL-60))|(1<<(SimpleSqlParserNOT_EQUAL-60))|(1<<(SimpleSqlParserLESS-60))|(1<<(SimpleSqlParserLESS_EQUAL-60))|(1<<(SimpleSqlParserGREATER-60))|(1<<(SimpleSqlParserGREATER_EQUAL-60)))) != 0) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
+
+				localctx.(*TermContext).operator = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(364)
+
+			var _x = p.Expression()
+
+			localctx.(*TermContext).right = _x
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(366)
+
+			var _x = p.Expression()
+
+			localctx.(*TermContext).left = _x
+		}
+		{
+			p.SetState(367)
+			p.Match(SimpleSqlParserIS_)
+		}
+		p.SetState(369)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == SimpleSqlParserNOT_ {
+			{
+				p.SetState(368)
+				p.Match(SimpleSqlParserNOT_)
+			}
+
+		}
+		{
+			p.SetState(371)
+			p.Match(SimpleSqlParserNULL_)
+		}
+
+	}
+
+	return localctx
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_delete_stmt
+	p.RuleIndex = SimpleSqlParserRULE_expression
 	return p
 }
 
-func (*Delete_stmtContext) IsDelete_stmtContext() {}
+func (*ExpressionContext) IsExpressionContext() {}
 
-func NewDelete_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Delete_stmtContext {
-	var p = new(Delete_stmtContext)
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_delete_stmt
+	p.RuleIndex = SimpleSqlParserRULE_expression
 
 	return p
 }
 
-func (s *Delete_stmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Delete_stmtContext) DELETE_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserDELETE_, 0)
+func (s *ExpressionContext) AllMul_expression() []IMul_expressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMul_expressionContext)(nil)).Elem())
+	var tst = make([]IMul_expressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMul_expressionContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *Delete_stmtContext) FROM_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserFROM_, 0)
+func (s *ExpressionContext) Mul_expression(i int) IMul_expressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMul_expressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMul_expressionContext)
 }
 
-func (s *Delete_stmtContext) IDENT() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, 0)
+func (s *ExpressionContext) AllPLUS() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserPLUS)
 }
 
-func (s *Delete_stmtContext) WHERE_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserWHERE_, 0)
+func (s *ExpressionContext) PLUS(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserPLUS, i)
 }
 
-func (s *Delete_stmtContext) Condition() IConditionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConditionContext)(nil)).Elem(), 0)
+func (s *ExpressionContext) AllMINUS() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserMINUS)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *ExpressionContext) MINUS(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserMINUS, i)
+}
 
-	return t.(IConditionContext)
+func (s *ExpressionContext) AllCONCAT() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserCONCAT)
 }
 
-func (s *Delete_stmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ExpressionContext) CONCAT(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCONCAT, i)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Delete_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Delete_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitDelete_stmt(s)
+		return t.VisitExpression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Delete_stmt() (localctx IDelete_stmtContext) {
-	localctx = NewDelete_stmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SimpleSqlParserRULE_delete_stmt)
+func (p *SimpleSqlParser) Expression() (localctx IExpressionContext) {
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SimpleSqlParserRULE_expression)
 	var _la int
 
 	defer func() {
@@ -2354,121 +5477,145 @@ func (p *SimpleSqlParser) Delete_stmt() (localctx IDelete_stmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(157)
-		p.Match(SimpleSqlParserDELETE_)
-	}
-	{
-		p.SetState(158)
-		p.Match(SimpleSqlParserFROM_)
-	}
-	{
-		p.SetState(159)
-		p.Match(SimpleSqlParserIDENT)
+		p.SetState(375)
+		p.Mul_expression()
 	}
-	p.SetState(162)
+	p.SetState(380)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SimpleSqlParserWHERE_ {
+	for ((_la-55)&-(0x1f+1)) == 0 && ((1<<uint((_la-55)))&((1<<(SimpleSqlParserPLUS-55))|(1<<(SimpleSqlParserMINUS-55))|(1<<(SimpleSqlParserCONCAT-55)))) != 0 {
 		{
-			p.SetState(160)
-			p.Match(SimpleSqlParserWHERE_)
+			p.SetState(376)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((_la-55)&-(0x1f+1)) == 0 && ((1<<uint((_la-55)))&((1<<(SimpleSqlParserPLUS-55))|(1<<(SimpleSqlParserMINUS-55))|(1<<(SimpleSqlParserCONCAT-55)))) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
 		{
-			p.SetState(161)
-			p.Condition()
+			p.SetState(377)
+			p.Mul_expression()
 		}
 
+		p.SetState(382)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// ICreate_view_stmtContext is an interface to support dynamic dispatch.
-type ICreate_view_stmtContext interface {
+// IMul_expressionContext is an interface to support dynamic dispatch.
+type IMul_expressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreate_view_stmtContext differentiates from other interfaces.
-	IsCreate_view_stmtContext()
+	// IsMul_expressionContext differentiates from other interfaces.
+	IsMul_expressionContext()
 }
 
-type Create_view_stmtContext struct {
+type Mul_expressionContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreate_view_stmtContext() *Create_view_stmtContext {
-	var p = new(Create_view_stmtContext)
+func NewEmptyMul_expressionContext() *Mul_expressionContext {
+	var p = new(Mul_expressionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_create_view_stmt
+	p.RuleIndex = SimpleSqlParserRULE_mul_expression
 	return p
 }
 
-func (*Create_view_stmtContext) IsCreate_view_stmtContext() {}
+func (*Mul_expressionContext) IsMul_expressionContext() {}
 
-func NewCreate_view_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Create_view_stmtContext {
-	var p = new(Create_view_stmtContext)
+func NewMul_expressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Mul_expressionContext {
+	var p = new(Mul_expressionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_create_view_stmt
+	p.RuleIndex = SimpleSqlParserRULE_mul_expression
 
 	return p
 }
 
-func (s *Create_view_stmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *Mul_expressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Create_view_stmtContext) CREATE_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserCREATE_, 0)
+func (s *Mul_expressionContext) AllUnary_expression() []IUnary_expressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IUnary_expressionContext)(nil)).Elem())
+	var tst = make([]IUnary_expressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IUnary_expressionContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *Create_view_stmtContext) VIEW_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserVIEW_, 0)
+func (s *Mul_expressionContext) Unary_expression(i int) IUnary_expressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUnary_expressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUnary_expressionContext)
 }
 
-func (s *Create_view_stmtContext) IDENT() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, 0)
+func (s *Mul_expressionContext) AllSTAR() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserSTAR)
 }
 
-func (s *Create_view_stmtContext) AS_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserAS_, 0)
+func (s *Mul_expressionContext) STAR(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserSTAR, i)
 }
 
-func (s *Create_view_stmtContext) Select_stmt() ISelect_stmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelect_stmtContext)(nil)).Elem(), 0)
+func (s *Mul_expressionContext) AllSLASH() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserSLASH)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *Mul_expressionContext) SLASH(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserSLASH, i)
+}
 
-	return t.(ISelect_stmtContext)
+func (s *Mul_expressionContext) AllPERCENT() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserPERCENT)
 }
 
-func (s *Create_view_stmtContext) GetRuleContext() antlr.RuleContext {
+func (s *Mul_expressionContext) PERCENT(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserPERCENT, i)
+}
+
+func (s *Mul_expressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Create_view_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Mul_expressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Create_view_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *Mul_expressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitCreate_view_stmt(s)
+		return t.VisitMul_expression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Create_view_stmt() (localctx ICreate_view_stmtContext) {
-	localctx = NewCreate_view_stmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SimpleSqlParserRULE_create_view_stmt)
+func (p *SimpleSqlParser) Mul_expression() (localctx IMul_expressionContext) {
+	localctx = NewMul_expressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SimpleSqlParserRULE_mul_expression)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -2488,108 +5635,121 @@ func (p *SimpleSqlParser) Create_view_stmt() (localctx ICreate_view_stmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(164)
-		p.Match(SimpleSqlParserCREATE_)
-	}
-	{
-		p.SetState(165)
-		p.Match(SimpleSqlParserVIEW_)
-	}
-	{
-		p.SetState(166)
-		p.Match(SimpleSqlParserIDENT)
-	}
-	{
-		p.SetState(167)
-		p.Match(SimpleSqlParserAS_)
+		p.SetState(383)
+		p.Unary_expression()
 	}
-	{
-		p.SetState(168)
-		p.Select_stmt()
+	p.SetState(388)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ((_la-54)&-(0x1f+1)) == 0 && ((1<<uint((_la-54)))&((1<<(SimpleSqlParserSTAR-54))|(1<<(SimpleSqlParserSLASH-54))|(1<<(SimpleSqlParserPERCENT-54)))) != 0 {
+		{
+			p.SetState(384)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((_la-54)&-(0x1f+1)) == 0 && ((1<<uint((_la-54)))&((1<<(SimpleSqlParserSTAR-54))|(1<<(SimpleSqlParserSLASH-54))|(1<<(SimpleSqlParserPERCENT-54)))) != 0) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(385)
+			p.Unary_expression()
+		}
+
+		p.SetState(390)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// ICreate_index_stmtContext is an interface to support dynamic dispatch.
-type ICreate_index_stmtContext interface {
+// IUnary_expressionContext is an interface to support dynamic dispatch.
+type IUnary_expressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreate_index_stmtContext differentiates from other interfaces.
-	IsCreate_index_stmtContext()
+	// IsUnary_expressionContext differentiates from other interfaces.
+	IsUnary_expressionContext()
 }
 
-type Create_index_stmtContext struct {
+type Unary_expressionContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreate_index_stmtContext() *Create_index_stmtContext {
-	var p = new(Create_index_stmtContext)
+func NewEmptyUnary_expressionContext() *Unary_expressionContext {
+	var p = new(Unary_expressionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_create_index_stmt
+	p.RuleIndex = SimpleSqlParserRULE_unary_expression
 	return p
 }
 
-func (*Create_index_stmtContext) IsCreate_index_stmtContext() {}
+func (*Unary_expressionContext) IsUnary_expressionContext() {}
 
-func NewCreate_index_stmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Create_index_stmtContext {
-	var p = new(Create_index_stmtContext)
+func NewUnary_expressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Unary_expressionContext {
+	var p = new(Unary_expressionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_create_index_stmt
+	p.RuleIndex = SimpleSqlParserRULE_unary_expression
 
 	return p
 }
 
-func (s *Create_index_stmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *Unary_expressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Create_index_stmtContext) CREATE_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserCREATE_, 0)
+func (s *Unary_expressionContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserMINUS, 0)
 }
 
-func (s *Create_index_stmtContext) INDEX_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserINDEX_, 0)
-}
+func (s *Unary_expressionContext) Unary_expression() IUnary_expressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IUnary_expressionContext)(nil)).Elem(), 0)
 
-func (s *Create_index_stmtContext) AllIDENT() []antlr.TerminalNode {
-	return s.GetTokens(SimpleSqlParserIDENT)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *Create_index_stmtContext) IDENT(i int) antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, i)
+	return t.(IUnary_expressionContext)
 }
 
-func (s *Create_index_stmtContext) ON_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserON_, 0)
+func (s *Unary_expressionContext) Primary_expression() IPrimary_expressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrimary_expressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrimary_expressionContext)
 }
 
-func (s *Create_index_stmtContext) GetRuleContext() antlr.RuleContext {
+func (s *Unary_expressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Create_index_stmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Unary_expressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Create_index_stmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *Unary_expressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitCreate_index_stmt(s)
+		return t.VisitUnary_expression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Create_index_stmt() (localctx ICreate_index_stmtContext) {
-	localctx = NewCreate_index_stmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SimpleSqlParserRULE_create_index_stmt)
+func (p *SimpleSqlParser) Unary_expression() (localctx IUnary_expressionContext) {
+	localctx = NewUnary_expressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SimpleSqlParserRULE_unary_expression)
 
 	defer func() {
 		p.ExitRule()
@@ -2607,145 +5767,134 @@ func (p *SimpleSqlParser) Create_index_stmt() (localctx ICreate_index_stmtContex
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(170)
-		p.Match(SimpleSqlParserCREATE_)
-	}
-	{
-		p.SetState(171)
-		p.Match(SimpleSqlParserINDEX_)
-	}
-	{
-		p.SetState(172)
-		p.Match(SimpleSqlParserIDENT)
-	}
-	{
-		p.SetState(173)
-		p.Match(SimpleSqlParserON_)
-	}
-	{
-		p.SetState(174)
-		p.Match(SimpleSqlParserIDENT)
-	}
-	{
-		p.SetState(175)
-		p.Match(SimpleSqlParserT__0)
-	}
-	{
-		p.SetState(176)
-		p.Match(SimpleSqlParserIDENT)
-	}
-	{
-		p.SetState(177)
-		p.Match(SimpleSqlParserT__1)
+	p.SetState(394)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SimpleSqlParserMINUS:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(391)
+			p.Match(SimpleSqlParserMINUS)
+		}
+		{
+			p.SetState(392)
+			p.Unary_expression()
+		}
+
+	case SimpleSqlParserT__0, SimpleSqlParserDATE_, SimpleSqlParserTIMESTAMP_, SimpleSqlParserTRUE_, SimpleSqlParserFALSE_, SimpleSqlParserCOUNT_, SimpleSqlParserSUM_, SimpleSqlParserMIN_, SimpleSqlParserMAX_, SimpleSqlParserAVG_, SimpleSqlParserNULL_, SimpleSqlParserIDENT, SimpleSqlParserINT_LITERAL, SimpleSqlParserFLOAT_LITERAL, SimpleSqlParserSTR_LITERAL, SimpleSqlParserBLOB_LITERAL:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(393)
+			p.Primary_expression()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IConditionContext is an interface to support dynamic dispatch.
-type IConditionContext interface {
+// IPrimary_expressionContext is an interface to support dynamic dispatch.
+type IPrimary_expressionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetOp returns the op token.
-	GetOp() antlr.Token
-
-	// SetOp sets the op token.
-	SetOp(antlr.Token)
-
-	// IsConditionContext differentiates from other interfaces.
-	IsConditionContext()
+	// IsPrimary_expressionContext differentiates from other interfaces.
+	IsPrimary_expressionContext()
 }
 
-type ConditionContext struct {
+type Primary_expressionContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
-	op     antlr.Token
 }
 
-func NewEmptyConditionContext() *ConditionContext {
-	var p = new(ConditionContext)
+func NewEmptyPrimary_expressionContext() *Primary_expressionContext {
+	var p = new(Primary_expressionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_condition
+	p.RuleIndex = SimpleSqlParserRULE_primary_expression
 	return p
 }
 
-func (*ConditionContext) IsConditionContext() {}
+func (*Primary_expressionContext) IsPrimary_expressionContext() {}
 
-func NewConditionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConditionContext {
-	var p = new(ConditionContext)
+func NewPrimary_expressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Primary_expressionContext {
+	var p = new(Primary_expressionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_condition
+	p.RuleIndex = SimpleSqlParserRULE_primary_expression
 
 	return p
 }
 
-func (s *ConditionContext) GetParser() antlr.Parser { return s.parser }
+func (s *Primary_expressionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ConditionContext) GetOp() antlr.Token { return s.op }
+func (s *Primary_expressionContext) Column_ref() IColumn_refContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IColumn_refContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
 
-func (s *ConditionContext) SetOp(v antlr.Token) { s.op = v }
+	return t.(IColumn_refContext)
+}
 
-func (s *ConditionContext) AllTerm() []ITermContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITermContext)(nil)).Elem())
-	var tst = make([]ITermContext, len(ts))
+func (s *Primary_expressionContext) Literal() ILiteralContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ITermContext)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ILiteralContext)
 }
 
-func (s *ConditionContext) Term(i int) ITermContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITermContext)(nil)).Elem(), i)
+func (s *Primary_expressionContext) Aggregate() IAggregateContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAggregateContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITermContext)
+	return t.(IAggregateContext)
 }
 
-func (s *ConditionContext) AND_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserAND_, 0)
-}
+func (s *Primary_expressionContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
 
-func (s *ConditionContext) OR_() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserOR_, 0)
+	return t.(IExpressionContext)
 }
 
-func (s *ConditionContext) GetRuleContext() antlr.RuleContext {
+func (s *Primary_expressionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ConditionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Primary_expressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ConditionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *Primary_expressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitCondition(s)
+		return t.VisitPrimary_expression(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Condition() (localctx IConditionContext) {
-	localctx = NewConditionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SimpleSqlParserRULE_condition)
-	var _la int
+func (p *SimpleSqlParser) Primary_expression() (localctx IPrimary_expressionContext) {
+	localctx = NewPrimary_expressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SimpleSqlParserRULE_primary_expression)
 
 	defer func() {
 		p.ExitRule()
@@ -2763,130 +5912,128 @@ func (p *SimpleSqlParser) Condition() (localctx IConditionContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(179)
-		p.Term()
-	}
-	p.SetState(182)
+	p.SetState(403)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SimpleSqlParserAND_ || _la == SimpleSqlParserOR_ {
+	switch p.GetTokenStream().LA(1) {
+	case SimpleSqlParserIDENT:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(180)
-
-			var _lt = p.GetTokenStream().LT(1)
-
-			localctx.(*ConditionContext).op = _lt
+			p.SetState(396)
+			p.Column_ref()
+		}
 
-			_la = p.GetTokenStream().LA(1)
+	case SimpleSqlParserDATE_, SimpleSqlParserTIMESTAMP_, SimpleSqlParserTRUE_, SimpleSqlParserFALSE_, SimpleSqlParserNULL_, SimpleSqlParserINT_LITERAL, SimpleSqlParserFLOAT_LITERAL, SimpleSqlParserSTR_LITERAL, SimpleSqlParserBLOB_LITERAL:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(397)
+			p.Literal()
+		}
 
-			if !(_la == SimpleSqlParserAND_ || _la == SimpleSqlParserOR_) {
-				var _ri = p.GetErrorHandler().RecoverInline(p)
+	case SimpleSqlParserCOUNT_, SimpleSqlParserSUM_, SimpleSqlParserMIN_, SimpleSqlParserMAX_, SimpleSqlParserAVG_:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(398)
+			p.Aggregate()
+		}
 
-				localctx.(*ConditionContext).op = _ri
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
+	case SimpleSqlParserT__0:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(399)
+			p.Match(SimpleSqlParserT__0)
 		}
 		{
-			p.SetState(181)
-			p.Term()
+			p.SetState(400)
+			p.Expression()
+		}
+		{
+			p.SetState(401)
+			p.Match(SimpleSqlParserT__1)
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ITermContext is an interface to support dynamic dispatch.
-type ITermContext interface {
+// IAggregateContext is an interface to support dynamic dispatch.
+type IAggregateContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// GetOperator returns the operator token.
-	GetOperator() antlr.Token
-
-	// SetOperator sets the operator token.
-	SetOperator(antlr.Token)
-
-	// GetLeft returns the left rule contexts.
-	GetLeft() IExpressionContext
-
-	// GetRight returns the right rule contexts.
-	GetRight() IExpressionContext
-
-	// SetLeft sets the left rule contexts.
-	SetLeft(IExpressionContext)
+	// GetFunction returns the function token.
+	GetFunction() antlr.Token
 
-	// SetRight sets the right rule contexts.
-	SetRight(IExpressionContext)
+	// SetFunction sets the function token.
+	SetFunction(antlr.Token)
 
-	// IsTermContext differentiates from other interfaces.
-	IsTermContext()
+	// IsAggregateContext differentiates from other interfaces.
+	IsAggregateContext()
 }
 
-type TermContext struct {
+type AggregateContext struct {
 	*antlr.BaseParserRuleContext
 	parser   antlr.Parser
-	left     IExpressionContext
-	operator antlr.Token
-	right    IExpressionContext
+	function antlr.Token
 }
 
-func NewEmptyTermContext() *TermContext {
-	var p = new(TermContext)
+func NewEmptyAggregateContext() *AggregateContext {
+	var p = new(AggregateContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_term
+	p.RuleIndex = SimpleSqlParserRULE_aggregate
 	return p
 }
 
-func (*TermContext) IsTermContext() {}
+func (*AggregateContext) IsAggregateContext() {}
 
-func NewTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TermContext {
-	var p = new(TermContext)
+func NewAggregateContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AggregateContext {
+	var p = new(AggregateContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_term
+	p.RuleIndex = SimpleSqlParserRULE_aggregate
 
 	return p
 }
 
-func (s *TermContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TermContext) GetOperator() antlr.Token { return s.operator }
+func (s *AggregateContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TermContext) SetOperator(v antlr.Token) { s.operator = v }
+func (s *AggregateContext) GetFunction() antlr.Token { return s.function }
 
-func (s *TermContext) GetLeft() IExpressionContext { return s.left }
+func (s *AggregateContext) SetFunction(v antlr.Token) { s.function = v }
 
-func (s *TermContext) GetRight() IExpressionContext { return s.right }
+func (s *AggregateContext) COUNT_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserCOUNT_, 0)
+}
 
-func (s *TermContext) SetLeft(v IExpressionContext) { s.left = v }
+func (s *AggregateContext) SUM_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserSUM_, 0)
+}
 
-func (s *TermContext) SetRight(v IExpressionContext) { s.right = v }
+func (s *AggregateContext) MIN_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserMIN_, 0)
+}
 
-func (s *TermContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+func (s *AggregateContext) MAX_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserMAX_, 0)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
-	}
+func (s *AggregateContext) AVG_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserAVG_, 0)
+}
 
-	return tst
+func (s *AggregateContext) STAR() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserSTAR, 0)
 }
 
-func (s *TermContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *AggregateContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
@@ -2895,35 +6042,27 @@ func (s *TermContext) Expression(i int) IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *TermContext) EQUAL() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserEQUAL, 0)
-}
-
-func (s *TermContext) NOT_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserNOT_EQUAL, 0)
-}
-
-func (s *TermContext) GetRuleContext() antlr.RuleContext {
+func (s *AggregateContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AggregateContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TermContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AggregateContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitTerm(s)
+		return t.VisitAggregate(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Term() (localctx ITermContext) {
-	localctx = NewTermContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SimpleSqlParserRULE_term)
+func (p *SimpleSqlParser) Aggregate() (localctx IAggregateContext) {
+	localctx = NewAggregateContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SimpleSqlParserRULE_aggregate)
 	var _la int
 
 	defer func() {
@@ -2944,114 +6083,126 @@ func (p *SimpleSqlParser) Term() (localctx ITermContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(184)
-
-		var _x = p.Expression()
-
-		localctx.(*TermContext).left = _x
-	}
-	{
-		p.SetState(185)
+		p.SetState(405)
 
 		var _lt = p.GetTokenStream().LT(1)
 
-		localctx.(*TermContext).operator = _lt
+		localctx.(*AggregateContext).function = _lt
 
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SimpleSqlParserEQUAL || _la == SimpleSqlParserNOT_EQUAL) {
+		if !(((_la-40)&-(0x1f+1)) == 0 && ((1<<uint((_la-40)))&((1<<(SimpleSqlParserCOUNT_-40))|(1<<(SimpleSqlParserSUM_-40))|(1<<(SimpleSqlParserMIN_-40))|(1<<(SimpleSqlParserMAX_-40))|(1<<(SimpleSqlParserAVG_-40)))) != 0) {
 			var _ri = p.GetErrorHandler().RecoverInline(p)
 
-			localctx.(*TermContext).operator = _ri
+			localctx.(*AggregateContext).function = _ri
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
 			p.Consume()
 		}
 	}
 	{
-		p.SetState(186)
+		p.SetState(406)
+		p.Match(SimpleSqlParserT__0)
+	}
+	p.SetState(409)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SimpleSqlParserSTAR:
+		{
+			p.SetState(407)
+			p.Match(SimpleSqlParserSTAR)
+		}
 
-		var _x = p.Expression()
+	case SimpleSqlParserT__0, SimpleSqlParserDATE_, SimpleSqlParserTIMESTAMP_, SimpleSqlParserTRUE_, SimpleSqlParserFALSE_, SimpleSqlParserCOUNT_, SimpleSqlParserSUM_, SimpleSqlParserMIN_, SimpleSqlParserMAX_, SimpleSqlParserAVG_, SimpleSqlParserNULL_, SimpleSqlParserMINUS, SimpleSqlParserIDENT, SimpleSqlParserINT_LITERAL, SimpleSqlParserFLOAT_LITERAL, SimpleSqlParserSTR_LITERAL, SimpleSqlParserBLOB_LITERAL:
+		{
+			p.SetState(408)
+			p.Expression()
+		}
 
-		localctx.(*TermContext).right = _x
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(411)
+		p.Match(SimpleSqlParserT__1)
 	}
 
 	return localctx
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// IColumn_refContext is an interface to support dynamic dispatch.
+type IColumn_refContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// IsColumn_refContext differentiates from other interfaces.
+	IsColumn_refContext()
 }
 
-type ExpressionContext struct {
+type Column_refContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyColumn_refContext() *Column_refContext {
+	var p = new(Column_refContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SimpleSqlParserRULE_expression
+	p.RuleIndex = SimpleSqlParserRULE_column_ref
 	return p
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*Column_refContext) IsColumn_refContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewColumn_refContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Column_refContext {
+	var p = new(Column_refContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SimpleSqlParserRULE_expression
+	p.RuleIndex = SimpleSqlParserRULE_column_ref
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+func (s *Column_refContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionContext) IDENT() antlr.TerminalNode {
-	return s.GetToken(SimpleSqlParserIDENT, 0)
+func (s *Column_refContext) AllIDENT() []antlr.TerminalNode {
+	return s.GetTokens(SimpleSqlParserIDENT)
 }
 
-func (s *ExpressionContext) Literal() ILiteralContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILiteralContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *Column_refContext) IDENT(i int) antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserIDENT, i)
+}
 
-	return t.(ILiteralContext)
+func (s *Column_refContext) DOT() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserDOT, 0)
 }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *Column_refContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Column_refContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *Column_refContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SimpleSqlVisitor:
-		return t.VisitExpression(s)
+		return t.VisitColumn_ref(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SimpleSqlParser) Expression() (localctx IExpressionContext) {
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SimpleSqlParserRULE_expression)
+func (p *SimpleSqlParser) Column_ref() (localctx IColumn_refContext) {
+	localctx = NewColumn_refContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SimpleSqlParserRULE_column_ref)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3069,26 +6220,25 @@ func (p *SimpleSqlParser) Expression() (localctx IExpressionContext) {
 		}
 	}()
 
-	p.SetState(190)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(413)
+		p.Match(SimpleSqlParserIDENT)
+	}
+	p.SetState(416)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SimpleSqlParserIDENT:
-		p.EnterOuterAlt(localctx, 1)
+	if _la == SimpleSqlParserDOT {
 		{
-			p.SetState(188)
-			p.Match(SimpleSqlParserIDENT)
+			p.SetState(414)
+			p.Match(SimpleSqlParserDOT)
 		}
-
-	case SimpleSqlParserINT_LITERAL, SimpleSqlParserSTR_LITERAL:
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(189)
-			p.Literal()
+			p.SetState(415)
+			p.Match(SimpleSqlParserIDENT)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
@@ -3136,10 +6286,38 @@ func (s *LiteralContext) INT_LITERAL() antlr.TerminalNode {
 	return s.GetToken(SimpleSqlParserINT_LITERAL, 0)
 }
 
+func (s *LiteralContext) FLOAT_LITERAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserFLOAT_LITERAL, 0)
+}
+
 func (s *LiteralContext) STR_LITERAL() antlr.TerminalNode {
 	return s.GetToken(SimpleSqlParserSTR_LITERAL, 0)
 }
 
+func (s *LiteralContext) BLOB_LITERAL() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserBLOB_LITERAL, 0)
+}
+
+func (s *LiteralContext) TRUE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserTRUE_, 0)
+}
+
+func (s *LiteralContext) FALSE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserFALSE_, 0)
+}
+
+func (s *LiteralContext) DATE_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserDATE_, 0)
+}
+
+func (s *LiteralContext) TIMESTAMP_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserTIMESTAMP_, 0)
+}
+
+func (s *LiteralContext) NULL_() antlr.TerminalNode {
+	return s.GetToken(SimpleSqlParserNULL_, 0)
+}
+
 func (s *LiteralContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -3160,8 +6338,7 @@ func (s *LiteralContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SimpleSqlParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SimpleSqlParserRULE_literal)
-	var _la int
+	p.EnterRule(localctx, 78, SimpleSqlParserRULE_literal)
 
 	defer func() {
 		p.ExitRule()
@@ -3179,17 +6356,83 @@ func (p *SimpleSqlParser) Literal() (localctx ILiteralContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(192)
-		_la = p.GetTokenStream().LA(1)
+	p.SetState(429)
+	p.GetErrorHandler().Sync(p)
 
-		if !(_la == SimpleSqlParserINT_LITERAL || _la == SimpleSqlParserSTR_LITERAL) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	switch p.GetTokenStream().LA(1) {
+	case SimpleSqlParserINT_LITERAL:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(418)
+			p.Match(SimpleSqlParserINT_LITERAL)
+		}
+
+	case SimpleSqlParserFLOAT_LITERAL:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(419)
+			p.Match(SimpleSqlParserFLOAT_LITERAL)
+		}
+
+	case SimpleSqlParserSTR_LITERAL:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(420)
+			p.Match(SimpleSqlParserSTR_LITERAL)
+		}
+
+	case SimpleSqlParserBLOB_LITERAL:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(421)
+			p.Match(SimpleSqlParserBLOB_LITERAL)
+		}
+
+	case SimpleSqlParserTRUE_:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(422)
+			p.Match(SimpleSqlParserTRUE_)
+		}
+
+	case SimpleSqlParserFALSE_:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(423)
+			p.Match(SimpleSqlParserFALSE_)
+		}
+
+	case SimpleSqlParserDATE_:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(424)
+			p.Match(SimpleSqlParserDATE_)
+		}
+		{
+			p.SetState(425)
+			p.Match(SimpleSqlParserSTR_LITERAL)
+		}
+
+	case SimpleSqlParserTIMESTAMP_:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(426)
+			p.Match(SimpleSqlParserTIMESTAMP_)
 		}
+		{
+			p.SetState(427)
+			p.Match(SimpleSqlParserSTR_LITERAL)
+		}
+
+	case SimpleSqlParserNULL_:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(428)
+			p.Match(SimpleSqlParserNULL_)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
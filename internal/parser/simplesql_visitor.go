@@ -37,12 +37,45 @@ type SimpleSqlVisitor interface {
 	// Visit a parse tree produced by SimpleSqlParser#constant_list.
 	VisitConstant_list(ctx *Constant_listContext) interface{}
 
+	// Visit a parse tree produced by SimpleSqlParser#constant.
+	VisitConstant(ctx *ConstantContext) interface{}
+
 	// Visit a parse tree produced by SimpleSqlParser#select_stmt.
 	VisitSelect_stmt(ctx *Select_stmtContext) interface{}
 
+	// Visit a parse tree produced by SimpleSqlParser#select_list.
+	VisitSelect_list(ctx *Select_listContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#select_expr.
+	VisitSelect_expr(ctx *Select_exprContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#from_list.
+	VisitFrom_list(ctx *From_listContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#from_item.
+	VisitFrom_item(ctx *From_itemContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#table_ref.
+	VisitTable_ref(ctx *Table_refContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#join_clause.
+	VisitJoin_clause(ctx *Join_clauseContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#join_type.
+	VisitJoin_type(ctx *Join_typeContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#order_list.
+	VisitOrder_list(ctx *Order_listContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#order_expr.
+	VisitOrder_expr(ctx *Order_exprContext) interface{}
+
 	// Visit a parse tree produced by SimpleSqlParser#ident_list.
 	VisitIdent_list(ctx *Ident_listContext) interface{}
 
+	// Visit a parse tree produced by SimpleSqlParser#column_list.
+	VisitColumn_list(ctx *Column_listContext) interface{}
+
 	// Visit a parse tree produced by SimpleSqlParser#update_stmt.
 	VisitUpdate_stmt(ctx *Update_stmtContext) interface{}
 
@@ -64,12 +97,33 @@ type SimpleSqlVisitor interface {
 	// Visit a parse tree produced by SimpleSqlParser#condition.
 	VisitCondition(ctx *ConditionContext) interface{}
 
+	// Visit a parse tree produced by SimpleSqlParser#and_condition.
+	VisitAnd_condition(ctx *And_conditionContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#not_condition.
+	VisitNot_condition(ctx *Not_conditionContext) interface{}
+
 	// Visit a parse tree produced by SimpleSqlParser#term.
 	VisitTerm(ctx *TermContext) interface{}
 
 	// Visit a parse tree produced by SimpleSqlParser#expression.
 	VisitExpression(ctx *ExpressionContext) interface{}
 
+	// Visit a parse tree produced by SimpleSqlParser#mul_expression.
+	VisitMul_expression(ctx *Mul_expressionContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#unary_expression.
+	VisitUnary_expression(ctx *Unary_expressionContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#primary_expression.
+	VisitPrimary_expression(ctx *Primary_expressionContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#aggregate.
+	VisitAggregate(ctx *AggregateContext) interface{}
+
+	// Visit a parse tree produced by SimpleSqlParser#column_ref.
+	VisitColumn_ref(ctx *Column_refContext) interface{}
+
 	// Visit a parse tree produced by SimpleSqlParser#literal.
 	VisitLiteral(ctx *LiteralContext) interface{}
 }
@@ -1,19 +1,65 @@
 package parser
 
 import (
+	"encoding/hex"
+	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 )
 
-func ParseQuery(input string) interface{} {
-	is := antlr.NewInputStream(input)
+// Parses the statements of an SQL input, separated by semicolons.
+// If the input is invalid, the syntax errors found in it are
+// returned as SyntaxErrors, each with its position in the input.
+func ParseQuery(input string) (stmts []Statement, err error) {
+	errorListener := newSyntaxErrorListener()
+	is := lowercaseStream{antlr.NewInputStream(input)}
 	lexer := NewSimpleSqlLexer(is)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errorListener)
 	tokens := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
 	parser := NewSimpleSqlParser(tokens)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errorListener)
+	tree := parser.Parse()
+	// The tree of an invalid input lacks the
+	// nodes that the AST builder expects.
+	if len(errorListener.errors) > 0 {
+		return nil, errorListener.errors
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			syntaxError, ok := r.(*SyntaxError)
+			if !ok {
+				// a tree the AST builder does not expect is a bug
+				// of the builder, which is not the caller's to crash on
+				stmts, err = nil, fmt.Errorf("cannot build the statements of the input: %v", r)
+				return
+			}
+			stmts, err = nil, SyntaxErrors{syntaxError}
+		}
+	}()
 	visitor := NewSimpleSqlAstBuilder()
-	return parser.Parse().Accept(visitor)
+	return tree.Accept(visitor).([]Statement), nil
+}
+
+// An input stream that the lexer reads in lowercase, so that
+// the keywords of the grammar, which are written in lowercase,
+// are matched whatever their case.
+// The text of the tokens is that of the input, in its own case.
+type lowercaseStream struct {
+	*antlr.InputStream
+}
+
+func (ls lowercaseStream) LA(offset int) int {
+	c := ls.InputStream.LA(offset)
+	if c == antlr.TokenEOF {
+		return c
+	}
+	return int(unicode.ToLower(rune(c)))
 }
 
 type SimpleSqlAstBuilder struct {
@@ -25,12 +71,15 @@ func NewSimpleSqlAstBuilder() *SimpleSqlAstBuilder {
 }
 
 func (v *SimpleSqlAstBuilder) VisitParse(ctx *ParseContext) interface{} {
-	stmtListCtx := ctx.StatementList(0)
+	stmtListCtx := ctx.StatementList()
+	if stmtListCtx == nil {
+		return make([]Statement, 0)
+	}
 	return v.VisitStatementList(stmtListCtx.(*StatementListContext))
 }
 
 func (v *SimpleSqlAstBuilder) VisitStatementList(ctx *StatementListContext) interface{} {
-	statements := make([]any, 0)
+	statements := make([]Statement, 0)
 	nodes := ctx.AllStatement()
 	for _, node := range nodes {
 		statements = append(statements, v.VisitStatement(node.(*StatementContext)))
@@ -79,9 +128,13 @@ func (v *SimpleSqlAstBuilder) VisitCreate_table_stmt(ctx *Create_table_stmtConte
 		return nil
 	}
 
-	tableName := ctx.IDENT().GetText()
+	tableName := identifier(ctx.IDENT(0).GetSymbol())
 	fieldSpecs := v.VisitField_specs(ctx.Field_specs().(*Field_specsContext))
-	return CreateTableStmt{tableName, fieldSpecs.([]FieldSpec)}
+	format := ""
+	if ctx.GetFormat() != nil {
+		format = identifier(ctx.GetFormat())
+	}
+	return CreateTableStmt{tableName, fieldSpecs.([]FieldSpec), format}
 }
 
 func (v *SimpleSqlAstBuilder) VisitField_specs(ctx *Field_specsContext) interface{} {
@@ -99,14 +152,27 @@ func (v *SimpleSqlAstBuilder) VisitField_specs(ctx *Field_specsContext) interfac
 }
 
 func (v *SimpleSqlAstBuilder) VisitField_spec(ctx *Field_specContext) interface{} {
-	field := ctx.IDENT().GetText()
+	field := identifier(ctx.IDENT().GetSymbol())
 	typeSpec := v.VisitType_spec(ctx.Type_spec().(*Type_specContext))
 	return FieldSpec{field, typeSpec.(TypeSpec)}
 }
 
+// The integers are 64-bit, so bigint is the same as int,
+// and real is the same as double.
 func (v *SimpleSqlAstBuilder) VisitType_spec(ctx *Type_specContext) interface{} {
-	if ctx.INT_() != nil {
+	switch {
+	case ctx.INT_() != nil, ctx.BIGINT_() != nil:
 		return TypeSpec{INTEGER_TYPE, 0}
+	case ctx.BOOLEAN_() != nil:
+		return TypeSpec{BOOLEAN_TYPE, 0}
+	case ctx.DOUBLE_() != nil, ctx.REAL_() != nil:
+		return TypeSpec{DOUBLE_TYPE, 0}
+	case ctx.DATE_() != nil:
+		return TypeSpec{DATE_TYPE, 0}
+	case ctx.TIMESTAMP_() != nil:
+		return TypeSpec{TIMESTAMP_TYPE, 0}
+	case ctx.BLOB_() != nil:
+		return TypeSpec{BLOB_TYPE, 0}
 	}
 
 	typeSpec := v.VisitVarchar_spec(ctx.Varchar_spec().(*Varchar_specContext))
@@ -119,7 +185,7 @@ func (v *SimpleSqlAstBuilder) VisitVarchar_spec(ctx *Varchar_specContext) interf
 	}
 	length, err := strconv.ParseInt(ctx.INT_LITERAL().GetText(), 10, 64)
 	if err != nil {
-		return nil
+		panic(newTokenError(ctx.INT_LITERAL().GetSymbol(), "length `%v` is out of range", ctx.INT_LITERAL().GetText()))
 	}
 	return TypeSpec{STRING_TYPE, length}
 }
@@ -133,11 +199,14 @@ func (v *SimpleSqlAstBuilder) VisitInsert_stmt(ctx *Insert_stmtContext) interfac
 		return nil
 	}
 
-	tableName := ctx.IDENT().GetText()
+	tableName := identifier(ctx.IDENT().GetSymbol())
 
-	fields := v.VisitIdent_list(ctx.Ident_list().(*Ident_listContext)).([]string)
-	fieldList := make([]string, 0, len(fields))
-	fieldList = append(fieldList, fields...)
+	// without a column list, the insert sets all
+	// the columns of the table, and its fields are nil
+	var fieldList []string
+	if listCtx := ctx.Ident_list(); listCtx != nil {
+		fieldList = v.VisitIdent_list(listCtx.(*Ident_listContext)).([]string)
+	}
 
 	if ctx.VALUES_() == nil {
 		return nil
@@ -148,68 +217,211 @@ func (v *SimpleSqlAstBuilder) VisitInsert_stmt(ctx *Insert_stmtContext) interfac
 }
 
 func (v *SimpleSqlAstBuilder) VisitConstant_list(ctx *Constant_listContext) interface{} {
-	listCtxs := ctx.AllLiteral()
-	literal := v.VisitLiteral(listCtxs[0].(*LiteralContext)).(Literal)
+	listCtxs := ctx.AllConstant()
+	literal := v.VisitConstant(listCtxs[0].(*ConstantContext)).(Literal)
 	literalList := []Literal{literal}
 	for i, litCtx := range listCtxs[1:] {
 		if ctx.COMMA(i) == nil {
 			return nil
 		}
-		literal := v.VisitLiteral(litCtx.(*LiteralContext)).(Literal)
+		literal := v.VisitConstant(litCtx.(*ConstantContext)).(Literal)
 		literalList = append(literalList, literal)
 	}
 	return literalList
 }
 
+func (v *SimpleSqlAstBuilder) VisitConstant(ctx *ConstantContext) interface{} {
+	if ctx.MINUS() != nil {
+		if floatLit := ctx.FLOAT_LITERAL(); floatLit != nil {
+			floatValue, _ := strconv.ParseFloat("-"+floatLit.GetText(), 64)
+			return Literal{floatValue}
+		}
+		intValue, err := strconv.ParseInt("-"+ctx.INT_LITERAL().GetText(), 10, 64)
+		if err != nil {
+			panic(newTokenError(ctx.MINUS().GetSymbol(), "integer `-%v` is out of range", ctx.INT_LITERAL().GetText()))
+		}
+		return Literal{intValue}
+	}
+	return v.VisitLiteral(ctx.Literal().(*LiteralContext))
+}
+
 func (v *SimpleSqlAstBuilder) VisitSelect_stmt(ctx *Select_stmtContext) interface{} {
 	if ctx.SELECT_() == nil {
 		return nil
 	}
 
 	fieldList := make([]string, 0)
-	identIdx := 0
+	var exprList []Expr
 	if startSelect := ctx.STAR(); startSelect != nil {
 		fieldList = append(fieldList, "*")
 	} else {
-		identListCtx := ctx.Ident_list(identIdx)
-		fields := v.VisitIdent_list(identListCtx.(*Ident_listContext)).([]string)
-		fieldList = append(fieldList, fields...)
-		identIdx += 1
+		exprList = make([]Expr, 0)
+		selectList := v.VisitSelect_list(ctx.Select_list().(*Select_listContext)).([]selectExpr)
+		for _, item := range selectList {
+			fieldList = append(fieldList, item.name)
+			exprList = append(exprList, item.expr)
+		}
 	}
 
 	if ctx.FROM_() == nil {
 		return nil
 	}
 
-	identListCtx := ctx.Ident_list(identIdx)
-	tableList := v.VisitIdent_list(identListCtx.(*Ident_listContext)).([]string)
+	fromList := v.VisitFrom_list(ctx.From_list().(*From_listContext)).([]FromItem)
+	tableList := make([]string, 0)
+	for _, fromItem := range fromList {
+		for _, tableRef := range fromItem.TableRefs() {
+			tableList = append(tableList, tableRef.Table)
+		}
+	}
 
 	condition := Condition{}
 	if ctx.WHERE_() != nil {
-		condition = v.VisitCondition(ctx.Condition().(*ConditionContext)).(Condition)
+		condition = v.VisitCondition(ctx.where.(*ConditionContext)).(Condition)
+	}
+
+	var groupList []string
+	if ctx.GROUP_() != nil {
+		groupList = v.VisitColumn_list(ctx.groups.(*Column_listContext)).([]string)
+	}
+
+	having := Condition{}
+	if ctx.HAVING_() != nil {
+		having = v.VisitCondition(ctx.having.(*ConditionContext)).(Condition)
+	}
+
+	var orderList []OrderExpr
+	if ctx.ORDER_() != nil {
+		orderList = v.VisitOrder_list(ctx.Order_list().(*Order_listContext)).([]OrderExpr)
+	}
+
+	return SelectStmt{fieldList, exprList, tableList, fromList, condition, groupList, having, orderList}
+}
+
+func (v *SimpleSqlAstBuilder) VisitFrom_list(ctx *From_listContext) interface{} {
+	fromList := make([]FromItem, 0)
+	for _, itemCtx := range ctx.AllFrom_item() {
+		fromList = append(fromList, v.VisitFrom_item(itemCtx.(*From_itemContext)).(FromItem))
+	}
+	return fromList
+}
+
+func (v *SimpleSqlAstBuilder) VisitFrom_item(ctx *From_itemContext) interface{} {
+	tableRef := v.VisitTable_ref(ctx.Table_ref().(*Table_refContext)).(TableRef)
+	var joins []JoinClause
+	for _, joinCtx := range ctx.AllJoin_clause() {
+		joins = append(joins, v.VisitJoin_clause(joinCtx.(*Join_clauseContext)).(JoinClause))
+	}
+	return FromItem{tableRef, joins}
+}
+
+func (v *SimpleSqlAstBuilder) VisitTable_ref(ctx *Table_refContext) interface{} {
+	alias := ""
+	if ctx.alias != nil {
+		alias = identifier(ctx.alias)
+	}
+	return TableRef{identifier(ctx.table), alias}
+}
+
+func (v *SimpleSqlAstBuilder) VisitJoin_clause(ctx *Join_clauseContext) interface{} {
+	tableRef := v.VisitTable_ref(ctx.Table_ref().(*Table_refContext)).(TableRef)
+	if ctx.CROSS_() != nil {
+		return JoinClause{"cross", tableRef, Condition{}}
+	}
+	kind := "inner"
+	if typeCtx := ctx.Join_type(); typeCtx != nil {
+		kind = v.VisitJoin_type(typeCtx.(*Join_typeContext)).(string)
+	}
+	condition := v.VisitCondition(ctx.Condition().(*ConditionContext)).(Condition)
+	return JoinClause{kind, tableRef, condition}
+}
+
+func (v *SimpleSqlAstBuilder) VisitJoin_type(ctx *Join_typeContext) interface{} {
+	switch {
+	case ctx.LEFT_() != nil:
+		return "left"
+	case ctx.RIGHT_() != nil:
+		return "right"
+	case ctx.FULL_() != nil:
+		return "full"
+	}
+	return "inner"
+}
+
+func (v *SimpleSqlAstBuilder) VisitOrder_list(ctx *Order_listContext) interface{} {
+	orderList := make([]OrderExpr, 0)
+	for _, orderCtx := range ctx.AllOrder_expr() {
+		orderList = append(orderList, v.VisitOrder_expr(orderCtx.(*Order_exprContext)).(OrderExpr))
+	}
+	return orderList
+}
+
+func (v *SimpleSqlAstBuilder) VisitOrder_expr(ctx *Order_exprContext) interface{} {
+	expr := v.VisitExpression(ctx.Expression().(*ExpressionContext)).(Expr)
+	return OrderExpr{expr, ctx.DESC_() != nil}
+}
+
+// An expression of the select list, along with
+// the name of the column it computes.
+type selectExpr struct {
+	name string
+	expr Expr
+}
+
+func (v *SimpleSqlAstBuilder) VisitSelect_list(ctx *Select_listContext) interface{} {
+	selectList := make([]selectExpr, 0)
+	for _, exprCtx := range ctx.AllSelect_expr() {
+		selectList = append(selectList, v.VisitSelect_expr(exprCtx.(*Select_exprContext)).(selectExpr))
 	}
+	return selectList
+}
 
-	return SelectStmt{fieldList, tableList, condition}
+// The column of an expression is named after its alias,
+// or after the text of the expression when it has none.
+func (v *SimpleSqlAstBuilder) VisitSelect_expr(ctx *Select_exprContext) interface{} {
+	expr := v.VisitExpression(ctx.Expression().(*ExpressionContext)).(Expr)
+	if ctx.alias != nil {
+		return selectExpr{identifier(ctx.alias), expr}
+	}
+	return selectExpr{expr.String(), expr}
 }
 
 func (v *SimpleSqlAstBuilder) VisitIdent_list(ctx *Ident_listContext) interface{} {
 	list := ctx.AllIDENT()
-	identList := []string{list[0].GetText()}
+	identList := []string{identifier(list[0].GetSymbol())}
 	for i, item := range list[1:] {
 		if ctx.COMMA(i) == nil {
 			return nil
 		}
-		identList = append(identList, item.GetText())
+		identList = append(identList, identifier(item.GetSymbol()))
 	}
 	return identList
 }
 
+func (v *SimpleSqlAstBuilder) VisitColumn_list(ctx *Column_listContext) interface{} {
+	columnList := make([]string, 0)
+	for _, columnCtx := range ctx.AllColumn_ref() {
+		columnList = append(columnList, v.VisitColumn_ref(columnCtx.(*Column_refContext)).(string))
+	}
+	return columnList
+}
+
+// A column reference, which is qualified by the
+// name of its table when written as "table.column".
+func (v *SimpleSqlAstBuilder) VisitColumn_ref(ctx *Column_refContext) interface{} {
+	names := make([]string, 0, 2)
+	for _, ident := range ctx.AllIDENT() {
+		names = append(names, identifier(ident.GetSymbol()))
+	}
+	return strings.Join(names, ".")
+}
+
 func (v *SimpleSqlAstBuilder) VisitUpdate_stmt(ctx *Update_stmtContext) interface{} {
 	if ctx.UPDATE_() == nil {
 		return nil
 	}
 
-	tableName := ctx.IDENT().GetText()
+	tableName := identifier(ctx.IDENT().GetSymbol())
 
 	if ctx.SET_() == nil {
 		return nil
@@ -242,7 +454,7 @@ func (v *SimpleSqlAstBuilder) VisitUpdate_expr_list(ctx *Update_expr_listContext
 }
 
 func (v *SimpleSqlAstBuilder) VisitUpdate_expr(ctx *Update_exprContext) interface{} {
-	field := ctx.IDENT().GetText()
+	field := identifier(ctx.IDENT().GetSymbol())
 	if ctx.EQUAL() == nil {
 		return nil
 	}
@@ -252,7 +464,7 @@ func (v *SimpleSqlAstBuilder) VisitUpdate_expr(ctx *Update_exprContext) interfac
 }
 
 func (v *SimpleSqlAstBuilder) VisitDelete_stmt(ctx *Delete_stmtContext) interface{} {
-	tableName := ctx.IDENT().GetText()
+	tableName := identifier(ctx.IDENT().GetSymbol())
 
 	condition := Condition{}
 	if ctx.WHERE_() != nil {
@@ -270,14 +482,17 @@ func (v *SimpleSqlAstBuilder) VisitCreate_view_stmt(ctx *Create_view_stmtContext
 		return nil
 	}
 
-	tableName := ctx.IDENT().GetText()
+	tableName := identifier(ctx.IDENT().GetSymbol())
 
 	if ctx.AS_() == nil {
 		return nil
 	}
 
 	selectStmt := v.VisitSelect_stmt(ctx.Select_stmt().(*Select_stmtContext))
-	selectStmtText := ctx.Select_stmt().GetText()
+	// The text of the query is taken from the input, since the text
+	// of the parse tree drops the whitespace between its tokens.
+	start, stop := ctx.Select_stmt().GetStart(), ctx.Select_stmt().GetStop()
+	selectStmtText := start.GetInputStream().GetTextFromInterval(antlr.NewInterval(start.GetStart(), stop.GetStop()))
 	return CreateViewStmt{tableName, selectStmt.(SelectStmt), selectStmtText}
 }
 
@@ -290,55 +505,194 @@ func (v *SimpleSqlAstBuilder) VisitCreate_index_stmt(ctx *Create_index_stmtConte
 		return nil
 	}
 
-	indexName := ctx.IDENT(0).GetText()
+	indexName := identifier(ctx.IDENT(0).GetSymbol())
 
 	if ctx.ON_() == nil {
 		return nil
 	}
 
-	tableName := ctx.IDENT(1).GetText()
-	field := ctx.IDENT(2).GetText()
-	return CreateIndexStmt{indexName, tableName, field}
+	tableName := identifier(ctx.IDENT(1).GetSymbol())
+	field := identifier(ctx.IDENT(2).GetSymbol())
+	method := ""
+	if ctx.GetMethod() != nil {
+		method = identifier(ctx.GetMethod())
+	}
+	return CreateIndexStmt{indexName, tableName, field, method}
 }
 
 func (v *SimpleSqlAstBuilder) VisitCondition(ctx *ConditionContext) interface{} {
-	termCtx := ctx.Term(0)
-	left := v.VisitTerm(termCtx.(*TermContext))
+	children := make([]Condition, 0)
+	for _, node := range ctx.AllAnd_condition() {
+		children = append(children, v.VisitAnd_condition(node.(*And_conditionContext)).(Condition))
+	}
+	if len(children) == 1 {
+		return children[0]
+	}
+	return Condition{Op: "or", Children: children}
+}
 
-	if orTerm := ctx.OR_(); orTerm != nil {
-		termCtx = ctx.Term(1)
-		right := v.VisitTerm(termCtx.(*TermContext))
-		return Condition{left.(Term), "or", right.(Term)}
+func (v *SimpleSqlAstBuilder) VisitAnd_condition(ctx *And_conditionContext) interface{} {
+	children := make([]Condition, 0)
+	for _, node := range ctx.AllNot_condition() {
+		children = append(children, v.VisitNot_condition(node.(*Not_conditionContext)).(Condition))
+	}
+	if len(children) == 1 {
+		return children[0]
 	}
+	return Condition{Op: "and", Children: children}
+}
 
-	if andTerm := ctx.AND_(); andTerm != nil {
-		termCtx = ctx.Term(1)
-		right := v.VisitTerm(termCtx.(*TermContext))
-		return Condition{left.(Term), "and", right.(Term)}
+func (v *SimpleSqlAstBuilder) VisitNot_condition(ctx *Not_conditionContext) interface{} {
+	if ctx.NOT_() != nil {
+		child := v.VisitNot_condition(ctx.Not_condition().(*Not_conditionContext))
+		return Condition{Op: "not", Children: []Condition{child.(Condition)}}
+	}
+	if condCtx := ctx.Condition(); condCtx != nil {
+		return v.VisitCondition(condCtx.(*ConditionContext))
 	}
-	return Condition{left.(Term), "", Term{}}
+	term := v.VisitTerm(ctx.Term().(*TermContext))
+	return NewTermCondition(term.(Term))
 }
 
+// A null test is a term whose operator is "is null"
+// or "is not null", and whose right side is empty.
 func (v *SimpleSqlAstBuilder) VisitTerm(ctx *TermContext) interface{} {
 	lhs := ctx.left.Accept(v)
+	if ctx.IS_() != nil {
+		if ctx.NOT_() != nil {
+			return Term{lhs.(Expr), "is not null", Expr{}}
+		}
+		return Term{lhs.(Expr), "is null", Expr{}}
+	}
 	op := ctx.operator.GetText()
 	rhs := ctx.right.Accept(v)
 	return Term{lhs.(Expr), op, rhs.(Expr)}
 }
 
 func (v *SimpleSqlAstBuilder) VisitExpression(ctx *ExpressionContext) interface{} {
-	if expr := ctx.IDENT(); expr != nil {
-		return Expr{expr.GetText()}
+	return v.visitBinaryExpressions(ctx.GetChildren())
+}
+
+func (v *SimpleSqlAstBuilder) VisitMul_expression(ctx *Mul_expressionContext) interface{} {
+	return v.visitBinaryExpressions(ctx.GetChildren())
+}
+
+// Folds a sequence of operands separated by operators
+// into a left-associative tree of binary expressions.
+func (v *SimpleSqlAstBuilder) visitBinaryExpressions(children []antlr.Tree) Expr {
+	expr := children[0].(antlr.ParseTree).Accept(v).(Expr)
+	for i := 1; i+1 < len(children); i += 2 {
+		op := children[i].(antlr.TerminalNode).GetText()
+		right := children[i+1].(antlr.ParseTree).Accept(v).(Expr)
+		expr = Expr{BinaryExpr{op, expr, right}}
 	}
-	literal := ctx.Literal().Accept(v)
-	return Expr{literal}
+	return expr
+}
+
+func (v *SimpleSqlAstBuilder) VisitUnary_expression(ctx *Unary_expressionContext) interface{} {
+	if ctx.MINUS() == nil {
+		return v.VisitPrimary_expression(ctx.Primary_expression().(*Primary_expressionContext))
+	}
+	operand := v.VisitUnary_expression(ctx.Unary_expression().(*Unary_expressionContext)).(Expr)
+	// Negative numbers are literals of their own.
+	if value, ok := operand.Value.(Literal); ok {
+		switch number := value.Value.(type) {
+		case int64:
+			return Expr{Literal{-number}}
+		case float64:
+			return Expr{Literal{-number}}
+		}
+	}
+	return Expr{UnaryExpr{"-", operand}}
+}
+
+func (v *SimpleSqlAstBuilder) VisitPrimary_expression(ctx *Primary_expressionContext) interface{} {
+	if columnCtx := ctx.Column_ref(); columnCtx != nil {
+		return Expr{v.VisitColumn_ref(columnCtx.(*Column_refContext))}
+	}
+	if literalCtx := ctx.Literal(); literalCtx != nil {
+		return Expr{literalCtx.Accept(v)}
+	}
+	if aggregateCtx := ctx.Aggregate(); aggregateCtx != nil {
+		return v.VisitAggregate(aggregateCtx.(*AggregateContext))
+	}
+	return v.VisitExpression(ctx.Expression().(*ExpressionContext))
+}
+
+func (v *SimpleSqlAstBuilder) VisitAggregate(ctx *AggregateContext) interface{} {
+	fn := strings.ToLower(ctx.function.GetText())
+	if ctx.STAR() != nil {
+		if fn != "count" {
+			panic(newTokenError(ctx.function, "%v(*) is not supported, only count(*) is", fn))
+		}
+		return Expr{AggregateExpr{fn, Expr{}}}
+	}
+	arg := v.VisitExpression(ctx.Expression().(*ExpressionContext)).(Expr)
+	return Expr{AggregateExpr{fn, arg}}
 }
 
 func (v *SimpleSqlAstBuilder) VisitLiteral(ctx *LiteralContext) interface{} {
 	if intLit := ctx.INT_LITERAL(); intLit != nil {
-		intValue, _ := strconv.ParseInt(intLit.GetText(), 10, 64)
+		intValue, err := strconv.ParseInt(intLit.GetText(), 10, 64)
+		if err != nil {
+			panic(newTokenError(intLit.GetSymbol(), "integer `%v` is out of range", intLit.GetText()))
+		}
 		return Literal{intValue}
 	}
+	if floatLit := ctx.FLOAT_LITERAL(); floatLit != nil {
+		floatValue, _ := strconv.ParseFloat(floatLit.GetText(), 64)
+		return Literal{floatValue}
+	}
+	if ctx.NULL_() != nil {
+		return Literal{nil}
+	}
+	if ctx.TRUE_() != nil || ctx.FALSE_() != nil {
+		return Literal{ctx.TRUE_() != nil}
+	}
+	// Blobs are written in hexadecimal, as in x'0af3'.
+	if blobLit := ctx.BLOB_LITERAL(); blobLit != nil {
+		data, _ := hex.DecodeString(strings.Trim(blobLit.GetText()[1:], "'"))
+		return Literal{Blob(data)}
+	}
 	strLit := ctx.STR_LITERAL().GetText()
-	return Literal{strings.Trim(strLit, "'")}
+	// Dates and timestamps are written as typed strings,
+	// as in date '2024-01-31'.
+	if ctx.DATE_() != nil {
+		date, err := ParseDate(unquote(strLit, "'"))
+		if err != nil {
+			panic(newTokenError(ctx.STR_LITERAL().GetSymbol(), "%v", err))
+		}
+		return Literal{date}
+	}
+	if ctx.TIMESTAMP_() != nil {
+		timestamp, err := ParseTimestamp(unquote(strLit, "'"))
+		if err != nil {
+			panic(newTokenError(ctx.STR_LITERAL().GetSymbol(), "%v", err))
+		}
+		return Literal{timestamp}
+	}
+	return Literal{unquote(strLit, "'")}
+}
+
+// Returns the name an identifier stands for. Names are case
+// insensitive, and stand for their lowercase form, unless they
+// are quoted, as in "Name", to keep their case or to be a keyword.
+// A quoted name cannot have a dot, which separates the name
+// of a table from the name of its column.
+func identifier(token antlr.Token) string {
+	text := token.GetText()
+	if strings.HasPrefix(text, `"`) {
+		name := unquote(text, `"`)
+		if strings.Contains(name, ".") {
+			panic(newTokenError(token, "name `%v` cannot contain a dot", name))
+		}
+		return name
+	}
+	return strings.ToLower(text)
+}
+
+// Returns the text of a quoted string or identifier,
+// in which the quote is escaped by doubling it.
+func unquote(text string, quote string) string {
+	return strings.ReplaceAll(text[1:len(text)-1], quote+quote, quote)
 }
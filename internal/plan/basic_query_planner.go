@@ -2,6 +2,7 @@ package plan
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/evanxg852000/simpledb/internal/metadata"
 	"github.com/evanxg852000/simpledb/internal/parser"
@@ -18,42 +19,153 @@ func NewBasicQueryPlanner(mdtManager *metadata.MetadataManager) *BasicQueryPlann
 	return &BasicQueryPlanner{mdtManager}
 }
 
-// Creates a query plan as follows.  It first takes
-// the product of all tables and views; it then selects on the predicate;
+// Creates a query plan as follows.  It first resolves the
+// columns of the query; it joins all tables and views;
+// it then selects on the predicate; it groups and sorts the records if the query asks to;
 // and finally it projects on the field list.
 func (bqp *BasicQueryPlanner) CreatePlan(selectStmt parser.SelectStmt, tx *recovery.Transaction) Plan {
-	// Step 1: Create a plan for each mentioned table or view.
+	// Step 1: Plan each mentioned table or view, and resolve the
+	// column references of the query to the fields of the plans.
+	// The table plans are kept so that joins can probe their indexes.
+	selectStmt, tablePlansByName := resolveFromClause(selectStmt, func(tableRef parser.TableRef) Plan {
+		return bqp.createTablePlan(tableRef, tx)
+	})
+	tableRefs, outerItems, condition := splitFromClause(selectStmt)
+	predicate := query.NewPredicate(condition)
 	plans := make([]Plan, 0)
-	for _, tableName := range selectStmt.Tables {
-		viewDef, err := bqp.mdtManager.GetViewDef(tableName, tx)
-		if err != nil {
-			panic(fmt.Sprint("error fetching view definition", err))
-		}
-		if viewDef != "" {
-			// Recursively plan the view.
-			ast := parser.ParseQuery(viewDef)
-			stmts := ast.([]any)
-			viewStmt := stmts[0].(parser.SelectStmt)
-			plans = append(plans, bqp.CreatePlan(viewStmt, tx))
+	tablePlans := make([]*TablePlan, 0)
+	for _, tableRef := range tableRefs {
+		plan := tablePlansByName[tableRef.Name()]
+		if tablePlan, ok := plan.(*TablePlan); ok {
+			plans = append(plans, bqp.createSelectPlan(tablePlan, predicate, tx))
+			tablePlans = append(tablePlans, tablePlan)
 		} else {
-			plan := NewTablePlan(tx, tableName, bqp.mdtManager)
 			plans = append(plans, plan)
+			tablePlans = append(tablePlans, nil)
 		}
 	}
+	for _, fromItem := range outerItems {
+		plans = append(plans, createFromItemPlan(fromItem, func(tableRef parser.TableRef) Plan {
+			return tablePlansByName[tableRef.Name()]
+		}))
+		tablePlans = append(tablePlans, nil)
+	}
 
-	// Step 2: Create the product of all table plans
+	// Step 2: Join the plans, through an index join when
+	// the predicate equates a field with an indexed field
+	// of the next table, or through a product otherwise.
 	plan := plans[0]
-	plans = plans[1:]
-	for _, nextPlan := range plans {
-		plan = NewProductPlan(plan, nextPlan)
+	for i := 1; i < len(plans); i++ {
+		joinPlan := bqp.createIndexJoinPlan(plan, tablePlans[i], predicate, tx)
+		if joinPlan == nil && i == 1 {
+			// With a single table on the left, its index can be probed instead.
+			joinPlan = bqp.createIndexJoinPlan(plans[i], tablePlans[0], predicate, tx)
+		}
+		if joinPlan == nil {
+			joinPlan = NewProductPlan(plan, plans[i])
+		}
+		plan = joinPlan
 	}
 
 	// Step 3: Add a selection plan for the predicate
-	predicate := query.NewPredicate(selectStmt.Condition)
 	plan = NewSelectPlan(plan, predicate)
 
-	// Step 4: Project on the field names
-	plan = NewProjectPlan(plan, selectStmt.Fields)
+	// Step 4: Group the records and select on the having condition
+	plan = createGroupByPlan(plan, selectStmt)
+
+	// Step 5: Sort the records on the order keys
+	if len(selectStmt.OrderBy) > 0 {
+		plan = NewSortPlan(tx, plan, selectStmt.OrderBy)
+	}
+
+	// Step 6: Project on the field names
+	plan = NewProjectPlan(plan, selectStmt.Fields, selectStmt.Exprs)
 
 	return plan
 }
+
+// Plans the specified table of the from clause, or the
+// view it names, with its fields qualified by its name.
+func (bqp *BasicQueryPlanner) createTablePlan(tableRef parser.TableRef, tx *recovery.Transaction) Plan {
+	if viewPlan := bqp.createViewPlan(tableRef.Table, tx); viewPlan != nil {
+		return qualifyPlan(viewPlan, tableRef.Name())
+	}
+	return NewAliasedTablePlan(tx, tableRef.Table, tableRef.Name(), bqp.mdtManager)
+}
+
+// Recursively plans the specified view,
+// or returns nil if there is no such view.
+func (bqp *BasicQueryPlanner) createViewPlan(viewName string, tx *recovery.Transaction) Plan {
+	viewDef, err := bqp.mdtManager.GetViewDef(viewName, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching view definition: %w", err))
+	}
+	if viewDef == "" {
+		return nil
+	}
+	stmts, err := parser.ParseQuery(viewDef)
+	if err != nil {
+		panic(fmt.Errorf("error parsing view definition: %w", err))
+	}
+	viewStmt := stmts[0].(parser.SelectStmt)
+	return bqp.CreatePlan(viewStmt, tx)
+}
+
+// Creates a plan reading the specified table.
+// When the predicate equates an indexed field of the table
+// with a constant, the matching records are fetched through
+// the cheapest such index instead of scanning the whole table.
+func (bqp *BasicQueryPlanner) createSelectPlan(tablePlan *TablePlan, predicate *query.Predicate, tx *recovery.Transaction) Plan {
+	indexes, err := bqp.mdtManager.GetIndexInfo(tablePlan.tableName, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching table indexes: %w", err))
+	}
+
+	var plan Plan = tablePlan
+	for _, fieldName := range sortedIndexFields(indexes) {
+		value, ok := predicate.EquatesWithConstant(tablePlan.qualify(fieldName))
+		if !ok {
+			continue
+		}
+		indexInfo := indexes[fieldName]
+		indexPlan := NewIndexSelectPlan(tablePlan, &indexInfo, value)
+		if plan == Plan(tablePlan) || indexPlan.BlockAccessed() < plan.BlockAccessed() {
+			plan = indexPlan
+		}
+	}
+	return plan
+}
+
+// Creates an index join of the specified plan with the specified table,
+// using the first index of the table whose field the predicate
+// equates with a field of the plan.
+// Returns nil when no such index exists.
+func (bqp *BasicQueryPlanner) createIndexJoinPlan(plan Plan, tablePlan *TablePlan, predicate *query.Predicate, tx *recovery.Transaction) Plan {
+	if tablePlan == nil {
+		return nil
+	}
+	indexes, err := bqp.mdtManager.GetIndexInfo(tablePlan.tableName, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching table indexes: %w", err))
+	}
+
+	schema := plan.Schema()
+	for _, fieldName := range sortedIndexFields(indexes) {
+		joinField, ok := predicate.EquatesWithField(tablePlan.qualify(fieldName))
+		if !ok || !schema.HasField(joinField) {
+			continue
+		}
+		indexInfo := indexes[fieldName]
+		return NewIndexJoinPlan(plan, tablePlan, &indexInfo, joinField)
+	}
+	return nil
+}
+
+func sortedIndexFields(indexes map[string]metadata.IndexInfo) []string {
+	fieldNames := make([]string, 0, len(indexes))
+	for fieldName := range indexes {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	slices.Sort(fieldNames)
+	return fieldNames
+}
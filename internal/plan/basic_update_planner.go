@@ -1,6 +1,9 @@
 package plan
 
 import (
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/index"
 	"github.com/evanxg852000/simpledb/internal/metadata"
 	"github.com/evanxg852000/simpledb/internal/parser"
 	"github.com/evanxg852000/simpledb/internal/query"
@@ -22,7 +25,7 @@ func (bup *BasicUpdatePlanner) ExecuteInsert(stmt parser.InsertStmt, tx *recover
 	updateScan := plan.Open().(query.UpdateScan)
 
 	updateScan.Insert()
-	for i, fieldName := range stmt.Fields {
+	for i, fieldName := range insertFields(stmt, plan.Schema()) {
 		value := query.NewConstant(stmt.Values[i].Value)
 		updateScan.SetValue(fieldName, value)
 	}
@@ -30,6 +33,15 @@ func (bup *BasicUpdatePlanner) ExecuteInsert(stmt parser.InsertStmt, tx *recover
 	return 1
 }
 
+// Returns the columns that the insert sets, which are all
+// the columns of the table, in order, if it names none.
+func insertFields(stmt parser.InsertStmt, schema record.Schema) []string {
+	if stmt.Fields == nil {
+		return schema.Fields()
+	}
+	return stmt.Fields
+}
+
 func (bup *BasicUpdatePlanner) ExecuteDelete(stmt parser.DeleteStmt, tx *recovery.Transaction) int64 {
 	var plan Plan
 	plan = NewTablePlan(tx, stmt.Table, bup.mdtManager)
@@ -68,13 +80,25 @@ func (bup *BasicUpdatePlanner) ExecuteCreateTable(stmt parser.CreateTableStmt, t
 		fieldSpec := fieldDesc.Spec
 		schema.AddField(fieldDesc.Name, fieldSpec.DataType, fieldSpec.Length)
 	}
-	err := bup.mdtManager.CreateTable(stmt.Table, schema, tx)
+	err := bup.mdtManager.CreateTable(stmt.Table, schema, tableFormat(stmt.Format), tx)
 	if err != nil {
 		panic(err)
 	}
 	return 0
 }
 
+// Returns the block format of the specified name,
+// which is the fixed format by default.
+func tableFormat(name string) int64 {
+	switch name {
+	case "", "fixed":
+		return record.FIXED_FORMAT
+	case "slotted":
+		return record.SLOTTED_FORMAT
+	}
+	panic(fmt.Errorf("%w: table format `%v`", ErrNotSupported, name))
+}
+
 func (bup *BasicUpdatePlanner) ExecuteCreateView(stmt parser.CreateViewStmt, tx *recovery.Transaction) int64 {
 	err := bup.mdtManager.CreateView(stmt.Name, stmt.QueryStr, tx)
 	if err != nil {
@@ -83,8 +107,20 @@ func (bup *BasicUpdatePlanner) ExecuteCreateView(stmt parser.CreateViewStmt, tx
 	return 0
 }
 
+// Returns the kind of index of the specified name,
+// which is a B-tree index by default.
+func indexType(name string) int64 {
+	switch name {
+	case "", "btree":
+		return index.BTREE_INDEX
+	case "hash":
+		return index.HASH_INDEX
+	}
+	panic(fmt.Errorf("%w: index type `%v`", ErrNotSupported, name))
+}
+
 func (bup *BasicUpdatePlanner) ExecuteCreateIndex(stmt parser.CreateIndexStmt, tx *recovery.Transaction) int64 {
-	err := bup.mdtManager.CreateIndex(stmt.Name, stmt.Table, stmt.Field, tx)
+	err := bup.mdtManager.CreateIndex(stmt.Name, stmt.Table, stmt.Field, indexType(stmt.Method), tx)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,181 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The error of a query having aggregates where they are not
+// allowed, or referring to columns that are not grouped.
+var ErrInvalidGrouping = errors.New("invalid grouping")
+
+// The Plan class for the groupby operator.
+type GroupByPlan struct {
+	plan        Plan
+	groupFields []string
+	aggFns      []query.AggregationFn
+	schema      *record.Schema
+}
+
+// Create a groupby plan for the underlying query.
+// The grouping is determined by the specified
+// collection of group fields, and the aggregation
+// is computed by the specified aggregates, each of them
+// becoming a field named after its text.
+func NewGroupByPlan(plan Plan, groupFields []string, aggregates []parser.AggregateExpr) *GroupByPlan {
+	subSchema := plan.Schema()
+	schema := record.NewSchema()
+	for _, fieldName := range groupFields {
+		if !subSchema.HasField(fieldName) {
+			panic(query.NewColumnNotFoundError(fieldName))
+		}
+		schema.Add(fieldName, subSchema)
+	}
+
+	aggFns := make([]query.AggregationFn, 0, len(aggregates))
+	for _, aggregate := range aggregates {
+		fieldName := aggregate.String()
+		if schema.HasField(fieldName) {
+			continue
+		}
+		var expr *query.Expression
+		fldType, fldLength := int64(record.INTEGER_TYPE), int64(0)
+		if aggregate.Arg.Value != nil {
+			expr = query.NewExpression(aggregate.Arg)
+			if !subSchema.AppliesTo(expr) {
+				panic(fmt.Errorf("%w: aggregate `%v` refers to unknown fields", query.ErrColumnNotFound, fieldName))
+			}
+			switch aggregate.Fn {
+			case "min", "max":
+				fldType, fldLength = subSchema.ExpressionType(expr)
+			case "sum", "avg":
+				// The sum of doubles is a double.
+				if argType, _ := subSchema.ExpressionType(expr); argType == record.DOUBLE_TYPE {
+					fldType = argType
+				}
+			}
+		}
+		aggFns = append(aggFns, newAggregationFn(aggregate.Fn, fieldName, expr))
+		schema.AddField(fieldName, fldType, fldLength)
+	}
+	return &GroupByPlan{plan, groupFields, aggFns, schema}
+}
+
+func newAggregationFn(fn string, fieldName string, expr *query.Expression) query.AggregationFn {
+	switch fn {
+	case "count":
+		return query.NewCountFn(fieldName, expr)
+	case "sum":
+		return query.NewSumFn(fieldName, expr)
+	case "avg":
+		return query.NewAvgFn(fieldName, expr)
+	case "min":
+		return query.NewMinFn(fieldName, expr)
+	case "max":
+		return query.NewMaxFn(fieldName, expr)
+	}
+	panic(fmt.Errorf("%w: aggregation function `%v`", ErrNotSupported, fn))
+}
+
+// This method opens a groupby scan over the underlying query,
+// which reads the query once to build the groups.
+func (gp *GroupByPlan) Open() query.Scan {
+	scan := gp.plan.Open()
+	return query.NewGroupByScan(scan, gp.groupFields, gp.aggFns)
+}
+
+// Return the number of blocks required to
+// compute the aggregation,
+// which is one pass through the underlying query.
+func (gp *GroupByPlan) BlockAccessed() int64 {
+	return gp.plan.BlockAccessed()
+}
+
+// Return the number of groups. Assuming equal distribution,
+// this is the product of the distinct values
+// for each grouping field, but not more than the
+// number of records of the underlying query.
+func (gp *GroupByPlan) RecordsOutput() int64 {
+	if len(gp.groupFields) == 0 {
+		return 1
+	}
+	groups := int64(1)
+	for _, fieldName := range gp.groupFields {
+		groups *= max(gp.plan.DistinctValues(fieldName), 1)
+		if groups >= gp.plan.RecordsOutput() {
+			return max(gp.plan.RecordsOutput(), 1)
+		}
+	}
+	return groups
+}
+
+// Return the number of distinct values for the
+// specified field. If the field is a grouping field,
+// then the number of distinct values is the same
+// as in the underlying query.
+// If the field is an aggregate field, then we
+// assume that all values are distinct.
+func (gp *GroupByPlan) DistinctValues(fieldName string) int64 {
+	for _, groupField := range gp.groupFields {
+		if groupField == fieldName {
+			return gp.plan.DistinctValues(fieldName)
+		}
+	}
+	return gp.RecordsOutput()
+}
+
+// Returns the schema of the output table.
+// The schema consists of the group fields,
+// plus one field for each aggregation function.
+func (gp *GroupByPlan) Schema() record.Schema {
+	return *gp.schema
+}
+
+// Adds the grouping of the query to the plan of its records:
+// a groupby plan when the query has group fields or aggregates,
+// followed by a selection on the having condition.
+// The select list, the having condition and the sort keys of a
+// grouped query may only refer to columns that are group fields,
+// or that are the arguments of an aggregate.
+func createGroupByPlan(plan Plan, selectStmt parser.SelectStmt) Plan {
+	if len(selectStmt.Condition.Aggregates()) > 0 {
+		panic(fmt.Errorf("%w: aggregates are not allowed in the where clause", ErrInvalidGrouping))
+	}
+	aggregates := make([]parser.AggregateExpr, 0)
+	for _, expr := range selectStmt.Exprs {
+		aggregates = append(aggregates, expr.Aggregates()...)
+	}
+	aggregates = append(aggregates, selectStmt.Having.Aggregates()...)
+	for _, orderExpr := range selectStmt.OrderBy {
+		aggregates = append(aggregates, orderExpr.Expr.Aggregates()...)
+	}
+	if len(selectStmt.GroupBy) == 0 && len(aggregates) == 0 {
+		if !selectStmt.Having.IsEmpty() {
+			panic(fmt.Errorf("%w: having clause without group by or aggregates", ErrInvalidGrouping))
+		}
+		return plan
+	}
+
+	plan = NewGroupByPlan(plan, selectStmt.GroupBy, aggregates)
+	schema := plan.Schema()
+	fieldNames := selectStmt.Having.FieldNames()
+	for _, expr := range selectStmt.Exprs {
+		fieldNames = append(fieldNames, expr.FieldNames()...)
+	}
+	for _, orderExpr := range selectStmt.OrderBy {
+		fieldNames = append(fieldNames, orderExpr.Expr.FieldNames()...)
+	}
+	for _, fieldName := range fieldNames {
+		if !schema.HasField(fieldName) {
+			panic(fmt.Errorf("%w: column `%v` must appear in GROUP BY or an aggregate", ErrInvalidGrouping, fieldName))
+		}
+	}
+	if !selectStmt.Having.IsEmpty() {
+		plan = NewSelectPlan(plan, query.NewPredicate(selectStmt.Having))
+	}
+	return plan
+}
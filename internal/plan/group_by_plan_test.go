@@ -0,0 +1,94 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupByPlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_group_by_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	_, err = planner.ExecuteQuery("create table emp(id int, name varchar(8), dept varchar(4), salary int)", tx)
+	assert.Nil(err)
+	_, err = planner.ExecuteQuery("create table empty(id int)", tx)
+	assert.Nil(err)
+	for i := 0; i < 30; i++ {
+		dept := []string{"eng", "ops", "hr"}[i%3]
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into emp(id, name, dept, salary) values (%d, 'emp_%02d', '%s', %d)", i, i, dept, 100+i), tx)
+		assert.Nil(err)
+	}
+
+	readRows := func(queryStr string) []string {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err)
+		p := result.(plan.Plan)
+		schema := p.Schema()
+		rows := make([]string, 0)
+		scan := p.Open()
+		for scan.Next() {
+			row := ""
+			for i, fieldName := range schema.Fields() {
+				if i > 0 {
+					row += ":"
+				}
+				value := scan.GetValue(fieldName)
+				row += value.String()
+			}
+			rows = append(rows, row)
+		}
+		scan.Close()
+		return rows
+	}
+
+	// Salaries of eng are 100, 103, ..., 127; of ops 101, ..., 128; of hr 102, ..., 129.
+	assert.ElementsMatch([]string{
+		"eng:10:1135:113:100:emp_27",
+		"ops:10:1145:114:101:emp_28",
+		"hr:10:1155:115:102:emp_29",
+	}, readRows("select dept, count(*), sum(salary), avg(salary), min(salary), max(name) from emp group by dept"))
+
+	// The having condition filters the groups, and aggregates can be part of expressions.
+	assert.ElementsMatch([]string{"hr:1165", "ops:1155"},
+		readRows("select dept, sum(salary) + count(id) from emp group by dept having avg(salary) > 113"))
+	assert.ElementsMatch([]string{"ops:5"},
+		readRows("select dept, count(*) from emp where id < 15 group by dept having min(salary) = 101"))
+
+	// Without group by, the whole table makes up a single group.
+	assert.Equal([]string{"30:3435:100:129"}, readRows("select count(*), sum(salary), min(salary), max(salary) from emp"))
+	assert.Equal([]string{"0:NULL:NULL:NULL:NULL"}, readRows("select count(id), sum(id), avg(id), min(id), max(id) from empty"))
+	assert.Equal([]string{}, readRows("select count(*) from emp having count(*) > 30"))
+
+	result, err := planner.ExecuteQuery("select dept, max(name), count(*) from emp group by dept", tx)
+	assert.Nil(err)
+	schema := result.(plan.Plan).Schema()
+	assert.Equal(int64(record.STRING_TYPE), schema.FieldType("max(name)"))
+	assert.Equal(int64(8), schema.FieldLength("max(name)"))
+	assert.Equal(int64(record.INTEGER_TYPE), schema.FieldType("count(*)"))
+
+	// Fields must be grouped to be selected, and aggregates cannot filter records.
+	_, err = planner.ExecuteQuery("select name, count(*) from emp group by dept", tx)
+	assert.ErrorIs(err, plan.ErrInvalidGrouping)
+	assert.EqualError(err, "invalid grouping: column `emp.name` must appear in GROUP BY or an aggregate")
+	_, err = planner.ExecuteQuery("select dept from emp group by dept order by salary", tx)
+	assert.ErrorIs(err, plan.ErrInvalidGrouping)
+	_, err = planner.ExecuteQuery("select max(salary) from emp having id > 1", tx)
+	assert.ErrorIs(err, plan.ErrInvalidGrouping)
+	_, err = planner.ExecuteQuery("select dept from emp where count(*) > 1 group by dept", tx)
+	assert.ErrorIs(err, plan.ErrInvalidGrouping)
+	tx.Commit()
+}
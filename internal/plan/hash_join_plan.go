@@ -0,0 +1,221 @@
+package plan
+
+import (
+	"slices"
+
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The Plan class for the hash join operator,
+// which joins two queries on the equality of a field of each.
+// The query with the fewest estimated records is the build side,
+// which is loaded into an in-memory hash table probed by the other one.
+// When the build side does not fit in the available buffers,
+// both inputs are first partitioned on the hash of their join
+// field into temporary tables (Grace hash join), so that each
+// build partition can be loaded on its own. A build partition
+// that still does not fit, as when a join value is very frequent,
+// is joined in chunks by the scan.
+type HashJoinPlan struct {
+	tx         *recovery.Transaction
+	left       Plan
+	right      Plan
+	leftField  string
+	rightField string
+	schema     *record.Schema
+}
+
+// Create a hash join plan joining the specified queries,
+// on the equality of the left field and the right field.
+func NewHashJoinPlan(tx *recovery.Transaction, left Plan, right Plan, leftField string, rightField string) *HashJoinPlan {
+	schema := record.NewSchema()
+	schema.AddAll(left.Schema())
+	schema.AddAll(right.Schema())
+	return &HashJoinPlan{tx, left, right, leftField, rightField, schema}
+}
+
+// This method reads the build side in memory,
+// as long as it fits in the available buffers.
+// When it does not, the records read so far and the remaining
+// ones are split into partitions, and so is the probe side.
+func (hjp *HashJoinPlan) Open() query.Scan {
+	build, buildField, probe, probeField := hjp.buildSide()
+	buildSchema := build.Schema()
+	buildFields := buildSchema.Fields()
+
+	probeSchema := probe.Schema()
+	probeFields := probeSchema.Fields()
+
+	src := build.Open()
+	records, hasMore := readRecords(src, buildFields, hjp.buildCapacity(&buildSchema, 1))
+	if !hasMore {
+		src.Close()
+		partition := query.HashJoinPartition{
+			Build: func() query.Scan { return query.NewMemoryScan(buildFields, records) },
+			Probe: probe.Open,
+		}
+		return query.NewHashJoinScan([]query.HashJoinPartition{partition}, buildFields, buildField, probeFields, probeField, int64(len(records)))
+	}
+
+	count := max(hjp.tx.AvailableBuffers()-1, 2)
+	buildPartitions := hjp.newPartitions(&buildSchema, count)
+	buildIndex := slices.Index(buildFields, buildField)
+	dests := openPartitions(buildPartitions)
+	for _, values := range records {
+		insertRecord(dests[partitionOf(values[buildIndex], count)], buildFields, values)
+	}
+	partitionRecord(src, dests, buildFields, buildField)
+	partitionRecords(src, dests, buildFields, buildField)
+	src.Close()
+	closeScans(dests)
+
+	probePartitions := hjp.newPartitions(&probeSchema, count)
+	src = probe.Open()
+	dests = openPartitions(probePartitions)
+	partitionRecords(src, dests, probeFields, probeField)
+	src.Close()
+	closeScans(dests)
+
+	partitions := make([]query.HashJoinPartition, 0, count)
+	for i := range buildPartitions {
+		partitions = append(partitions, query.HashJoinPartition{
+			Build: func() query.Scan { return buildPartitions[i].Open() },
+			Probe: func() query.Scan { return probePartitions[i].Open() },
+		})
+	}
+	// the build partition and the probe partition each
+	// pin a buffer while the hash table is probed
+	return query.NewHashJoinScan(partitions, buildFields, buildField, probeFields, probeField, hjp.buildCapacity(&buildSchema, 2))
+}
+
+// Estimates the number of block accesses to compute the join.
+// When the build side fits in the available buffers,
+// both inputs are read once:
+// B(hashjoin(p1,p2)) = B(p1) + B(p2)
+// Otherwise, the partitions are also written and read back:
+// B(hashjoin(p1,p2)) = 3*(B(p1) + B(p2))
+func (hjp *HashJoinPlan) BlockAccessed() int64 {
+	blocks := hjp.left.BlockAccessed() + hjp.right.BlockAccessed()
+	build, _, _, _ := hjp.buildSide()
+	buildSchema := build.Schema()
+	if estimatedBlocks(hjp.tx, &buildSchema, build.RecordsOutput()) <= max(hjp.tx.AvailableBuffers()-1, 1) {
+		return blocks
+	}
+	return 3 * blocks
+}
+
+// Estimates the number of output records in the join.
+// The formula is:
+// R(hashjoin(p1,p2)) = R(p1)*R(p2)/max(V(p1,F1),V(p2,F2))
+func (hjp *HashJoinPlan) RecordsOutput() int64 {
+	distinct := max(hjp.left.DistinctValues(hjp.leftField), hjp.right.DistinctValues(hjp.rightField), 1)
+	return hjp.left.RecordsOutput() * hjp.right.RecordsOutput() / distinct
+}
+
+// Estimates the number of distinct values for the
+// specified field, which is the same as in the
+// appropriate underlying query.
+func (hjp *HashJoinPlan) DistinctValues(fieldName string) int64 {
+	leftSchema := hjp.left.Schema()
+	if leftSchema.HasField(fieldName) {
+		return hjp.left.DistinctValues(fieldName)
+	}
+	return hjp.right.DistinctValues(fieldName)
+}
+
+// Returns the schema of the hash join,
+// which is the union of the schemas of the underlying queries.
+func (hjp *HashJoinPlan) Schema() record.Schema {
+	return *hjp.schema
+}
+
+// Returns the build query and field, followed by the
+// probe query and field.
+// The build side is the query with the fewest estimated records.
+func (hjp *HashJoinPlan) buildSide() (Plan, string, Plan, string) {
+	if hjp.right.RecordsOutput() <= hjp.left.RecordsOutput() {
+		return hjp.right, hjp.rightField, hjp.left, hjp.leftField
+	}
+	return hjp.left, hjp.leftField, hjp.right, hjp.rightField
+}
+
+// Returns the number of build records the available
+// buffers can hold, leaving the specified number of
+// buffers to the scans read meanwhile.
+func (hjp *HashJoinPlan) buildCapacity(schema *record.Schema, reserved int64) int64 {
+	layout := record.NewLayout(schema)
+	recordsPerBlock := max(hjp.tx.BlockSize()/layout.SlotSize(), 1)
+	blocks := max(hjp.tx.AvailableBuffers()-reserved, 1)
+	return blocks * recordsPerBlock
+}
+
+func (hjp *HashJoinPlan) newPartitions(schema *record.Schema, count int64) []*record.TempTable {
+	partitions := make([]*record.TempTable, 0, count)
+	for i := int64(0); i < count; i++ {
+		partitions = append(partitions, record.NewTempTable(hjp.tx, schema))
+	}
+	return partitions
+}
+
+// Reads up to the specified number of records from the scan.
+// Returns the records read, and whether the scan has more
+// records, in which case it is positioned on the next one.
+func readRecords(src query.Scan, fields []string, capacity int64) ([][]query.Constant, bool) {
+	records := make([][]query.Constant, 0)
+	for src.Next() {
+		if int64(len(records)) == capacity {
+			return records, true
+		}
+		values := make([]query.Constant, 0, len(fields))
+		for _, fieldName := range fields {
+			values = append(values, src.GetValue(fieldName))
+		}
+		records = append(records, values)
+	}
+	return records, false
+}
+
+// Inserts each remaining record of the scan into the
+// partition chosen from the hash of its partition field.
+func partitionRecords(src query.Scan, dests []query.UpdateScan, fields []string, fieldName string) {
+	for src.Next() {
+		partitionRecord(src, dests, fields, fieldName)
+	}
+}
+
+// Inserts the current record of the scan into the
+// partition chosen from the hash of its partition field.
+func partitionRecord(src query.Scan, dests []query.UpdateScan, fields []string, fieldName string) {
+	dest := dests[partitionOf(src.GetValue(fieldName), int64(len(dests)))]
+	dest.Insert()
+	for _, field := range fields {
+		dest.SetValue(field, src.GetValue(field))
+	}
+}
+
+func partitionOf(value query.Constant, count int64) int64 {
+	return value.HashCode() % count
+}
+
+func insertRecord(dest query.UpdateScan, fields []string, values []query.Constant) {
+	dest.Insert()
+	for i, fieldName := range fields {
+		dest.SetValue(fieldName, values[i])
+	}
+}
+
+func openPartitions(partitions []*record.TempTable) []query.UpdateScan {
+	scans := make([]query.UpdateScan, 0, len(partitions))
+	for _, partition := range partitions {
+		scans = append(scans, partition.Open())
+	}
+	return scans
+}
+
+func closeScans(scans []query.UpdateScan) {
+	for _, scan := range scans {
+		scan.Close()
+	}
+}
@@ -0,0 +1,138 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashJoinPlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_hash_join_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	for _, stmt := range []string{
+		"create table users(id int, name varchar(10))",
+		"create table orders(order_id int, user_id int)",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 300; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into users(id, name) values (%d, 'user_%d')", i, i), tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 600; i++ {
+		// users 0 to 99 have no orders, the others have 3 each
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into orders(order_id, user_id) values (%d, %d)", i, 100+i%200), tx)
+		assert.Nil(err)
+	}
+
+	tempFiles := func() []string {
+		files, err := filepath.Glob(path.Join(dbDir, "temp-*.tbl"))
+		assert.Nil(err)
+		return files
+	}
+	readJoin := func(p plan.Plan) map[int64]int64 {
+		orderCounts := make(map[int64]int64)
+		scan := p.Open()
+		for scan.Next() {
+			assert.Equal(scan.GetInt("id"), scan.GetInt("user_id"))
+			assert.Equal(fmt.Sprintf("user_%d", scan.GetInt("id")), scan.GetString("name"))
+			orderCounts[scan.GetInt("id")]++
+		}
+		scan.Close()
+		return orderCounts
+	}
+
+	// The users are the smaller input, but do not fit in the buffers,
+	// so both inputs are partitioned into temporary tables.
+	usersPlan := plan.NewTablePlan(tx, "users", db.MetadataManager())
+	ordersPlan := plan.NewTablePlan(tx, "orders", db.MetadataManager())
+	joinPlan := plan.NewHashJoinPlan(tx, ordersPlan, usersPlan, "user_id", "id")
+	orderCounts := readJoin(joinPlan)
+	assert.Len(orderCounts, 200)
+	for id := int64(100); id < 300; id++ {
+		assert.Equal(int64(3), orderCounts[id])
+	}
+	assert.NotEmpty(tempFiles())
+	assert.Less(joinPlan.RecordsOutput(), int64(600*300))
+	assert.Greater(joinPlan.BlockAccessed(), usersPlan.BlockAccessed()+ordersPlan.BlockAccessed())
+
+	// The planner hash joins the tables, and the selection on
+	// the users leaves few enough of them to be joined in memory.
+	tx.Commit()
+	assert.Empty(tempFiles())
+	tx = db.NewTx()
+	result, err := planner.ExecuteQuery("select id, name, user_id, order_id from orders, users where user_id = id and id >= 280", tx)
+	assert.Nil(err)
+	orderCounts = readJoin(result.(plan.Plan))
+	assert.Len(orderCounts, 20)
+	for id := int64(280); id < 300; id++ {
+		assert.Equal(int64(3), orderCounts[id])
+	}
+	assert.Empty(tempFiles())
+	tx.Commit()
+}
+
+func TestHashJoinPlanSkewedPartition(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_hash_join_plan_skewed_partition")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	for _, stmt := range []string{
+		"create table tags(tag_id int, label int)",
+		"create table items(item_id int, tag int)",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+	// all the tags have the same id, so they end up in a single
+	// partition, which does not fit in the buffers either
+	for i := 0; i < 400; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into tags(tag_id, label) values (1, %d)", i), tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 500; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into items(item_id, tag) values (%d, %d)", i, i%100), tx)
+		assert.Nil(err)
+	}
+
+	tagsPlan := plan.NewTablePlan(tx, "tags", db.MetadataManager())
+	itemsPlan := plan.NewTablePlan(tx, "items", db.MetadataManager())
+	joinPlan := plan.NewHashJoinPlan(tx, itemsPlan, tagsPlan, "tag", "tag_id")
+	scan := joinPlan.Open()
+	for round := 0; round < 2; round++ {
+		labelCounts := make(map[int64]int64)
+		for scan.Next() {
+			assert.Equal(int64(1), scan.GetInt("tag"))
+			assert.Equal(int64(1), scan.GetInt("item_id")%100)
+			labelCounts[scan.GetInt("label")]++
+		}
+		assert.Len(labelCounts, 400)
+		for label := int64(0); label < 400; label++ {
+			assert.Equal(int64(5), labelCounts[label])
+		}
+		scan.BeforeFirst()
+	}
+	scan.Close()
+	tx.Commit()
+}
@@ -0,0 +1,153 @@
+package plan
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// A query planner that optimizes using a heuristic-based algorithm.
+// Selections are pushed down to the tables they apply to,
+// indexes are used when they help, and the join order
+// is chosen greedily from the estimated output sizes.
+type HeuristicQueryPlanner struct {
+	mdtManager *metadata.MetadataManager
+}
+
+func NewHeuristicQueryPlanner(mdtManager *metadata.MetadataManager) *HeuristicQueryPlanner {
+	return &HeuristicQueryPlanner{mdtManager}
+}
+
+// Creates an optimized left-deep query plan using the following
+// heuristics.
+// H1. Choose the smallest table (considering selection predicates)
+// to be first in the join order.
+// H2. Add the table to the join order which
+// results in the smallest output.
+func (hqp *HeuristicQueryPlanner) CreatePlan(selectStmt parser.SelectStmt, tx *recovery.Transaction) Plan {
+	// Step 1: Plan each mentioned table or view, and resolve the
+	// column references of the query to the fields of the plans.
+	selectStmt, plans := resolveFromClause(selectStmt, func(tableRef parser.TableRef) Plan {
+		return hqp.createTablePlan(tableRef, tx)
+	})
+	tableRefs, outerItems, condition := splitFromClause(selectStmt)
+	predicate := query.NewPredicate(condition)
+
+	// Step 2: Create a TablePlanner object for each table or view,
+	// and for each item of the from clause with outer joins.
+	tablePlanners := make([]*TablePlanner, 0)
+	for _, tableRef := range tableRefs {
+		plan := plans[tableRef.Name()]
+		if tablePlan, ok := plan.(*TablePlan); ok {
+			tablePlanners = append(tablePlanners, NewTablePlanner(tablePlan, predicate, tx, hqp.mdtManager))
+		} else {
+			tablePlanners = append(tablePlanners, NewViewPlanner(plan, predicate, tx))
+		}
+	}
+	for _, fromItem := range outerItems {
+		plan := createFromItemPlan(fromItem, func(tableRef parser.TableRef) Plan {
+			return plans[tableRef.Name()]
+		})
+		tablePlanners = append(tablePlanners, NewViewPlanner(plan, predicate, tx))
+	}
+
+	// Step 3: Choose the lowest-size plan to begin the join order.
+	plan, tablePlanners := getLowestSelectPlan(tablePlanners)
+
+	// Step 4: Repeatedly add a plan to the join order.
+	for len(tablePlanners) > 0 {
+		var nextPlan Plan
+		nextPlan, tablePlanners = getLowestJoinPlan(plan, tablePlanners, selectStmt.OrderBy)
+		if nextPlan == nil {
+			nextPlan, tablePlanners = getLowestProductPlan(plan, tablePlanners)
+		}
+		plan = nextPlan
+	}
+
+	// Step 5: Group the records and select on the having condition.
+	plan = createGroupByPlan(plan, selectStmt)
+
+	// Step 6: Sort the records on the order keys,
+	// unless a mergejoin already produced that order.
+	if len(selectStmt.OrderBy) > 0 && !isSortedOn(plan, selectStmt.OrderBy) {
+		plan = NewSortPlan(tx, plan, selectStmt.OrderBy)
+	}
+
+	// Step 7: Project on the field names.
+	return NewProjectPlan(plan, selectStmt.Fields, selectStmt.Exprs)
+}
+
+// Plans the specified table of the from clause, or the
+// view it names, with its fields qualified by its name.
+func (hqp *HeuristicQueryPlanner) createTablePlan(tableRef parser.TableRef, tx *recovery.Transaction) Plan {
+	if viewPlan := hqp.createViewPlan(tableRef.Table, tx); viewPlan != nil {
+		return qualifyPlan(viewPlan, tableRef.Name())
+	}
+	return NewAliasedTablePlan(tx, tableRef.Table, tableRef.Name(), hqp.mdtManager)
+}
+
+// Recursively plans the specified view,
+// or returns nil if there is no such view.
+func (hqp *HeuristicQueryPlanner) createViewPlan(viewName string, tx *recovery.Transaction) Plan {
+	viewDef, err := hqp.mdtManager.GetViewDef(viewName, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching view definition: %w", err))
+	}
+	if viewDef == "" {
+		return nil
+	}
+	stmts, err := parser.ParseQuery(viewDef)
+	if err != nil {
+		panic(fmt.Errorf("error parsing view definition: %w", err))
+	}
+	viewStmt := stmts[0].(parser.SelectStmt)
+	return hqp.CreatePlan(viewStmt, tx)
+}
+
+// Returns the select plan with the smallest output,
+// along with the table planners left to join.
+func getLowestSelectPlan(tablePlanners []*TablePlanner) (Plan, []*TablePlanner) {
+	return getLowestPlan(tablePlanners, func(tp *TablePlanner) Plan {
+		return tp.MakeSelectPlan()
+	})
+}
+
+// Returns the join plan with the smallest output, or nil
+// if no table is joined to the current plan by the predicate,
+// along with the table planners left to join.
+func getLowestJoinPlan(current Plan, tablePlanners []*TablePlanner, orderBy []parser.OrderExpr) (Plan, []*TablePlanner) {
+	return getLowestPlan(tablePlanners, func(tp *TablePlanner) Plan {
+		return tp.MakeJoinPlan(current, orderBy)
+	})
+}
+
+// Returns the product plan with the smallest output,
+// along with the table planners left to join.
+func getLowestProductPlan(current Plan, tablePlanners []*TablePlanner) (Plan, []*TablePlanner) {
+	return getLowestPlan(tablePlanners, func(tp *TablePlanner) Plan {
+		return tp.MakeProductPlan(current)
+	})
+}
+
+func getLowestPlan(tablePlanners []*TablePlanner, makePlan func(tp *TablePlanner) Plan) (Plan, []*TablePlanner) {
+	bestIndex := -1
+	var bestPlan Plan
+	for i, tp := range tablePlanners {
+		plan := makePlan(tp)
+		if plan == nil {
+			continue
+		}
+		if bestPlan == nil || plan.RecordsOutput() < bestPlan.RecordsOutput() {
+			bestIndex = i
+			bestPlan = plan
+		}
+	}
+	if bestPlan == nil {
+		return nil, tablePlanners
+	}
+	return bestPlan, slices.Delete(tablePlanners, bestIndex, bestIndex+1)
+}
@@ -0,0 +1,136 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+// Parses an input holding a single query.
+func parseSelectStmt(t *testing.T, queryStr string) parser.SelectStmt {
+	stmts, err := parser.ParseQuery(queryStr)
+	assert.Nil(t, err, queryStr)
+	return stmts[0].(parser.SelectStmt)
+}
+
+func TestHeuristicQueryPlanner(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_heuristic_query_planner")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+	for _, stmt := range []string{
+		"create table students(sid int, sname varchar(10), major_id int)",
+		"create table depts(did int, dname varchar(10))",
+		"create table enrolls(student_id int, grade varchar(2))",
+		"create index enrolls_student_idx on enrolls(student_id)",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 5; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into depts(did, dname) values (%d, 'dept_%d')", i, i), tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 40; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into students(sid, sname, major_id) values (%d, 'student_%d', %d)", i, i, i%5), tx)
+		assert.Nil(err)
+		for _, grade := range []string{"A", "B"} {
+			_, err = planner.ExecuteQuery(fmt.Sprintf("insert into enrolls(student_id, grade) values (%d, '%s')", i, grade), tx)
+			assert.Nil(err)
+		}
+	}
+	tx.Commit()
+
+	// Reopen the database so that the statistics reflect the inserted records.
+	db = server.NewSimpleDB(dbDir, 400, 8)
+	tx = db.NewTx()
+	queryStr := "select sname, grade, dname from students, enrolls, depts where major_id = did and sid = student_id"
+	selectStmt := parseSelectStmt(t, queryStr)
+
+	basicPlan := plan.NewBasicQueryPlanner(db.MetadataManager()).CreatePlan(selectStmt, tx)
+	heuristicPlan := plan.NewHeuristicQueryPlanner(db.MetadataManager()).CreatePlan(selectStmt, tx)
+	assert.Less(heuristicPlan.BlockAccessed(), basicPlan.BlockAccessed())
+
+	readRows := func(p plan.Plan) []string {
+		rows := make([]string, 0)
+		scan := p.Open()
+		for scan.Next() {
+			rows = append(rows, scan.GetString("sname")+":"+scan.GetString("grade")+":"+scan.GetString("dname"))
+		}
+		scan.Close()
+		return rows
+	}
+	expected := make([]string, 0)
+	for i := 0; i < 40; i++ {
+		expected = append(expected, fmt.Sprintf("student_%d:A:dept_%d", i, i%5), fmt.Sprintf("student_%d:B:dept_%d", i, i%5))
+	}
+	assert.ElementsMatch(expected, readRows(heuristicPlan))
+	assert.ElementsMatch(expected, readRows(basicPlan))
+
+	// Tables that are not joined by the predicate are multiplied.
+	selectStmt = parseSelectStmt(t, "select dname, sname from depts, students where sid = 7")
+	heuristicPlan = plan.NewHeuristicQueryPlanner(db.MetadataManager()).CreatePlan(selectStmt, tx)
+	scan := heuristicPlan.Open()
+	count := 0
+	for scan.Next() {
+		assert.Equal("student_7", scan.GetString("sname"))
+		count++
+	}
+	scan.Close()
+	assert.Equal(5, count)
+	tx.Commit()
+}
+
+func TestHeuristicQueryPlannerNestedCondition(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_heuristic_query_planner_nested_condition")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+	_, err = planner.ExecuteQuery("create table students(sid int, sname varchar(10), major_id int)", tx)
+	assert.Nil(err)
+	_, err = planner.ExecuteQuery("create index students_major_idx on students(major_id)", tx)
+	assert.Nil(err)
+	for i := 0; i < 30; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into students(sid, sname, major_id) values (%d, 'student_%d', %d)", i, i, i%5), tx)
+		assert.Nil(err)
+	}
+
+	readSids := func(queryStr string) []int64 {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err)
+		sids := make([]int64, 0)
+		scan := result.(plan.Plan).Open()
+		for scan.Next() {
+			sids = append(sids, scan.GetInt("sid"))
+		}
+		scan.Close()
+		return sids
+	}
+
+	assert.ElementsMatch([]int64{10, 13, 14, 15, 18, 19},
+		readSids("select sid from students where sid >= 10 and not (major_id = 1 or major_id = 2) and sid < 20"))
+	assert.ElementsMatch([]int64{0, 1, 2, 29},
+		readSids("select sid from students where sid <= 2 or sid > 28"))
+	// The index on major_id must not be used for a term of a disjunction.
+	assert.ElementsMatch([]int64{3, 8, 13, 18, 23, 28, 25},
+		readSids("select sid from students where major_id = 3 or sid = 25"))
+	assert.ElementsMatch([]int64{4, 9, 14},
+		readSids("select sid from students where (major_id = 4) and sid != 19 and sid < 20"))
+	tx.Commit()
+}
@@ -0,0 +1,69 @@
+package plan
+
+import (
+	"github.com/evanxg852000/simpledb/internal/index"
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The Plan class corresponding to the indexjoin
+// relational algebra operator.
+type IndexJoinPlan struct {
+	left      Plan
+	right     *TablePlan
+	indexInfo *metadata.IndexInfo
+	joinField string
+	schema    *record.Schema
+}
+
+// Implements the join operator,
+// using the specified LHS and RHS plans.
+// The index is built on the join field of the RHS table,
+// and joinField names the LHS field compared against it.
+func NewIndexJoinPlan(left Plan, right *TablePlan, indexInfo *metadata.IndexInfo, joinField string) *IndexJoinPlan {
+	schema := record.NewSchema()
+	schema.AddAll(left.Schema())
+	schema.AddAll(right.Schema())
+	return &IndexJoinPlan{left, right, indexInfo, joinField, schema}
+}
+
+// Opens an indexjoin scan for this query.
+func (ijp *IndexJoinPlan) Open() query.Scan {
+	leftScan := ijp.left.Open()
+	rightScan := ijp.right.Open().(*record.TableScan)
+	idx := ijp.indexInfo.Open()
+	return index.NewIndexJoinScan(leftScan, idx, ijp.joinField, rightScan)
+}
+
+// Estimates the number of block accesses to compute the join.
+// The formula is:
+// B(indexjoin(p1,p2,idx)) = B(p1) + R(p1)*B(idx)
+// + R(indexjoin(p1,p2,idx))
+func (ijp *IndexJoinPlan) BlockAccessed() int64 {
+	return ijp.left.BlockAccessed() +
+		(ijp.left.RecordsOutput() * ijp.indexInfo.BlockAccessed()) +
+		ijp.RecordsOutput()
+}
+
+// Estimates the number of output records in the join.
+// The formula is:
+// R(indexjoin(p1,p2,idx)) = R(p1)*R(idx)
+func (ijp *IndexJoinPlan) RecordsOutput() int64 {
+	return ijp.left.RecordsOutput() * ijp.indexInfo.RecordsOutput()
+}
+
+// Estimates the number of distinct values for the
+// specified field.
+func (ijp *IndexJoinPlan) DistinctValues(fieldName string) int64 {
+	leftSchema := ijp.left.Schema()
+	if leftSchema.HasField(fieldName) {
+		return ijp.left.DistinctValues(fieldName)
+	}
+	return ijp.right.DistinctValues(fieldName)
+}
+
+// Returns the schema of the index join.
+func (ijp *IndexJoinPlan) Schema() record.Schema {
+	return *ijp.schema
+}
@@ -0,0 +1,121 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexJoinPlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_index_join_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	for _, stmt := range []string{
+		"create table users(id int, name varchar(10))",
+		"create table orders(order_id int, user_id int)",
+		"create index orders_user_idx on orders(user_id)",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 10; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into users(id, name) values (%d, 'user_%d')", i, i), tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 30; i++ {
+		// users 0 to 4 have no orders
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into orders(order_id, user_id) values (%d, %d)", i, 5+i%5), tx)
+		assert.Nil(err)
+	}
+
+	// The index on the right table is probed for each left record.
+	indexes, err := db.MetadataManager().GetIndexInfo("orders", tx)
+	assert.Nil(err)
+	indexInfo := indexes["user_id"]
+	usersPlan := plan.NewTablePlan(tx, "users", db.MetadataManager())
+	ordersPlan := plan.NewTablePlan(tx, "orders", db.MetadataManager())
+	joinPlan := plan.NewIndexJoinPlan(usersPlan, ordersPlan, &indexInfo, "id")
+
+	count := 0
+	scan := joinPlan.Open()
+	for scan.Next() {
+		assert.Equal(scan.GetInt("id"), scan.GetInt("user_id"))
+		assert.Equal(fmt.Sprintf("user_%d", scan.GetInt("id")), scan.GetString("name"))
+		count++
+	}
+	scan.Close()
+	assert.Equal(30, count)
+
+	// The planner joins through the index with the index on either side.
+	for _, queryStr := range []string{
+		"select name, order_id from users, orders where id = user_id",
+		"select name, order_id from orders, users where user_id = id",
+	} {
+		result, err := planner.ExecuteQuery(queryStr+" and id = 7", tx)
+		assert.Nil(err)
+		orderIds := make([]int64, 0)
+		scan = result.(plan.Plan).Open()
+		for scan.Next() {
+			assert.Equal("user_7", scan.GetString("name"))
+			orderIds = append(orderIds, scan.GetInt("order_id"))
+		}
+		scan.Close()
+		assert.ElementsMatch([]int64{2, 7, 12, 17, 22, 27}, orderIds)
+	}
+	tx.Commit()
+}
+
+func TestIndexJoinPlanNullKeys(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_index_join_plan_null_keys")
+	assert.Nil(err)
+	defer os.RemoveAll(workspaceDir)
+
+	// The left records whose join value is null match no record,
+	// and are not looked up in the index, with either planner.
+	for i, option := range []server.Option{server.WithQueryPlanner(server.HEURISTIC_PLANNER), server.WithQueryPlanner(server.BASIC_PLANNER)} {
+		for _, method := range []string{"btree", "hash"} {
+			dbDir := path.Join(workspaceDir, fmt.Sprintf("db_%d_%s", i, method))
+			db := server.NewSimpleDB(dbDir, 400, 8, option)
+			planner := db.Planner()
+			tx := db.NewTx()
+			for _, stmt := range []string{
+				"create table a(n int, x varchar(10))",
+				"create table b(m int, y varchar(10))",
+				"create index b_y_idx on b(y) using " + method,
+				"insert into a(n, x) values (1, null)",
+				"insert into a(n, x) values (2, 'k')",
+				"insert into a(n, x) values (3, null)",
+				"insert into b(m, y) values (10, 'k')",
+				"insert into b(m, y) values (11, null)",
+				"insert into b(m, y) values (12, 'k')",
+			} {
+				_, err = planner.ExecuteQuery(stmt, tx)
+				assert.Nil(err, stmt)
+			}
+
+			result, err := planner.ExecuteQuery("select n, m from a, b where x = y", tx)
+			assert.Nil(err)
+			rows := make([]string, 0)
+			scan := result.(plan.Plan).Open()
+			for scan.Next() {
+				rows = append(rows, fmt.Sprintf("%d:%d", scan.GetInt("n"), scan.GetInt("m")))
+			}
+			scan.Close()
+			assert.ElementsMatch([]string{"2:10", "2:12"}, rows, method)
+			tx.Commit()
+		}
+	}
+}
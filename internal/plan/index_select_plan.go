@@ -0,0 +1,53 @@
+package plan
+
+import (
+	"github.com/evanxg852000/simpledb/internal/index"
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The Plan class corresponding to the index select
+// relational algebra operator.
+type IndexSelectPlan struct {
+	plan      *TablePlan
+	indexInfo *metadata.IndexInfo
+	value     query.Constant
+}
+
+// Creates a new index select node in the query tree
+// for the specified index and selection constant.
+func NewIndexSelectPlan(plan *TablePlan, indexInfo *metadata.IndexInfo, value query.Constant) *IndexSelectPlan {
+	return &IndexSelectPlan{plan, indexInfo, value}
+}
+
+// Creates a new index select scan for this query.
+func (isp *IndexSelectPlan) Open() query.Scan {
+	tblScan := isp.plan.Open().(*record.TableScan)
+	idx := isp.indexInfo.Open()
+	return index.NewIndexSelectScan(tblScan, idx, isp.value)
+}
+
+// Estimates the number of block accesses to compute the
+// index selection, which is the same as the
+// index traversal cost plus the number of matching data records.
+func (isp *IndexSelectPlan) BlockAccessed() int64 {
+	return isp.indexInfo.BlockAccessed() + isp.RecordsOutput()
+}
+
+// Estimates the number of output records in the index selection,
+// which is the same as the number of search key values
+// for the index.
+func (isp *IndexSelectPlan) RecordsOutput() int64 {
+	return isp.indexInfo.RecordsOutput()
+}
+
+// Returns the distinct values as defined by the index.
+func (isp *IndexSelectPlan) DistinctValues(fieldName string) int64 {
+	return isp.indexInfo.DistinctValues(isp.plan.unqualify(fieldName))
+}
+
+// Returns the schema of the data table.
+func (isp *IndexSelectPlan) Schema() record.Schema {
+	return isp.plan.Schema()
+}
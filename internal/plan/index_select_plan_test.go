@@ -0,0 +1,72 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexSelectPlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_index_select_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	_, err = planner.ExecuteQuery("create table users(id int, name varchar(10))", tx)
+	assert.Nil(err)
+	_, err = planner.ExecuteQuery("create index users_id_idx on users(id)", tx)
+	assert.Nil(err)
+	for i := 0; i < 100; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into users(id, name) values (%d, 'user_%d')", i%50, i), tx)
+		assert.Nil(err)
+	}
+	tx.Commit()
+
+	// Reopen the database so that the statistics reflect the inserted records.
+	db = server.NewSimpleDB(dbDir, 400, 8)
+	planner = db.Planner()
+	tx = db.NewTx()
+
+	// The index select plan only reads the matching records.
+	indexes, err := db.MetadataManager().GetIndexInfo("users", tx)
+	assert.Nil(err)
+	indexInfo := indexes["id"]
+	tablePlan := plan.NewTablePlan(tx, "users", db.MetadataManager())
+	indexPlan := plan.NewIndexSelectPlan(tablePlan, &indexInfo, query.NewConstant(int64(3)))
+	assert.Less(indexPlan.BlockAccessed(), tablePlan.BlockAccessed())
+
+	names := make([]string, 0)
+	scan := indexPlan.Open()
+	for scan.Next() {
+		assert.Equal(int64(3), scan.GetInt("id"))
+		names = append(names, scan.GetString("name"))
+	}
+	scan.Close()
+	assert.ElementsMatch([]string{"user_3", "user_53"}, names)
+
+	// The planner picks the index for a field = constant term.
+	result, err := planner.ExecuteQuery("select name from users where id = 42", tx)
+	assert.Nil(err)
+	queryPlan := result.(plan.Plan)
+	assert.Less(queryPlan.BlockAccessed(), tablePlan.BlockAccessed())
+
+	names = make([]string, 0)
+	scan = queryPlan.Open()
+	for scan.Next() {
+		names = append(names, scan.GetString("name"))
+	}
+	scan.Close()
+	assert.ElementsMatch([]string{"user_42", "user_92"}, names)
+	tx.Commit()
+}
@@ -0,0 +1,277 @@
+package plan
+
+import (
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/index"
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// A modification of the basic update planner.
+// Insert, delete and modify statements also update
+// every index of the table, so that the indexes
+// stay in sync with the records.
+// Null values are not indexed, since no equality
+// with a constant can select them.
+type IndexUpdatePlanner struct {
+	*BasicUpdatePlanner
+	mdtManager *metadata.MetadataManager
+}
+
+func NewIndexUpdatePlanner(mdtManager *metadata.MetadataManager) *IndexUpdatePlanner {
+	return &IndexUpdatePlanner{NewBasicUpdatePlanner(mdtManager), mdtManager}
+}
+
+// Inserts the record, then an entry for the new record
+// in each index of the table.
+func (iup *IndexUpdatePlanner) ExecuteInsert(stmt parser.InsertStmt, tx *recovery.Transaction) int64 {
+	plan := NewTablePlan(tx, stmt.Table, iup.mdtManager)
+
+	// first, insert the record
+	updateScan := plan.Open().(query.UpdateScan)
+	updateScan.Insert()
+	rId := updateScan.GetRID()
+
+	// then modify each field, inserting an index record if appropriate
+	indexes, err := iup.mdtManager.GetIndexInfo(stmt.Table, tx)
+	if err != nil {
+		panic(err)
+	}
+	for i, fieldName := range insertFields(stmt, plan.Schema()) {
+		value := query.NewConstant(stmt.Values[i].Value)
+		updateScan.SetValue(fieldName, value)
+
+		if indexInfo, ok := indexes[fieldName]; ok && !value.IsNull() {
+			idx := indexInfo.Open()
+			idx.Insert(value, rId)
+			idx.Close()
+		}
+	}
+	updateScan.Close()
+	return 1
+}
+
+// Deletes the index entries of each selected record,
+// then the record itself.
+func (iup *IndexUpdatePlanner) ExecuteDelete(stmt parser.DeleteStmt, tx *recovery.Transaction) int64 {
+	var plan Plan
+	plan = NewTablePlan(tx, stmt.Table, iup.mdtManager)
+	plan = NewSelectPlan(plan, query.NewPredicate(stmt.Condition))
+	indexes, err := iup.mdtManager.GetIndexInfo(stmt.Table, tx)
+	if err != nil {
+		panic(err)
+	}
+
+	updateScan := plan.Open().(query.UpdateScan)
+	count := 0
+	for updateScan.Next() {
+		// first, delete the record's RID from every index
+		rId := updateScan.GetRID()
+		for fieldName, indexInfo := range indexes {
+			value := updateScan.GetValue(fieldName)
+			if value.IsNull() {
+				continue
+			}
+			idx := indexInfo.Open()
+			idx.Delete(value, rId)
+			idx.Close()
+		}
+		// then delete the record
+		updateScan.Delete()
+		count += 1
+	}
+	updateScan.Close()
+	return int64(count)
+}
+
+// Modifies the selected records, moving the index entry
+// of every updated field that is indexed.
+func (iup *IndexUpdatePlanner) ExecuteModify(stmt parser.UpdateStmt, tx *recovery.Transaction) int64 {
+	var plan Plan
+	plan = NewTablePlan(tx, stmt.Table, iup.mdtManager)
+	plan = NewSelectPlan(plan, query.NewPredicate(stmt.Condition))
+	indexes, err := iup.mdtManager.GetIndexInfo(stmt.Table, tx)
+	if err != nil {
+		panic(err)
+	}
+
+	updateScan := plan.Open().(query.UpdateScan)
+	count := 0
+	for updateScan.Next() {
+		for _, updateExpr := range stmt.Exprs {
+			expr := query.NewExpression(updateExpr.Value)
+			newValue := expr.Evaluate(updateScan)
+			oldValue := updateScan.GetValue(updateExpr.Field)
+			updateScan.SetValue(updateExpr.Field, newValue)
+
+			// then update the appropriate index, if it exists
+			if indexInfo, ok := indexes[updateExpr.Field]; ok {
+				rId := updateScan.GetRID()
+				idx := indexInfo.Open()
+				if !oldValue.IsNull() {
+					idx.Delete(oldValue, rId)
+				}
+				if !newValue.IsNull() {
+					idx.Insert(newValue, rId)
+				}
+				idx.Close()
+			}
+		}
+		count += 1
+	}
+	updateScan.Close()
+	return int64(count)
+}
+
+// Registers the index, then loads the value and RID of
+// every existing record of the table into it.
+func (iup *IndexUpdatePlanner) ExecuteCreateIndex(stmt parser.CreateIndexStmt, tx *recovery.Transaction) int64 {
+	layout, err := iup.mdtManager.GetLayout(stmt.Table, tx)
+	if err != nil {
+		panic(err)
+	}
+	if !layout.Schema.HasField(stmt.Field) {
+		panic(fmt.Errorf("%w: `%s` in table `%s`", query.ErrColumnNotFound, stmt.Field, stmt.Table))
+	}
+	if layout.Schema.MayBeOutOfLine(stmt.Field) {
+		panic(fmt.Errorf("field `%s` of table `%s` may be stored out of line and cannot be indexed", stmt.Field, stmt.Table))
+	}
+
+	err = iup.mdtManager.CreateIndex(stmt.Name, stmt.Table, stmt.Field, indexType(stmt.Method), tx)
+	if err != nil {
+		panic(err)
+	}
+	indexes, err := iup.mdtManager.GetIndexInfo(stmt.Table, tx)
+	if err != nil {
+		panic(err)
+	}
+	indexInfo := indexes[stmt.Field]
+
+	// the index records of the existing records are sorted by
+	// data value through a sort plan, which writes them to
+	// temporary tables when they do not fit in the buffers,
+	// then loaded as they are read back
+	entryPlan := newIndexEntryPlan(NewTablePlan(tx, stmt.Table, iup.mdtManager), stmt.Field)
+	idx := indexInfo.Open()
+	if loader, ok := idx.(index.BulkLoader); ok {
+		orderBy := append(joinOrder("data_val"), append(joinOrder("block"), joinOrder("id")...)...)
+		entries := NewSortPlan(tx, entryPlan, orderBy).Open()
+		loader.BulkLoad(entries)
+		entries.Close()
+	} else {
+		entries := entryPlan.Open()
+		for entries.Next() {
+			idx.Insert(entries.GetValue("data_val"), query.NewRID(entries.GetInt("block"), entries.GetInt("id")))
+		}
+		entries.Close()
+	}
+	idx.Close()
+	return 0
+}
+
+// The plan of the index records of the non-null values
+// of a field of a table, whose fields are those of the
+// index records: the data value and the RID of its record,
+// as a block number and a slot.
+type indexEntryPlan struct {
+	plan      *TablePlan
+	fieldName string
+	schema    record.Schema
+}
+
+func newIndexEntryPlan(plan *TablePlan, fieldName string) *indexEntryPlan {
+	tblSchema := plan.Schema()
+	schema := record.NewSchema()
+	schema.AddIntField("block")
+	schema.AddIntField("id")
+	schema.AddField("data_val", tblSchema.FieldType(fieldName), tblSchema.FieldLength(fieldName))
+	return &indexEntryPlan{plan, fieldName, *schema}
+}
+
+func (iep *indexEntryPlan) Open() query.Scan {
+	return &indexEntryScan{iep.plan.Open().(query.UpdateScan), iep.fieldName}
+}
+
+func (iep *indexEntryPlan) BlockAccessed() int64 {
+	return iep.plan.BlockAccessed()
+}
+
+func (iep *indexEntryPlan) RecordsOutput() int64 {
+	return iep.plan.RecordsOutput()
+}
+
+func (iep *indexEntryPlan) DistinctValues(fieldName string) int64 {
+	if fieldName == "data_val" {
+		return iep.plan.DistinctValues(iep.fieldName)
+	}
+	return iep.plan.RecordsOutput()
+}
+
+func (iep *indexEntryPlan) Schema() record.Schema {
+	return iep.schema
+}
+
+// The scan of an indexEntryPlan, which skips
+// the records whose field is null.
+type indexEntryScan struct {
+	scan      query.UpdateScan
+	fieldName string
+}
+
+func (ies *indexEntryScan) BeforeFirst() {
+	ies.scan.BeforeFirst()
+}
+
+func (ies *indexEntryScan) Next() bool {
+	for ies.scan.Next() {
+		if !ies.scan.IsNull(ies.fieldName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ies *indexEntryScan) GetInt(fieldName string) int64 {
+	switch fieldName {
+	case "block":
+		return ies.scan.GetRID().BlockNum
+	case "id":
+		return ies.scan.GetRID().Slot
+	case "data_val":
+		return ies.scan.GetInt(ies.fieldName)
+	}
+	panic(query.NewColumnNotFoundError(fieldName))
+}
+
+func (ies *indexEntryScan) GetString(fieldName string) string {
+	if fieldName == "data_val" {
+		return ies.scan.GetString(ies.fieldName)
+	}
+	panic(query.NewColumnNotFoundError(fieldName))
+}
+
+func (ies *indexEntryScan) GetValue(fieldName string) query.Constant {
+	switch fieldName {
+	case "block", "id":
+		return query.NewConstant(ies.GetInt(fieldName))
+	case "data_val":
+		return ies.scan.GetValue(ies.fieldName)
+	}
+	panic(query.NewColumnNotFoundError(fieldName))
+}
+
+func (ies *indexEntryScan) IsNull(fieldName string) bool {
+	return false
+}
+
+func (ies *indexEntryScan) HasField(fieldName string) bool {
+	return fieldName == "data_val" || fieldName == "block" || fieldName == "id"
+}
+
+func (ies *indexEntryScan) Close() {
+	ies.scan.Close()
+}
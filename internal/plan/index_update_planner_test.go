@@ -0,0 +1,169 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/index"
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+	"github.com/stretchr/testify/assert"
+)
+
+func indexedRIDs(db *server.SimpleDB, tx *recovery.Transaction, tblName, fldName string, key any) []query.RID {
+	indexes, _ := db.MetadataManager().GetIndexInfo(tblName, tx)
+	indexInfo := indexes[fldName]
+	idx := indexInfo.Open()
+	defer idx.Close()
+
+	rIds := make([]query.RID, 0)
+	idx.BeforeFirst(query.NewConstant(key))
+	for idx.Next() {
+		rIds = append(rIds, idx.GetDataRID())
+	}
+	return rIds
+}
+
+func TestIndexUpdatePlanner(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_index_update_planner")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	_, err = planner.ExecuteQuery("create table users(id int, name varchar(10))", tx)
+	assert.Nil(err)
+	_, err = planner.ExecuteQuery("create index users_name_idx on users(name)", tx)
+	assert.Nil(err)
+	for _, stmt := range []string{
+		"insert into users(id, name) values (1, 'alice')",
+		"insert into users(id, name) values (2, 'bob')",
+		"insert into users(id, name) values (3, 'bob')",
+	} {
+		count, err := planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+		assert.Equal(int64(1), count)
+	}
+	assert.Equal(1, len(indexedRIDs(db, tx, "users", "name", "alice")))
+	assert.Equal(2, len(indexedRIDs(db, tx, "users", "name", "bob")))
+
+	// Updating an indexed field moves its index entry.
+	count, err := planner.ExecuteQuery("update users set name = 'carol' where id = 2", tx)
+	assert.Nil(err)
+	assert.Equal(int64(1), count)
+	assert.Equal(1, len(indexedRIDs(db, tx, "users", "name", "bob")))
+	assert.Equal(1, len(indexedRIDs(db, tx, "users", "name", "carol")))
+
+	// Deleting a record removes its index entries.
+	count, err = planner.ExecuteQuery("delete from users where name = 'alice'", tx)
+	assert.Nil(err)
+	assert.Equal(int64(1), count)
+	assert.Equal(0, len(indexedRIDs(db, tx, "users", "name", "alice")))
+	tx.Commit()
+}
+
+func TestCreateIndexBackfill(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_create_index_backfill")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	_, err = planner.ExecuteQuery("create table users(id int, name varchar(10))", tx)
+	assert.Nil(err)
+	for i := 0; i < 60; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into users(id, name) values (%d, 'user_%d')", i, i%20), tx)
+		assert.Nil(err)
+	}
+
+	// Existing records are loaded into the new indexes,
+	// which are B-tree indexes unless specified otherwise.
+	_, err = planner.ExecuteQuery("create index users_id_idx on users(id)", tx)
+	assert.Nil(err)
+	_, err = planner.ExecuteQuery("create index bad_idx on users(name) using bitmap", tx)
+	assert.EqualError(err, "not supported: index type `bitmap`")
+	_, err = planner.ExecuteQuery("create index users_name_idx on users(name) using hash", tx)
+	assert.Nil(err)
+	indexes, err := db.MetadataManager().GetIndexInfo("users", tx)
+	assert.Nil(err)
+	assert.Equal(int64(index.BTREE_INDEX), indexes["id"].IndexType)
+	assert.Equal(int64(index.HASH_INDEX), indexes["name"].IndexType)
+	assert.Equal(1, len(indexedRIDs(db, tx, "users", "id", int64(42))))
+	assert.Equal(3, len(indexedRIDs(db, tx, "users", "name", "user_7")))
+	assert.Equal(0, len(indexedRIDs(db, tx, "users", "name", "user_20")))
+
+	// Unknown tables and fields are rejected without registering the index.
+	_, err = planner.ExecuteQuery("create index bad_idx on customers(id)", tx)
+	assert.ErrorIs(err, metadata.ErrTableNotFound)
+	assert.EqualError(err, "table not found: `customers`")
+	_, err = planner.ExecuteQuery("create index bad_idx on users(age)", tx)
+	assert.ErrorIs(err, query.ErrColumnNotFound)
+	assert.EqualError(err, "column not found: `age` in table `users`")
+	_, err = planner.ExecuteQuery("create index bad_idx on users(id) using hash", tx)
+	assert.ErrorIs(err, plan.ErrDuplicateName)
+	assert.EqualError(err, "duplicate name: column `id` of table `users` already has index `users_id_idx`")
+	indexes, err = db.MetadataManager().GetIndexInfo("users", tx)
+	assert.Nil(err)
+	assert.Equal(2, len(indexes))
+	tx.Commit()
+}
+
+func TestCreateIndexBackfillLargeTable(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_create_index_backfill_large_table")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	// Far more index records than the buffers hold are sorted
+	// through temporary tables before being loaded, including
+	// a key with more records than a leaf holds.
+	_, err = planner.ExecuteQuery("create table users(id int, age int)", tx)
+	assert.Nil(err)
+	for i := 0; i < 1500; i++ {
+		age := (i * 7) % 300
+		if i%10 == 0 {
+			age = 42
+		}
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into users(id, age) values (%d, %d)", i, age), tx)
+		assert.Nil(err)
+	}
+	_, err = planner.ExecuteQuery("insert into users(id, age) values (1500, null)", tx)
+	assert.Nil(err)
+	_, err = planner.ExecuteQuery("create index users_age_idx on users(age)", tx)
+	assert.Nil(err)
+
+	expected := map[int64]int{}
+	result, err := planner.ExecuteQuery("select age from users", tx)
+	assert.Nil(err)
+	scan := result.(plan.Plan).Open()
+	for scan.Next() {
+		if !scan.IsNull("age") {
+			expected[scan.GetInt("age")]++
+		}
+	}
+	scan.Close()
+	for _, age := range []int64{0, 1, 42, 150, 299} {
+		assert.Equal(expected[age], len(indexedRIDs(db, tx, "users", "age", age)), fmt.Sprintf("age %d", age))
+	}
+	assert.Equal(155, expected[42])
+	assert.Equal(0, len(indexedRIDs(db, tx, "users", "age", int64(300))))
+	tx.Commit()
+}
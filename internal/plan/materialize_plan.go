@@ -0,0 +1,81 @@
+package plan
+
+import (
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The Plan class for the materialize operator.
+// The records of the underlying query are copied into
+// a temporary table, which can then be rescanned
+// without evaluating the query again.
+type MaterializePlan struct {
+	tx   *recovery.Transaction
+	plan Plan
+}
+
+// Create a materialize plan for the specified query.
+func NewMaterializePlan(tx *recovery.Transaction, plan Plan) *MaterializePlan {
+	return &MaterializePlan{tx, plan}
+}
+
+// This method loops through the underlying query,
+// copying its output records into a temporary table.
+// It then returns a table scan for that table.
+func (mp *MaterializePlan) Open() query.Scan {
+	schema := mp.plan.Schema()
+	temp := record.NewTempTable(mp.tx, &schema)
+	src := mp.plan.Open()
+	dest := temp.Open()
+	copyRecords(src, dest, schema.Fields())
+	src.Close()
+	dest.BeforeFirst()
+	return dest
+}
+
+// Return the estimated number of blocks in the
+// materialized table.
+// It does not include the one-time cost
+// of materializing the records.
+func (mp *MaterializePlan) BlockAccessed() int64 {
+	schema := mp.plan.Schema()
+	return estimatedBlocks(mp.tx, &schema, mp.plan.RecordsOutput())
+}
+
+// Return the number of records in the materialized table,
+// which is the same as in the underlying plan.
+func (mp *MaterializePlan) RecordsOutput() int64 {
+	return mp.plan.RecordsOutput()
+}
+
+// Return the number of distinct field values,
+// which is the same as in the underlying plan.
+func (mp *MaterializePlan) DistinctValues(fieldName string) int64 {
+	return mp.plan.DistinctValues(fieldName)
+}
+
+// Return the schema of the materialized table,
+// which is the same as in the underlying plan.
+func (mp *MaterializePlan) Schema() record.Schema {
+	return mp.plan.Schema()
+}
+
+// Inserts each remaining record of the source scan
+// into the destination scan.
+func copyRecords(src query.Scan, dest query.UpdateScan, fields []string) {
+	for src.Next() {
+		dest.Insert()
+		for _, fieldName := range fields {
+			dest.SetValue(fieldName, src.GetValue(fieldName))
+		}
+	}
+}
+
+// Returns the number of blocks needed to store
+// the specified number of records of the schema.
+func estimatedBlocks(tx *recovery.Transaction, schema *record.Schema, records int64) int64 {
+	layout := record.NewLayout(schema)
+	recordsPerBlock := max(tx.BlockSize()/layout.SlotSize(), 1)
+	return (records + recordsPerBlock - 1) / recordsPerBlock
+}
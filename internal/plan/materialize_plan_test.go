@@ -0,0 +1,127 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaterializePlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_materialize_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	_, err = planner.ExecuteQuery("create table users(id int, name varchar(10))", tx)
+	assert.Nil(err)
+	for i := 0; i < 100; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into users(id, name) values (%d, 'user_%d')", i, i), tx)
+		assert.Nil(err)
+	}
+
+	result, err := planner.ExecuteQuery("select id, name from users where id >= 90", tx)
+	assert.Nil(err)
+	queryPlan := result.(plan.Plan)
+	materializePlan := plan.NewMaterializePlan(tx, queryPlan)
+	assert.Equal(queryPlan.Schema(), materializePlan.Schema())
+	assert.Equal(queryPlan.RecordsOutput(), materializePlan.RecordsOutput())
+	assert.Greater(materializePlan.BlockAccessed(), int64(0))
+
+	readNames := func(scan query.Scan) []string {
+		names := make([]string, 0)
+		for scan.Next() {
+			names = append(names, scan.GetString("name"))
+		}
+		return names
+	}
+	expected := make([]string, 0)
+	for i := 90; i < 100; i++ {
+		expected = append(expected, fmt.Sprintf("user_%d", i))
+	}
+
+	// The materialized records can be read again.
+	scan := materializePlan.Open()
+	assert.Equal(expected, readNames(scan))
+	scan.BeforeFirst()
+	assert.Equal(expected, readNames(scan))
+	scan.Close()
+
+	tempFiles, err := filepath.Glob(path.Join(dbDir, "temp-*.tbl"))
+	assert.Nil(err)
+	assert.Len(tempFiles, 1)
+	tx.Commit()
+	assert.NoFileExists(tempFiles[0])
+}
+
+func TestTempFilesDeletedOnCommit(t *testing.T) {
+	for _, queryPlanner := range []int{server.BASIC_PLANNER, server.HEURISTIC_PLANNER} {
+		assert := assert.New(t)
+		workspaceDir, err := os.MkdirTemp("", "test_temp_files_deleted_on_commit")
+		assert.Nil(err)
+		dbDir := path.Join(workspaceDir, "db")
+		defer os.RemoveAll(workspaceDir)
+
+		db := server.NewSimpleDB(dbDir, 400, 8, server.WithQueryPlanner(queryPlanner))
+		planner := db.Planner()
+		tx := db.NewTx()
+		for _, stmt := range []string{
+			"create table users(id int, name varchar(10))",
+			"create table orders(order_id int, user_id int)",
+		} {
+			_, err = planner.ExecuteQuery(stmt, tx)
+			assert.Nil(err)
+		}
+		for i := 0; i < 300; i++ {
+			_, err = planner.ExecuteQuery(fmt.Sprintf("insert into users(id, name) values (%d, 'user_%d')", (i*37)%300, i), tx)
+			assert.Nil(err)
+			_, err = planner.ExecuteQuery(fmt.Sprintf("insert into orders(order_id, user_id) values (%d, %d)", i, i), tx)
+			assert.Nil(err)
+		}
+		tx.Commit()
+
+		// The sorts and the join spill to temporary tables, whose
+		// blocks are left in the buffers when the transactions commit.
+		for _, queryStr := range []string{
+			"select id, name from users order by name",
+			"select id, order_id from users, orders where id = user_id",
+			"select id, order_id from users, orders where id = user_id order by order_id desc",
+		} {
+			tx = db.NewTx()
+			result, err := planner.ExecuteQuery(queryStr, tx)
+			assert.Nil(err)
+			count := 0
+			scan := result.(plan.Plan).Open()
+			for scan.Next() {
+				count++
+			}
+			scan.Close()
+			tx.Commit()
+			assert.Equal(300, count, queryStr)
+		}
+
+		// Reusing the buffers does not write them back to the deleted files.
+		tx = db.NewTx()
+		result, err := planner.ExecuteQuery("select order_id from orders", tx)
+		assert.Nil(err)
+		scan := result.(plan.Plan).Open()
+		for scan.Next() {
+		}
+		scan.Close()
+		tx.Commit()
+		tempFiles, err := filepath.Glob(path.Join(dbDir, "temp-*"))
+		assert.Nil(err)
+		assert.Empty(tempFiles)
+	}
+}
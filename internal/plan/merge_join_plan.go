@@ -0,0 +1,106 @@
+package plan
+
+import (
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The Plan class for the mergejoin operator,
+// which joins two queries on the equality of a field of each.
+// Both queries are sorted on their join field, unless their
+// records are already in that order, and then merged.
+// The output is ordered on the join fields.
+type MergeJoinPlan struct {
+	tx         *recovery.Transaction
+	left       Plan
+	right      Plan
+	leftField  string
+	rightField string
+	schema     *record.Schema
+}
+
+// Creates a mergejoin plan for the two specified queries,
+// joined on the equality of the left field and the right field.
+func NewMergeJoinPlan(tx *recovery.Transaction, left Plan, right Plan, leftField string, rightField string) *MergeJoinPlan {
+	schema := record.NewSchema()
+	schema.AddAll(left.Schema())
+	schema.AddAll(right.Schema())
+	return &MergeJoinPlan{tx, left, right, leftField, rightField, schema}
+}
+
+// The method first sorts its two underlying queries
+// on their join field, when needed. It then returns
+// a mergejoin scan of the two sorted scans.
+func (mjp *MergeJoinPlan) Open() query.Scan {
+	left := mjp.sorted(mjp.left, mjp.leftField).Open()
+	right := mjp.sorted(mjp.right, mjp.rightField).Open()
+	rightSchema := mjp.right.Schema()
+	return query.NewMergeJoinScan(left, right, mjp.leftField, mjp.rightField, rightSchema.Fields())
+}
+
+// Estimates the number of block accesses to compute the join,
+// including the cost of sorting the underlying queries.
+// The formula is:
+// B(mergejoin(p1,p2)) = B(sort(p1)) + B(sort(p2))
+func (mjp *MergeJoinPlan) BlockAccessed() int64 {
+	return mjp.sortCost(mjp.left, mjp.leftField) + mjp.sortCost(mjp.right, mjp.rightField)
+}
+
+// Estimates the number of output records in the join.
+// The formula is:
+// R(mergejoin(p1,p2)) = R(p1)*R(p2)/max(V(p1,F1),V(p2,F2))
+func (mjp *MergeJoinPlan) RecordsOutput() int64 {
+	distinct := max(mjp.left.DistinctValues(mjp.leftField), mjp.right.DistinctValues(mjp.rightField), 1)
+	return mjp.left.RecordsOutput() * mjp.right.RecordsOutput() / distinct
+}
+
+// Estimates the number of distinct values for the
+// specified field, which is the same as in the
+// appropriate underlying query.
+func (mjp *MergeJoinPlan) DistinctValues(fieldName string) int64 {
+	leftSchema := mjp.left.Schema()
+	if leftSchema.HasField(fieldName) {
+		return mjp.left.DistinctValues(fieldName)
+	}
+	return mjp.right.DistinctValues(fieldName)
+}
+
+// Return the schema of the join,
+// which is the union of the schemas of the underlying queries.
+func (mjp *MergeJoinPlan) Schema() record.Schema {
+	return *mjp.schema
+}
+
+// Returns the specified query sorted on the join field,
+// which is the query itself when it is already sorted.
+func (mjp *MergeJoinPlan) sorted(plan Plan, fieldName string) Plan {
+	orderBy := joinOrder(fieldName)
+	if isSortedOn(plan, orderBy) {
+		return plan
+	}
+	return NewSortPlan(mjp.tx, plan, orderBy)
+}
+
+// Estimates the number of block accesses to sort the specified
+// query on the join field and read it back.
+// The query is read once, and when it does not fit in the
+// available buffers, its sorted runs are also written and read:
+// B(sort(p)) = B(p) + 2*B(runs(p))
+func (mjp *MergeJoinPlan) sortCost(plan Plan, fieldName string) int64 {
+	if isSortedOn(plan, joinOrder(fieldName)) {
+		return plan.BlockAccessed()
+	}
+	schema := plan.Schema()
+	blocks := estimatedBlocks(mjp.tx, &schema, plan.RecordsOutput())
+	if blocks <= max(mjp.tx.AvailableBuffers()-1, 1) {
+		return plan.BlockAccessed()
+	}
+	return plan.BlockAccessed() + 2*blocks
+}
+
+// Returns the ascending order on the specified field.
+func joinOrder(fieldName string) []parser.OrderExpr {
+	return []parser.OrderExpr{{Expr: parser.Expr{Value: fieldName}}}
+}
@@ -0,0 +1,115 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeJoinPlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_merge_join_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	for _, stmt := range []string{
+		"create table depts(dept_id int, dname varchar(10))",
+		"create table emps(emp_id int, dept int)",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+	// Departments 0 to 9, in a scrambled order, with departments 2 and 5 twice.
+	for _, id := range []int{7, 2, 9, 0, 5, 3, 8, 1, 6, 4, 2, 5} {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into depts(dept_id, dname) values (%d, 'dept_%d')", id, id), tx)
+		assert.Nil(err)
+	}
+	// Employees of departments 2 to 11, 3 in each.
+	for i := 0; i < 30; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into emps(emp_id, dept) values (%d, %d)", i, 2+(i*7)%10), tx)
+		assert.Nil(err)
+	}
+	tx.Commit()
+
+	// The database is reopened for the statistics to reflect the records.
+	db = server.NewSimpleDB(dbDir, 400, 8)
+	planner = db.Planner()
+	tx = db.NewTx()
+
+	readDepts := func(p plan.Plan) []int64 {
+		depts := make([]int64, 0)
+		scan := p.Open()
+		for scan.Next() {
+			assert.Equal(scan.GetInt("dept_id"), scan.GetInt("dept"))
+			assert.Equal(fmt.Sprintf("dept_%d", scan.GetInt("dept")), scan.GetString("dname"))
+			depts = append(depts, scan.GetInt("dept"))
+		}
+		scan.Close()
+		return depts
+	}
+
+	// Departments 2 to 9 match 3 employees, and the duplicate
+	// departments 2 and 5 match the same employees twice.
+	expected := make([]int64, 0)
+	for dept := int64(2); dept <= 9; dept++ {
+		count := 3
+		if dept == 2 || dept == 5 {
+			count = 6
+		}
+		for i := 0; i < count; i++ {
+			expected = append(expected, dept)
+		}
+	}
+	deptsPlan := plan.NewTablePlan(tx, "depts", db.MetadataManager())
+	empsPlan := plan.NewTablePlan(tx, "emps", db.MetadataManager())
+	joinPlan := plan.NewMergeJoinPlan(tx, empsPlan, deptsPlan, "dept", "dept_id")
+	assert.Equal(expected, readDepts(joinPlan))
+	joinPlan = plan.NewMergeJoinPlan(tx, deptsPlan, empsPlan, "dept_id", "dept")
+	assert.Equal(expected, readDepts(joinPlan))
+
+	// An input already sorted on its join field is not sorted again,
+	// and the join costs less than the nested loops of a product.
+	selectStmt := parseSelectStmt(t, "select dept_id from depts order by dept_id")
+	sortedPlan := plan.NewSortPlan(tx, deptsPlan, selectStmt.OrderBy)
+	joinPlan = plan.NewMergeJoinPlan(tx, sortedPlan, empsPlan, "dept_id", "dept")
+	assert.Equal(expected, readDepts(joinPlan))
+	assert.Less(joinPlan.BlockAccessed(), plan.NewProductPlan(deptsPlan, empsPlan).BlockAccessed())
+	assert.Equal(sortedPlan.BlockAccessed()+empsPlan.BlockAccessed(), joinPlan.BlockAccessed())
+
+	// The planner merge joins the tables when the output
+	// is ordered on the join field.
+	result, err := planner.ExecuteQuery("select dept_id, dname, dept from depts, emps where dept_id = dept and emp_id < 30 order by dept", tx)
+	assert.Nil(err)
+	assert.Equal(expected, readDepts(result.(plan.Plan)))
+
+	// Both inputs are sorted in runs spilled to temporary tables,
+	// and the sort of the first one leaves enough buffers to sort
+	// the second one while it is open.
+	for i := 0; i < 300; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into depts(dept_id, dname) values (%d, 'dept_%d')", 100+(i*37)%300, i), tx)
+		assert.Nil(err)
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into emps(emp_id, dept) values (%d, %d)", 100+i, 100+(i*53)%300), tx)
+		assert.Nil(err)
+	}
+	result, err = planner.ExecuteQuery("select dept_id, dname, dept from depts join emps on dept_id = dept order by dept_id", tx)
+	assert.Nil(err)
+	count := 0
+	scan := result.(plan.Plan).Open()
+	for scan.Next() {
+		assert.Equal(scan.GetInt("dept_id"), scan.GetInt("dept"))
+		count++
+	}
+	scan.Close()
+	assert.Equal(len(expected)+300, count)
+	tx.Commit()
+}
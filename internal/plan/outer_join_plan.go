@@ -0,0 +1,146 @@
+package plan
+
+import (
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The Plan class for the left, right and full outer joins.
+// The joined records are those of the product of the two
+// queries that satisfy the join predicate, plus the records
+// of the preserved queries that match no record of the
+// other query, with nulls in place of its fields.
+type OuterJoinPlan struct {
+	kind      string
+	left      Plan
+	right     Plan
+	predicate *query.Predicate
+	schema    *record.Schema
+}
+
+// Creates an outer join of the specified kind,
+// which is "left", "right" or "full", of the two queries
+// on the specified join condition.
+func NewOuterJoinPlan(kind string, left Plan, right Plan, condition parser.Condition) *OuterJoinPlan {
+	if kind != "left" && kind != "right" && kind != "full" {
+		panic(fmt.Errorf("%w: outer join `%v`", ErrNotSupported, kind))
+	}
+	schema := record.NewSchema()
+	schema.AddAll(left.Schema())
+	schema.AddAll(right.Schema())
+	predicate := query.NewPredicate(condition)
+	for _, fieldName := range condition.FieldNames() {
+		if !schema.HasField(fieldName) {
+			panic(query.NewColumnNotFoundError(fieldName))
+		}
+	}
+	return &OuterJoinPlan{kind, left, right, predicate, schema}
+}
+
+// Creates an outer join scan for this query.
+func (ojp *OuterJoinPlan) Open() query.Scan {
+	leftScan := ojp.left.Open()
+	rightScan := ojp.right.Open()
+	leftSchema := ojp.left.Schema()
+	keepLeft := ojp.kind == "left" || ojp.kind == "full"
+	keepRight := ojp.kind == "right" || ojp.kind == "full"
+	return query.NewOuterJoinScan(leftScan, rightScan, ojp.predicate, leftSchema.Fields(), keepLeft, keepRight)
+}
+
+// Estimates the number of block accesses in the join,
+// which reads the RHS query for each LHS record.
+// The formula is:
+// B(outerjoin(p1,p2)) = B(p1) + R(p1)*B(p2)
+func (ojp *OuterJoinPlan) BlockAccessed() int64 {
+	return ojp.left.BlockAccessed() + (ojp.left.RecordsOutput() * ojp.right.BlockAccessed())
+}
+
+// Estimates the number of output records in the join,
+// which are the matching records of the product, but
+// at least the records of the preserved queries.
+func (ojp *OuterJoinPlan) RecordsOutput() int64 {
+	product := NewProductPlan(ojp.left, ojp.right)
+	records := product.RecordsOutput() / ReductionFactor(ojp.predicate, product)
+	switch ojp.kind {
+	case "left":
+		return max(records, ojp.left.RecordsOutput())
+	case "right":
+		return max(records, ojp.right.RecordsOutput())
+	}
+	return max(records, ojp.left.RecordsOutput()+ojp.right.RecordsOutput())
+}
+
+// Estimates the distinct number of field values in the join,
+// which is the same as in the appropriate underlying query.
+func (ojp *OuterJoinPlan) DistinctValues(fieldName string) int64 {
+	leftSchema := ojp.left.Schema()
+	if leftSchema.HasField(fieldName) {
+		return ojp.left.DistinctValues(fieldName)
+	}
+	return ojp.right.DistinctValues(fieldName)
+}
+
+// Returns the schema of the join,
+// which is the union of the schemas of the underlying queries.
+func (ojp *OuterJoinPlan) Schema() record.Schema {
+	return *ojp.schema
+}
+
+// Creates the plan of a from clause item that has outer joins,
+// by joining its tables in order, each of them planned by the
+// specified function.
+// Inner joins are products selected on their join condition,
+// since the conditions cannot be moved across an outer join.
+func createFromItemPlan(fromItem parser.FromItem, createTablePlan func(tableRef parser.TableRef) Plan) Plan {
+	plan := createTablePlan(fromItem.Table)
+	for _, join := range fromItem.Joins {
+		right := createTablePlan(join.Table)
+		switch join.Kind {
+		case "cross":
+			plan = NewProductPlan(plan, right)
+		case "inner":
+			plan = NewSelectPlan(NewProductPlan(plan, right), query.NewPredicate(join.Condition))
+		default:
+			plan = NewOuterJoinPlan(join.Kind, plan, right, join.Condition)
+		}
+	}
+	return plan
+}
+
+// Splits the from clause of the query into the tables that can
+// be joined in any order, and the items that have outer joins,
+// which must be planned as a whole.
+// The tables include those of the items with inner joins only,
+// whose join conditions are added to the returned condition
+// along with the where condition.
+func splitFromClause(selectStmt parser.SelectStmt) ([]parser.TableRef, []parser.FromItem, parser.Condition) {
+	tables := make([]parser.TableRef, 0)
+	outerItems := make([]parser.FromItem, 0)
+	conditions := make([]parser.Condition, 0)
+	if !selectStmt.Condition.IsEmpty() {
+		conditions = append(conditions, selectStmt.Condition)
+	}
+	for _, fromItem := range selectStmt.From {
+		if fromItem.HasOuterJoin() {
+			outerItems = append(outerItems, fromItem)
+			continue
+		}
+		tables = append(tables, fromItem.Table)
+		for _, join := range fromItem.Joins {
+			tables = append(tables, join.Table)
+			if !join.Condition.IsEmpty() {
+				conditions = append(conditions, join.Condition)
+			}
+		}
+	}
+	if len(conditions) == 1 {
+		return tables, outerItems, conditions[0]
+	}
+	if len(conditions) == 0 {
+		return tables, outerItems, parser.Condition{}
+	}
+	return tables, outerItems, parser.Condition{Op: "and", Children: conditions}
+}
@@ -0,0 +1,99 @@
+package plan_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOuterJoinPlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_outer_join_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	for _, stmt := range []string{
+		"create table depts(dept_id int, dname varchar(10))",
+		"create table emps(emp_id int, name varchar(10), dept int)",
+		"insert into depts(dept_id, dname) values (1, 'eng')",
+		"insert into depts(dept_id, dname) values (2, 'ops')",
+		"insert into depts(dept_id, dname) values (3, 'hr')",
+		"insert into emps(emp_id, name, dept) values (1, 'ann', 1)",
+		"insert into emps(emp_id, name, dept) values (2, 'bob', 1)",
+		"insert into emps(emp_id, name, dept) values (3, 'cid', 2)",
+		"insert into emps(emp_id, name, dept) values (4, 'dan', 9)",
+		"create table sites(site_id int, city varchar(10))",
+		"insert into sites(site_id, city) values (1, 'paris')",
+		"insert into sites(site_id, city) values (2, 'lyon')",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+
+	readRows := func(queryStr string) []string {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err)
+		p := result.(plan.Plan)
+		schema := p.Schema()
+		rows := make([]string, 0)
+		scan := p.Open()
+		for scan.Next() {
+			row := ""
+			for i, fieldName := range schema.Fields() {
+				if i > 0 {
+					row += ":"
+				}
+				value := scan.GetValue(fieldName)
+				row += value.String()
+			}
+			rows = append(rows, row)
+		}
+		scan.Close()
+		return rows
+	}
+
+	// An inner join is the same as a product selected on the join condition.
+	assert.ElementsMatch([]string{"ann:eng", "bob:eng", "cid:ops"},
+		readRows("select name, dname from emps join depts on dept = dept_id"))
+	assert.ElementsMatch([]string{"ann:eng", "bob:eng"},
+		readRows("select name, dname from emps inner join depts on dept = dept_id where dname = 'eng'"))
+	assert.Len(readRows("select name, dname from emps cross join depts"), 12)
+
+	// The unmatched records of the preserved side are padded with nulls.
+	assert.ElementsMatch([]string{"ann:eng", "bob:eng", "cid:ops", "dan:NULL"},
+		readRows("select name, dname from emps left join depts on dept = dept_id"))
+	assert.ElementsMatch([]string{"ann:eng", "bob:eng", "cid:ops", "NULL:hr"},
+		readRows("select name, dname from emps right outer join depts on dept = dept_id"))
+	assert.ElementsMatch([]string{"ann:eng", "bob:eng", "cid:ops", "dan:NULL", "NULL:hr"},
+		readRows("select name, dname from emps full join depts on dept = dept_id"))
+
+	// The where condition applies to the joined records, and
+	// comparisons with null are not satisfied.
+	assert.ElementsMatch([]string{"cid:ops"},
+		readRows("select name, dname from emps left join depts on dept = dept_id where dept_id > 1"))
+	assert.ElementsMatch([]string{"ann", "bob", "dan"},
+		readRows("select name from emps left join depts on dept = dept_id and dname = 'eng' where emp_id != 3"))
+
+	// Null values are not counted.
+	assert.ElementsMatch([]string{"eng:2", "ops:1", "hr:0"},
+		readRows("select dname, count(emp_id) from depts left join emps on dept_id = dept group by dname"))
+	assert.Equal([]string{"4:3"}, readRows("select count(*), count(dept_id) from emps left join depts on dept = dept_id"))
+
+	// Outer joins can be mixed with inner joins and other tables.
+	assert.ElementsMatch([]string{"ann:eng:paris", "bob:eng:paris", "cid:ops:paris", "dan:NULL:paris"},
+		readRows("select name, dname, city from emps left join depts on dept = dept_id, sites where site_id = 1"))
+	assert.ElementsMatch([]string{"ann:eng:paris", "bob:eng:paris", "cid:ops:paris", "NULL:hr:NULL"},
+		readRows("select name, dname, city from sites join emps on site_id = 1 right join depts on dept = dept_id where city = 'paris' or dname = 'hr'"))
+	_, err = planner.ExecuteQuery("select name from emps left join depts on dept = salary", tx)
+	assert.NotNil(err)
+	tx.Commit()
+}
@@ -1,43 +1,145 @@
 package plan
 
 import (
+	"errors"
 	"fmt"
+	"log"
+	"runtime"
+	"runtime/debug"
 
+	"github.com/evanxg852000/simpledb/internal/metadata"
 	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
 	"github.com/evanxg852000/simpledb/internal/tx/recovery"
 )
 
+// The error of a table format, an index type, an outer join
+// or an aggregation function that the engine does not have.
+var ErrNotSupported = errors.New("not supported")
+
+// The error of a statement that failed on a bug of the engine,
+// such as a runtime error, rather than on the statement itself.
+var ErrInternal = errors.New("internal error")
+
 type Planner struct {
 	queryPlanner  QueryPlanner
 	updatePlanner UpdatePlanner
+	mdtManager    *metadata.MetadataManager
 }
 
 // The object that executes SQL statements.
-func NewPlanner(queryPlanner QueryPlanner, updatePlanner UpdatePlanner) *Planner {
-	return &Planner{queryPlanner, updatePlanner}
+// Statements are verified against the catalog of the
+// specified metadata manager before they are planned.
+func NewPlanner(queryPlanner QueryPlanner, updatePlanner UpdatePlanner, mdtManager *metadata.MetadataManager) *Planner {
+	return &Planner{queryPlanner, updatePlanner, mdtManager}
 }
 
 // Parses an SQL statement,
-// - returns a plan for data selection queries
+// - returns a ResultPlan for data selection queries
 // - execute and returns affected rows for modification queries
-func (planner *Planner) ExecuteQuery(queryStr string, tx *recovery.Transaction) (any, error) {
+// Statements that fail while executing are reported as errors,
+// which wrap the error of their kind when there is one, such as
+// metadata.ErrTableNotFound or query.ErrTypeMismatch.
+// A query that fails while its records are read reports the
+// error through the Err method of its ResultScan.
+func (planner *Planner) ExecuteQuery(queryStr string, tx *recovery.Transaction) (result any, err error) {
 	defer func() {
-		err := recover()
+		if r := recover(); r != nil {
+			result = nil
+			err = panicError(r)
+		}
+	}()
+
+	stmts, err := parser.ParseQuery(queryStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("expected one SQL statement, found %d", len(stmts))
+	}
+	return planner.executeStatement(stmts[0], tx)
+}
+
+// The result of a statement of a script: the records
+// selected by a query, read when the query is executed,
+// or the number of records affected by an update.
+type StatementResult struct {
+	IsQuery      bool
+	Fields       []string
+	Rows         [][]query.Constant
+	AffectedRows int64
+}
+
+// The error of a statement of a script, which is
+// found at the specified index in the script.
+type ScriptError struct {
+	Index int
+	Err   error
+}
+
+func (se *ScriptError) Error() string {
+	return fmt.Sprintf("statement %d: %v", se.Index+1, se.Err)
+}
+
+func (se *ScriptError) Unwrap() error {
+	return se.Err
+}
+
+// Parses a script of SQL statements separated by semicolons,
+// and executes them in order, returning the result of each one.
+// The execution stops at the first statement that fails, which
+// is reported as a ScriptError, along with the results of the
+// statements executed before it.
+// A script that does not parse is not executed at all, and its
+// syntax errors are reported as parser.SyntaxErrors.
+func (planner *Planner) ExecuteScript(script string, tx *recovery.Transaction) (results []StatementResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &ScriptError{len(results), panicError(r)}
+		}
+	}()
+
+	stmts, err := parser.ParseQuery(script)
+	if err != nil {
+		return nil, err
+	}
+	results = make([]StatementResult, 0, len(stmts))
+	for i, sqlStmt := range stmts {
+		result, err := planner.executeStatement(sqlStmt, tx)
 		if err != nil {
-			fmt.Println("ERR:", err)
+			return results, &ScriptError{i, err}
+		}
+		if affectedRows, ok := result.(int64); ok {
+			results = append(results, StatementResult{AffectedRows: affectedRows})
+			continue
+		}
+		// The records are read before the next statement
+		// is executed, since it may modify them.
+		queryResult, err := readResult(result.(*ResultPlan))
+		if err != nil {
+			return results, &ScriptError{i, err}
+		}
+		results = append(results, queryResult)
+	}
+	return results, nil
+}
+
+func (planner *Planner) executeStatement(sqlStmt parser.Statement, tx *recovery.Transaction) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = nil
+			err = panicError(r)
 		}
 	}()
 
-	ast := parser.ParseQuery(queryStr)
-	sqlStmt := ast.([]any)[0]
-	err := planner.VerifyStatement(sqlStmt)
+	err = planner.VerifyStatement(sqlStmt, tx)
 	if err != nil {
 		return nil, err
 	}
 
 	switch stmt := sqlStmt.(type) {
 	case parser.SelectStmt:
-		return planner.queryPlanner.CreatePlan(stmt, tx), nil
+		return NewResultPlan(planner.queryPlanner.CreatePlan(stmt, tx)), nil
 	case parser.InsertStmt:
 		return planner.updatePlanner.ExecuteInsert(stmt, tx), nil
 	case parser.UpdateStmt:
@@ -54,7 +156,41 @@ func (planner *Planner) ExecuteQuery(queryStr string, tx *recovery.Transaction)
 	return nil, fmt.Errorf("unknown SQL statement")
 }
 
-func (planner *Planner) VerifyStatement(sqlStmt any) error {
-	//TODO: verify statement and return error if any
-	return nil
+// Read the records of the plan of a query.
+func readResult(p *ResultPlan) (StatementResult, error) {
+	schema := p.Schema()
+	fields := schema.Fields()
+	rows := make([][]query.Constant, 0)
+	scan := p.Open().(*ResultScan)
+	for scan.Next() {
+		row := make([]query.Constant, 0, len(fields))
+		for _, fldName := range fields {
+			if scan.IsNull(fldName) {
+				row = append(row, query.NewNullConstant())
+				continue
+			}
+			row = append(row, scan.GetValue(fldName))
+		}
+		rows = append(rows, row)
+	}
+	scan.Close()
+	if err := scan.Err(); err != nil {
+		return StatementResult{}, err
+	}
+	return StatementResult{IsQuery: true, Fields: fields, Rows: rows}, nil
+}
+
+// Returns the error a statement panicked with.
+// A runtime error, or a panic that is not an error, is a bug of
+// the engine rather than a failure of the statement: it is logged
+// along with its stack, and reported as an ErrInternal, so that
+// the caller can carry on.
+func panicError(r any) error {
+	if e, ok := r.(error); ok {
+		if _, ok := r.(runtime.Error); !ok {
+			return e
+		}
+	}
+	log.Printf("internal error: %v\n%s", r, debug.Stack())
+	return fmt.Errorf("%w: %v", ErrInternal, r)
 }
@@ -0,0 +1,315 @@
+package plan_test
+
+import (
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteScript(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_execute_script")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	// The rows of a query are those selected when it runs,
+	// before the statements that follow it.
+	results, err := planner.ExecuteScript(`
+		create table users(id int, name varchar(10));
+		insert into users(id, name) values (1, 'evan');
+		insert into users(id, name) values (2, 'jane');
+		select id, name from users;
+		update users set name = null where id = 2;
+		select name from users where id = 2;
+	`, tx)
+	assert.Nil(err)
+	assert.Equal([]plan.StatementResult{
+		{AffectedRows: 0},
+		{AffectedRows: 1},
+		{AffectedRows: 1},
+		{IsQuery: true, Fields: []string{"id", "name"}, Rows: [][]query.Constant{
+			{query.NewConstant(int64(1)), query.NewConstant("evan")},
+			{query.NewConstant(int64(2)), query.NewConstant("jane")},
+		}},
+		{AffectedRows: 1},
+		{IsQuery: true, Fields: []string{"name"}, Rows: [][]query.Constant{
+			{query.NewNullConstant()},
+		}},
+	}, results)
+	tx.Commit()
+
+	// The script stops at the first statement that fails.
+	tx = db.NewTx()
+	results, err = planner.ExecuteScript(`
+		insert into users(id, name) values (3, 'john');
+		select id from users where missing = 1;
+		insert into users(id, name) values (4, 'mary')
+	`, tx)
+	var scriptErr *plan.ScriptError
+	assert.True(errors.As(err, &scriptErr))
+	assert.Equal(1, scriptErr.Index)
+	assert.Len(results, 1)
+	tx.Rollback()
+
+	// A query that only fails once its records are read
+	// is reported as the failing statement as well.
+	tx = db.NewTx()
+	results, err = planner.ExecuteScript("select id from users; select id from users where name > 1", tx)
+	assert.True(errors.As(err, &scriptErr))
+	assert.Equal(1, scriptErr.Index)
+	assert.Len(results, 1)
+	assert.Len(results[0].Rows, 2)
+	tx.Rollback()
+
+	tx = db.NewTx()
+	results, err = planner.ExecuteScript("", tx)
+	assert.Nil(err)
+	assert.Empty(results)
+	_, err = planner.ExecuteQuery("select id from users; select name from users", tx)
+	assert.EqualError(err, "expected one SQL statement, found 2")
+
+	// A script that does not parse is not executed at all.
+	results, err = planner.ExecuteScript("delete from users; select id frm users", tx)
+	var syntaxErrors parser.SyntaxErrors
+	assert.True(errors.As(err, &syntaxErrors))
+	assert.EqualError(err, "syntax error at line 1, column 34: unexpected `users`, expected 'from'")
+	assert.Empty(results)
+	results, err = planner.ExecuteScript("select id from users", tx)
+	assert.Nil(err)
+	assert.Len(results[0].Rows, 2)
+	tx.Commit()
+}
+
+func TestExecuteErrors(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_execute_errors")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+	_, err = planner.ExecuteQuery("create table users(id int, name varchar(10))", tx)
+	assert.Nil(err)
+
+	// The errors of the statements can be told apart by their
+	// kind, whether they are found when planning or executing.
+	for queryStr, kind := range map[string]error{
+		"select id from customers":                          metadata.ErrTableNotFound,
+		"insert into customers(id) values (1)":              metadata.ErrTableNotFound,
+		"select age from users":                             query.ErrColumnNotFound,
+		"insert into users(id, age) values (1, 2)":          query.ErrColumnNotFound,
+		"update users set age = 1":                          query.ErrColumnNotFound,
+		"insert into users(id, name) values ('one', 'one')": query.ErrTypeMismatch,
+		"select id + name from users":                       query.ErrTypeMismatch,
+		"select id / (id - 1) from users":                   query.ErrDivisionByZero,
+		"select id from users where count(id) > 1":          plan.ErrInvalidGrouping,
+		"create table notes(id int) format compact":         plan.ErrNotSupported,
+	} {
+		results, err := planner.ExecuteScript("insert into users(id, name) values (1, 'evan'); "+queryStr, tx)
+		assert.ErrorIs(err, kind, queryStr)
+		var scriptErr *plan.ScriptError
+		assert.True(errors.As(err, &scriptErr))
+		assert.Equal(1, scriptErr.Index)
+		assert.Len(results, 1)
+	}
+	tx.Rollback()
+
+	// The scan of a query that fails while its records are read
+	// stops on the error, instead of panicking in the caller.
+	tx = db.NewTx()
+	_, err = planner.ExecuteScript("create table users(id int, name varchar(10)); insert into users(id, name) values (1, 'evan')", tx)
+	assert.Nil(err)
+	result, err := planner.ExecuteQuery("select id from users where id * 9223372036854775807 * 2 > 1", tx)
+	assert.Nil(err)
+	scan := result.(plan.Plan).Open()
+	assert.False(scan.Next())
+	scan.Close()
+	assert.ErrorIs(scan.(*plan.ResultScan).Err(), query.ErrOverflow)
+	tx.Rollback()
+
+	// A runtime error is a bug of the engine, which is
+	// reported as an internal error rather than panicking.
+	planner = plan.NewPlanner(brokenQueryPlanner{}, plan.NewBasicUpdatePlanner(db.MetadataManager()), db.MetadataManager())
+	tx = db.NewTx()
+	_, err = planner.ExecuteQuery("create table users(id int, name varchar(10))", tx)
+	assert.Nil(err)
+	_, err = planner.ExecuteQuery("select id from users", tx)
+	assert.ErrorIs(err, plan.ErrInternal)
+	_, err = planner.ExecuteScript("select id from users", tx)
+	assert.ErrorIs(err, plan.ErrInternal)
+	assert.ErrorContains(err, "index out of range")
+	tx.Rollback()
+}
+
+// A query planner that fails with a runtime error.
+type brokenQueryPlanner struct{}
+
+func (brokenQueryPlanner) CreatePlan(selectStmt parser.SelectStmt, tx *recovery.Transaction) plan.Plan {
+	plans := make([]plan.Plan, 0)
+	return plans[len(selectStmt.Tables)]
+}
+
+func TestVerifyStatement(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_verify_statement")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+	for _, stmt := range []string{
+		"create table users(id int, name varchar(10), joined date)",
+		"create view named_users as select id, name from users where name is not null",
+		"create index users_id on users(id)",
+		"insert into users(id, name, joined) values (1, 'evan', '2024-01-31')",
+		"update users set name = null, joined = date '2024-02-01' where id = 1",
+		"select u.name, n.id from users u join named_users n on u.id = n.id where u.joined > date '2024-01-01'",
+		"delete from users where joined = '2024-01-01'",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err, stmt)
+	}
+
+	// Each error names the identifier the statement fails on.
+	for queryStr, expected := range map[string]struct {
+		kind       error
+		identifier string
+	}{
+		"insert into customers(id) values (1)":                  {metadata.ErrTableNotFound, "customers"},
+		"delete from customers":                                 {metadata.ErrTableNotFound, "customers"},
+		"select id from named_users, customers":                 {metadata.ErrTableNotFound, "customers"},
+		"insert into users(id, age) values (1, 2)":              {query.ErrColumnNotFound, "age"},
+		"update users set name = age":                           {query.ErrColumnNotFound, "age"},
+		"delete from users where age = 1":                       {query.ErrColumnNotFound, "age"},
+		"select id from named_users where joined is null":       {query.ErrColumnNotFound, "joined"},
+		"create index users_age on users(age)":                  {query.ErrColumnNotFound, "age"},
+		"insert into users(id, name) values ('one', 'one')":     {query.ErrTypeMismatch, "id"},
+		"insert into users(id, joined) values (2, 'someday')":   {query.ErrTypeMismatch, "joined"},
+		"update users set id = true":                            {query.ErrTypeMismatch, "id"},
+		"delete from users where name = 1":                      {query.ErrTypeMismatch, "name"},
+		"select id from users u where 'one' < u.id":             {query.ErrTypeMismatch, "u.id"},
+		"select id from users where joined = 'someday'":         {query.ErrTypeMismatch, "users.joined"},
+		"insert into users(id, name) values (2, 'evan-xavier')": {plan.ErrValueTooLong, "name"},
+		"update users set name = 'evan-xavier'":                 {plan.ErrValueTooLong, "name"},
+		"insert into users(id, id) values (2, 3)":               {plan.ErrDuplicateName, "id"},
+		"update users set id = 2, id = 3":                       {plan.ErrDuplicateName, "id"},
+		"select id as a, name as a from users":                  {plan.ErrDuplicateName, "a"},
+		"select id from users, named_users users":               {plan.ErrDuplicateName, "users"},
+		"create table users(id int)":                            {plan.ErrDuplicateName, "users"},
+		"create table named_users(id int)":                      {plan.ErrDuplicateName, "named_users"},
+		"create table accounts(id int, id int)":                 {plan.ErrDuplicateName, "id"},
+		"create view users as select id from named_users":       {plan.ErrDuplicateName, "users"},
+		"create index users_id on users(name)":                  {plan.ErrDuplicateName, "users_id"},
+		"create index users_key on users(id)":                   {plan.ErrDuplicateName, "users_id"},
+	} {
+		_, err := planner.ExecuteQuery(queryStr, tx)
+		assert.ErrorIs(err, expected.kind, queryStr)
+		assert.ErrorContains(err, "`"+expected.identifier+"`", queryStr)
+	}
+
+	for queryStr, message := range map[string]string{
+		"insert into users(id, name) values (2)":   "insert into `users` names 2 columns but has 1 values",
+		"insert into users values (2)":             "insert into `users` has 1 values but the table has 3 columns",
+		"insert into named_users(id) values (2)":   "`named_users` is a view, not a table",
+		"create index named_id on named_users(id)": "`named_users` is a view, not a table",
+	} {
+		_, err := planner.ExecuteQuery(queryStr, tx)
+		assert.EqualError(err, message, queryStr)
+	}
+
+	// The statements that fail are not executed.
+	result, err := planner.ExecuteQuery("select id, name from users", tx)
+	assert.Nil(err)
+	rows := 0
+	scan := result.(plan.Plan).Open()
+	for scan.Next() {
+		assert.Equal(int64(1), scan.GetInt("id"))
+		assert.True(scan.IsNull("name"))
+		rows++
+	}
+	scan.Close()
+	assert.Equal(1, rows)
+	tx.Commit()
+}
+
+func TestExecuteQuotedNames(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_execute_quoted_names")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	// Unquoted names are the same whatever their case, while
+	// quoted names keep theirs, and can be keywords.
+	results, err := planner.ExecuteScript(`
+		CREATE TABLE Orders(Id INT, "Order" VARCHAR(10), "order" VARCHAR(10));
+		INSERT INTO orders(ID, "Order", "order") VALUES (1, 'it''s', 'its'); -- one order
+		/* read it back */
+		SELECT "Order", "order" FROM ORDERS WHERE id = 1;
+	`, tx)
+	assert.Nil(err)
+	assert.Len(results, 3)
+	assert.Equal(plan.StatementResult{IsQuery: true, Fields: []string{"Order", "order"}, Rows: [][]query.Constant{
+		{query.NewConstant("it's"), query.NewConstant("its")},
+	}}, results[2])
+
+	_, err = planner.ExecuteQuery("select Order from orders", tx)
+	var syntaxErrors parser.SyntaxErrors
+	assert.True(errors.As(err, &syntaxErrors))
+	_, err = planner.ExecuteQuery(`select "ORDER" from orders`, tx)
+	assert.ErrorIs(err, query.ErrColumnNotFound)
+	tx.Commit()
+}
+
+func TestExecuteInsertWithoutColumns(t *testing.T) {
+	assert := assert.New(t)
+	for _, queryPlanner := range []int{server.BASIC_PLANNER, server.HEURISTIC_PLANNER} {
+		workspaceDir, err := os.MkdirTemp("", "test_execute_insert_without_columns")
+		assert.Nil(err)
+		dbDir := path.Join(workspaceDir, "db")
+		defer os.RemoveAll(workspaceDir)
+
+		db := server.NewSimpleDB(dbDir, 400, 8, server.WithQueryPlanner(queryPlanner))
+		planner := db.Planner()
+		tx := db.NewTx()
+
+		// An insert without a column list sets
+		// all the columns of the table, in order.
+		results, err := planner.ExecuteScript(`
+			create table users(id int, name varchar(10));
+			create index users_name on users(name);
+			insert into users values (1, 'evan');
+			select id, name from users where name = 'evan';
+		`, tx)
+		assert.Nil(err)
+		assert.Equal([][]query.Constant{
+			{query.NewConstant(int64(1)), query.NewConstant("evan")},
+		}, results[3].Rows)
+
+		_, err = planner.ExecuteQuery("insert into users values ('jane', 2)", tx)
+		assert.ErrorIs(err, query.ErrTypeMismatch)
+		tx.Commit()
+	}
+}
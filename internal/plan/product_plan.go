@@ -31,7 +31,7 @@ func (pp *ProductPlan) Open() query.Scan {
 // The formula is:
 // B(product(p1,p2)) = B(p1) + R(p1)*B(p2)
 func (pp *ProductPlan) BlockAccessed() int64 {
-	return pp.left.BlockAccessed() + (pp.left.BlockAccessed() * pp.right.BlockAccessed())
+	return pp.left.BlockAccessed() + (pp.left.RecordsOutput() * pp.right.BlockAccessed())
 }
 
 // Estimates the number of output records in the product.
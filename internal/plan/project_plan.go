@@ -1,33 +1,56 @@
 package plan
 
 import (
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
 	"github.com/evanxg852000/simpledb/internal/query"
 	"github.com/evanxg852000/simpledb/internal/record"
 )
 
 type ProjectPlan struct {
-	plan   Plan
-	schema *record.Schema
+	plan     Plan
+	schema   *record.Schema
+	computed map[string]*query.Expression
 }
 
 // Creates a new project node in the query tree,
 // having the specified subquery and field list.
-func NewProjectPlan(plan Plan, fields []string) *ProjectPlan {
+// The expressions, when given, compute each of the fields;
+// a field whose expression is not a reference to the
+// same field of the subquery is a computed field,
+// whose type is derived from its expression.
+func NewProjectPlan(plan Plan, fields []string, exprs []parser.Expr) *ProjectPlan {
 	schema := record.NewSchema()
+	computed := make(map[string]*query.Expression)
+	subSchema := plan.Schema()
 	if fields[0] == "*" {
-		schema.AddAll(plan.Schema())
+		schema.AddAll(subSchema)
 		fields = fields[1:]
 	}
-	for _, field := range fields {
-		schema.Add(field, plan.Schema())
+	for i, field := range fields {
+		if exprs == nil || (exprs[i].IsFieldName() && exprs[i].AsFieldExpr() == field) {
+			if !subSchema.HasField(field) {
+				panic(query.NewColumnNotFoundError(field))
+			}
+			schema.Add(field, subSchema)
+			continue
+		}
+		expr := query.NewExpression(exprs[i])
+		if !subSchema.AppliesTo(expr) {
+			panic(fmt.Errorf("%w: expression `%v` refers to unknown fields", query.ErrColumnNotFound, field))
+		}
+		fldType, fldLength := subSchema.ExpressionType(expr)
+		schema.AddField(field, fldType, fldLength)
+		computed[field] = expr
 	}
-	return &ProjectPlan{plan, schema}
+	return &ProjectPlan{plan, schema, computed}
 }
 
 // Creates a project scan for this query.
 func (pp *ProjectPlan) Open() query.Scan {
 	scan := pp.plan.Open()
-	return query.NewProjectScan(scan, pp.schema.Fields())
+	return query.NewProjectScan(scan, pp.schema.Fields(), pp.computed)
 }
 
 // Estimates the number of block accesses in the projection,
@@ -45,7 +68,15 @@ func (pp *ProjectPlan) RecordsOutput() int64 {
 // Estimates the number of distinct field values
 // in the projection,
 // which is the same as in the underlying query.
+// A computed field is assumed to take a distinct
+// value for each output record.
 func (pp *ProjectPlan) DistinctValues(fieldName string) int64 {
+	if expr, ok := pp.computed[fieldName]; ok {
+		if expr.IsFieldName() {
+			return pp.plan.DistinctValues(expr.AsFieldName())
+		}
+		return max(pp.plan.RecordsOutput(), 1)
+	}
 	return pp.plan.DistinctValues(fieldName)
 }
 
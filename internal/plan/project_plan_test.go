@@ -0,0 +1,87 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectPlanComputedFields(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_project_plan_computed_fields")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	_, err = planner.ExecuteQuery("create table orders(id int, item varchar(8), price int, qty int)", tx)
+	assert.Nil(err)
+	for i := 1; i <= 5; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into orders(id, item, price, qty) values (%d, 'item_%d', %d, %d)", i, i, i*10, i), tx)
+		assert.Nil(err)
+	}
+
+	result, err := planner.ExecuteQuery("update orders set qty = qty + 1, price = -price / 2 where id >= 4", tx)
+	assert.Nil(err)
+	assert.Equal(int64(2), result)
+
+	result, err = planner.ExecuteQuery("select id, price * qty, item || '#' || id, (id + 1) % 3 from orders where price * qty > 20", tx)
+	assert.Nil(err)
+	p := result.(plan.Plan)
+
+	schema := p.Schema()
+	assert.Equal([]string{"id", "price * qty", "item || '#' || id", "(id + 1) % 3"}, schema.Fields())
+	assert.Equal(int64(record.INTEGER_TYPE), schema.FieldType("price * qty"))
+	assert.Equal(int64(record.STRING_TYPE), schema.FieldType("item || '#' || id"))
+	assert.Equal(int64(8+1+record.MAX_INTEGER_DIGITS), schema.FieldLength("item || '#' || id"))
+	assert.Equal(int64(record.INTEGER_TYPE), schema.FieldType("(id + 1) % 3"))
+
+	rows := make([]string, 0)
+	scan := p.Open()
+	for scan.Next() {
+		rows = append(rows, fmt.Sprintf("%d:%d:%s:%d",
+			scan.GetInt("id"),
+			scan.GetInt("price * qty"),
+			scan.GetString("item || '#' || id"),
+			scan.GetInt("(id + 1) % 3"),
+		))
+	}
+	scan.Close()
+	assert.Equal([]string{"2:40:item_2#2:0", "3:90:item_3#3:1"}, rows)
+
+	// Arithmetic on strings is rejected.
+	result, err = planner.ExecuteQuery("select item + 1 from orders", tx)
+	assert.Nil(err)
+	scan = result.(plan.Plan).Open()
+	for scan.Next() {
+		scan.GetValue("item + 1")
+	}
+	scan.Close()
+	assert.ErrorIs(scan.(*plan.ResultScan).Err(), query.ErrTypeMismatch)
+
+	// Integer results must fit in 64 bits.
+	for _, stmt := range []string{
+		"update orders set price = price + 9223372036854775807 where id = 1",
+		"update orders set price = -price - 9223372036854775807 where id = 1",
+		"update orders set price = price * 1000000000000000000 where id = 1",
+		"update orders set price = -(-9223372036854775807 - 1) where id = 1",
+		"update orders set price = (-9223372036854775807 - 1) / -1 where id = 1",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.ErrorIs(err, query.ErrOverflow, stmt)
+	}
+	result, err = planner.ExecuteQuery("update orders set price = price - 9223372036854775807 - 1 + 10 where id = 1", tx)
+	assert.Nil(err)
+	assert.Equal(int64(1), result)
+	tx.Commit()
+}
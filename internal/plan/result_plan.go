@@ -0,0 +1,146 @@
+package plan
+
+import (
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The plan of a query returned by the planner.
+// The scans of the plan operators panic when they fail,
+// such as on an overflow found while reading a record,
+// so the scan of the query reports those failures as an
+// error instead, which it stops on.
+type ResultPlan struct {
+	plan Plan
+}
+
+// Create a result plan for the specified query.
+func NewResultPlan(plan Plan) *ResultPlan {
+	return &ResultPlan{plan}
+}
+
+// Opens a ResultScan for the query.
+// If the query fails to open, the scan has no
+// records and its Err method returns the error.
+func (rp *ResultPlan) Open() query.Scan {
+	rs := &ResultScan{}
+	func() {
+		defer rs.catch()
+		rs.scan = rp.plan.Open()
+	}()
+	return rs
+}
+
+func (rp *ResultPlan) BlockAccessed() int64 {
+	return rp.plan.BlockAccessed()
+}
+
+func (rp *ResultPlan) RecordsOutput() int64 {
+	return rp.plan.RecordsOutput()
+}
+
+func (rp *ResultPlan) DistinctValues(fieldName string) int64 {
+	return rp.plan.DistinctValues(fieldName)
+}
+
+func (rp *ResultPlan) Schema() record.Schema {
+	return rp.plan.Schema()
+}
+
+// The scan of a ResultPlan.
+// Once the underlying scan fails, Next returns false,
+// the values read are null, and Err returns the error,
+// as with the Rows of database/sql.
+type ResultScan struct {
+	scan query.Scan
+	err  error
+}
+
+// Returns the error the scan failed on, if any.
+func (rs *ResultScan) Err() error {
+	return rs.err
+}
+
+func (rs *ResultScan) BeforeFirst() {
+	if rs.err != nil {
+		return
+	}
+	defer rs.catch()
+	rs.scan.BeforeFirst()
+}
+
+func (rs *ResultScan) Next() bool {
+	if rs.err != nil {
+		return false
+	}
+	defer rs.catch()
+	return rs.scan.Next()
+}
+
+func (rs *ResultScan) GetInt(fieldName string) int64 {
+	if rs.err != nil {
+		return 0
+	}
+	defer rs.catch()
+	return rs.scan.GetInt(fieldName)
+}
+
+func (rs *ResultScan) GetString(fieldName string) string {
+	if rs.err != nil {
+		return ""
+	}
+	defer rs.catch()
+	return rs.scan.GetString(fieldName)
+}
+
+func (rs *ResultScan) GetValue(fieldName string) (value query.Constant) {
+	if rs.err != nil {
+		return query.NewNullConstant()
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			rs.err = panicError(r)
+			value = query.NewNullConstant()
+		}
+	}()
+	return rs.scan.GetValue(fieldName)
+}
+
+func (rs *ResultScan) IsNull(fieldName string) bool {
+	if rs.err != nil {
+		return true
+	}
+	defer rs.catch()
+	return rs.scan.IsNull(fieldName)
+}
+
+func (rs *ResultScan) HasField(fieldName string) bool {
+	if rs.scan == nil {
+		return false
+	}
+	return rs.scan.HasField(fieldName)
+}
+
+// Closes the underlying scan. A failure to close it
+// is reported by Err, unless the scan had already failed.
+func (rs *ResultScan) Close() {
+	if rs.scan == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil && rs.err == nil {
+			rs.err = panicError(r)
+		}
+	}()
+	scan := rs.scan
+	rs.scan = nil
+	scan.Close()
+}
+
+// Records the error the underlying scan panicked with.
+// It must be deferred by the methods of the scan.
+func (rs *ResultScan) catch() {
+	if r := recover(); r != nil {
+		rs.err = panicError(r)
+	}
+}
@@ -0,0 +1,237 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+)
+
+// The error of an unqualified column reference
+// to a column that more than one table of a query has.
+var ErrAmbiguousColumn = errors.New("ambiguous column")
+
+// The scope of a query holds the tables of its from clause,
+// each under the name its columns can be qualified with.
+// It resolves the column references of the query, qualified
+// or not, to the fields of the plans of these tables, whose
+// names are always qualified as "table.column".
+type Scope struct {
+	names   []string
+	columns map[string][]string
+}
+
+func NewScope() *Scope {
+	return &Scope{make([]string, 0), make(map[string][]string)}
+}
+
+// Adds a table to the scope, under the specified name,
+// having the specified columns.
+// A name cannot be given to two tables of the same query.
+func (s *Scope) Add(name string, columns []string) {
+	if _, ok := s.columns[name]; ok {
+		panic(fmt.Errorf("%w: table `%v` is specified more than once", ErrDuplicateName, name))
+	}
+	s.names = append(s.names, name)
+	s.columns[name] = columns
+}
+
+// Returns the qualified field name of the specified column.
+// A qualified column must belong to the table it is qualified
+// with, and an unqualified column to exactly one table.
+func (s *Scope) Resolve(column string) string {
+	if name, columnName, ok := strings.Cut(column, "."); ok {
+		columns, ok := s.columns[name]
+		if !ok {
+			panic(fmt.Errorf("%w: `%v`", metadata.ErrTableNotFound, name))
+		}
+		if !slices.Contains(columns, columnName) {
+			panic(query.NewColumnNotFoundError(column))
+		}
+		return column
+	}
+	names := s.tablesOf(column)
+	if len(names) == 0 {
+		panic(query.NewColumnNotFoundError(column))
+	}
+	if len(names) > 1 {
+		last := len(names) - 1
+		panic(fmt.Errorf("%w: `%v` is a column of tables `%v` and `%v`",
+			ErrAmbiguousColumn, column, strings.Join(names[:last], "`, `"), names[last]))
+	}
+	return names[0] + "." + column
+}
+
+// Returns the expression with all of its
+// column references resolved.
+func (s *Scope) ResolveExpr(expr parser.Expr) parser.Expr {
+	switch value := expr.Value.(type) {
+	case string:
+		return parser.Expr{Value: s.Resolve(value)}
+	case parser.UnaryExpr:
+		return parser.Expr{Value: parser.UnaryExpr{Op: value.Op, Operand: s.ResolveExpr(value.Operand)}}
+	case parser.BinaryExpr:
+		return parser.Expr{Value: parser.BinaryExpr{Op: value.Op, Left: s.ResolveExpr(value.Left), Right: s.ResolveExpr(value.Right)}}
+	case parser.AggregateExpr:
+		if value.Arg.Value == nil {
+			return expr
+		}
+		return parser.Expr{Value: parser.AggregateExpr{Fn: value.Fn, Arg: s.ResolveExpr(value.Arg)}}
+	}
+	return expr
+}
+
+// Returns the condition with all of the column
+// references of its terms resolved.
+func (s *Scope) ResolveCondition(condition parser.Condition) parser.Condition {
+	if condition.IsEmpty() {
+		return condition
+	}
+	if condition.Op == "" {
+		term := condition.Term
+		return parser.NewTermCondition(parser.Term{Left: s.ResolveExpr(term.Left), Op: term.Op, Right: s.ResolveExpr(term.Right)})
+	}
+	children := make([]parser.Condition, 0, len(condition.Children))
+	for _, child := range condition.Children {
+		children = append(children, s.ResolveCondition(child))
+	}
+	return parser.Condition{Op: condition.Op, Children: children}
+}
+
+// Returns the query with all of its column references resolved.
+// The "*" of the select list is expanded into the columns of
+// every table. A column that is selected without an alias
+// is named after the column alone, unless another selected
+// column has the same name, in which case it keeps its
+// qualified name.
+// The order keys may also refer to the selected columns by name.
+func (s *Scope) ResolveSelectStmt(selectStmt parser.SelectStmt) parser.SelectStmt {
+	fields := make([]string, 0)
+	exprs := make([]parser.Expr, 0)
+	columns := make([]bool, 0)
+	for i, field := range selectStmt.Fields {
+		if field == "*" {
+			for _, name := range s.names {
+				for _, columnName := range s.columns[name] {
+					fields = append(fields, name+"."+columnName)
+					exprs = append(exprs, parser.Expr{Value: name + "." + columnName})
+					columns = append(columns, true)
+				}
+			}
+			continue
+		}
+		expr := selectStmt.Exprs[i]
+		fields = append(fields, field)
+		exprs = append(exprs, s.ResolveExpr(expr))
+		columns = append(columns, expr.IsFieldName() && expr.AsFieldExpr() == field)
+	}
+	for i := range fields {
+		if !columns[i] {
+			continue
+		}
+		_, columnName, _ := strings.Cut(exprs[i].AsFieldExpr(), ".")
+		if !slices.ContainsFunc(exprs, func(expr parser.Expr) bool {
+			return expr.IsFieldName() && expr.AsFieldExpr() != exprs[i].AsFieldExpr() && strings.HasSuffix(expr.AsFieldExpr(), "."+columnName)
+		}) && !slices.Contains(fields, columnName) {
+			fields[i] = columnName
+		}
+	}
+
+	groupBy := make([]string, 0, len(selectStmt.GroupBy))
+	for _, fieldName := range selectStmt.GroupBy {
+		groupBy = append(groupBy, s.Resolve(fieldName))
+	}
+	orderBy := make([]parser.OrderExpr, 0, len(selectStmt.OrderBy))
+	for _, orderExpr := range selectStmt.OrderBy {
+		expr := orderExpr.Expr
+		if i := slices.Index(selectStmt.Fields, expr.String()); expr.IsFieldName() && i >= 0 && selectStmt.Fields[i] != "*" {
+			expr = selectStmt.Exprs[i]
+		}
+		orderBy = append(orderBy, parser.OrderExpr{Expr: s.ResolveExpr(expr), Desc: orderExpr.Desc})
+	}
+
+	from := make([]parser.FromItem, 0, len(selectStmt.From))
+	for _, fromItem := range selectStmt.From {
+		joins := make([]parser.JoinClause, 0, len(fromItem.Joins))
+		for _, join := range fromItem.Joins {
+			joins = append(joins, parser.JoinClause{Kind: join.Kind, Table: join.Table, Condition: s.ResolveCondition(join.Condition)})
+		}
+		from = append(from, parser.FromItem{Table: fromItem.Table, Joins: joins})
+	}
+
+	return parser.SelectStmt{
+		Fields:    fields,
+		Exprs:     exprs,
+		Tables:    selectStmt.Tables,
+		From:      from,
+		Condition: s.ResolveCondition(selectStmt.Condition),
+		GroupBy:   groupBy,
+		Having:    s.ResolveCondition(selectStmt.Having),
+		OrderBy:   orderBy,
+	}
+}
+
+// Returns the names of the tables having the specified column.
+func (s *Scope) tablesOf(columnName string) []string {
+	names := make([]string, 0)
+	for _, name := range s.names {
+		if slices.Contains(s.columns[name], columnName) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Plans each table of the from clause of the query with the
+// specified function, which qualifies the fields of the plan
+// with the name of the table, and resolves the query in the
+// scope of these tables.
+// The literals its conditions compare with the fields of
+// the tables are converted to their types, as coerceLiterals
+// does, before an index is looked up with them.
+// Returns the resolved query, and the plans by table name.
+func resolveFromClause(selectStmt parser.SelectStmt, createTablePlan func(tableRef parser.TableRef) Plan) (parser.SelectStmt, map[string]Plan) {
+	scope := NewScope()
+	fromSchema := record.NewSchema()
+	plans := make(map[string]Plan)
+	for _, fromItem := range selectStmt.From {
+		for _, tableRef := range fromItem.TableRefs() {
+			name := tableRef.Name()
+			plan := createTablePlan(tableRef)
+			schema := plan.Schema()
+			columns := make([]string, 0)
+			for _, fieldName := range schema.Fields() {
+				columns = append(columns, strings.TrimPrefix(fieldName, name+"."))
+			}
+			scope.Add(name, columns)
+			fromSchema.AddAll(schema)
+			plans[name] = plan
+		}
+	}
+	selectStmt = scope.ResolveSelectStmt(selectStmt)
+	selectStmt.Condition = coerceLiterals(fromSchema, selectStmt.Condition)
+	for _, fromItem := range selectStmt.From {
+		for i, join := range fromItem.Joins {
+			fromItem.Joins[i].Condition = coerceLiterals(fromSchema, join.Condition)
+		}
+	}
+	return selectStmt, plans
+}
+
+// Returns the plan of a view, whose fields are
+// qualified with the specified name.
+func qualifyPlan(plan Plan, name string) Plan {
+	schema := plan.Schema()
+	fields := make([]string, 0)
+	exprs := make([]parser.Expr, 0)
+	for _, fieldName := range schema.Fields() {
+		fields = append(fields, name+"."+fieldName)
+		exprs = append(exprs, parser.Expr{Value: fieldName})
+	}
+	return NewProjectPlan(plan, fields, exprs)
+}
@@ -0,0 +1,112 @@
+package plan_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScope(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_scope")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	for _, stmt := range []string{
+		"create table users(id int, name varchar(10), manager int)",
+		"create table orders(id int, user_id int, total int)",
+		"create index users_id on users(id)",
+		"insert into users(id, name, manager) values (1, 'ann', 0)",
+		"insert into users(id, name, manager) values (2, 'bob', 1)",
+		"insert into users(id, name, manager) values (3, 'cid', 1)",
+		"insert into orders(id, user_id, total) values (10, 2, 5)",
+		"insert into orders(id, user_id, total) values (11, 2, 7)",
+		"insert into orders(id, user_id, total) values (12, 3, 9)",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+	err = db.MetadataManager().CreateView("big_orders", "select id, user_id from orders where total > 6", tx)
+	assert.Nil(err)
+
+	readRows := func(queryStr string) ([]string, []string) {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err)
+		p := result.(plan.Plan)
+		schema := p.Schema()
+		rows := make([]string, 0)
+		scan := p.Open()
+		for scan.Next() {
+			row := ""
+			for i, fieldName := range schema.Fields() {
+				if i > 0 {
+					row += ":"
+				}
+				value := scan.GetValue(fieldName)
+				row += value.String()
+			}
+			rows = append(rows, row)
+		}
+		scan.Close()
+		return schema.Fields(), rows
+	}
+
+	// Columns are qualified by the alias of their table, or its name,
+	// and selected columns are named after their alias.
+	fields, rows := readRows("select u.name as username, o.id, total from users u, orders o where u.id = o.user_id order by o.id")
+	assert.Equal([]string{"username", "id", "total"}, fields)
+	assert.Equal([]string{"bob:10:5", "bob:11:7", "cid:12:9"}, rows)
+	fields, rows = readRows("select users.name, orders.total from users join orders on users.id = orders.user_id where total > 6")
+	assert.Equal([]string{"name", "total"}, fields)
+	assert.ElementsMatch([]string{"bob:7", "cid:9"}, rows)
+
+	// A table can be joined with itself under different aliases,
+	// and columns of the same name keep their qualified name.
+	fields, rows = readRows("select e.name, m.name from users e, users m where e.manager = m.id")
+	assert.Equal([]string{"e.name", "m.name"}, fields)
+	assert.ElementsMatch([]string{"bob:ann", "cid:ann"}, rows)
+	fields, rows = readRows("select * from users e left join users m on e.manager = m.id where e.id = 1")
+	assert.Equal([]string{"e.id", "e.name", "e.manager", "m.id", "m.name", "m.manager"}, fields)
+	assert.Equal([]string{"1:ann:0:NULL:NULL:NULL"}, rows)
+
+	// The order keys and grouping can refer to aliases.
+	_, rows = readRows("select u.name n, count(o.id) c from users u, orders o where u.id = o.user_id group by u.name order by n desc")
+	assert.Equal([]string{"cid:1", "bob:2"}, rows)
+
+	// Views are aliased like tables.
+	fields, rows = readRows("select u.name, b.id from big_orders b, users u where b.user_id = u.id")
+	assert.Equal([]string{"name", "id"}, fields)
+	assert.ElementsMatch([]string{"bob:11", "cid:12"}, rows)
+
+	for _, queryStr := range []string{
+		"select id from users, orders",                     // ambiguous column
+		"select u.total from users u",                      // unknown column of the table
+		"select users.id from users u",                     // the table is only known by its alias
+		"select name from users u, orders u",               // duplicate alias
+		"select name from users, users",                    // duplicate table
+		"select name from users u where x.id = 1",          // unknown table
+		"select e.name from users e join orders on id = 1", // ambiguous join condition
+	} {
+		_, err = planner.ExecuteQuery(queryStr, tx)
+		assert.NotNil(err, queryStr)
+	}
+	_, err = planner.ExecuteQuery("select id from users, orders", tx)
+	assert.ErrorIs(err, plan.ErrAmbiguousColumn)
+	assert.EqualError(err, "ambiguous column: `id` is a column of tables `users` and `orders`")
+	_, err = planner.ExecuteQuery("select id from users u, orders o, users v", tx)
+	assert.EqualError(err, "ambiguous column: `id` is a column of tables `u`, `o` and `v`")
+	_, err = planner.ExecuteQuery("select u.total from users u", tx)
+	assert.ErrorIs(err, query.ErrColumnNotFound)
+	assert.EqualError(err, "column not found: `u.total`")
+	tx.Commit()
+}
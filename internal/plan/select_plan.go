@@ -1,6 +1,9 @@
 package plan
 
 import (
+	"math"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
 	"github.com/evanxg852000/simpledb/internal/query"
 	"github.com/evanxg852000/simpledb/internal/record"
 )
@@ -14,7 +17,11 @@ type SelectPlan struct {
 
 // Creates a new select node in the query tree,
 // having the specified subquery and predicate.
+// The literals the predicate compares with fields of the
+// subquery are converted to their types, as coerceLiterals does.
 func NewSelectPlan(plan Plan, predicate *query.Predicate) *SelectPlan {
+	schema := plan.Schema()
+	predicate = query.NewPredicate(coerceLiterals(&schema, predicate.Condition))
 	return &SelectPlan{plan, predicate}
 }
 
@@ -30,17 +37,26 @@ func (sp *SelectPlan) BlockAccessed() int64 {
 	return sp.plan.BlockAccessed()
 }
 
-// Estimates the number of records in the table,
-// which is obtainable from the statistics manager.
+// Estimates the number of output records in the selection,
+// which is determined by the reduction factor of the predicate.
 func (sp *SelectPlan) RecordsOutput() int64 {
 	return sp.plan.RecordsOutput() / ReductionFactor(sp.predicate, sp.plan)
 }
 
-// Estimates the number of distinct field values in the table,
-// which is obtainable from the statistics manager.
+// Estimates the number of distinct field values in the projection.
+// If the predicate contains a term equating the specified
+// field to a constant, then this value will be 1.
+// Otherwise, it will be the number of the distinct values
+// in the underlying query
+// (but not more than the size of the output table).
 func (sp *SelectPlan) DistinctValues(fieldName string) int64 {
-	//TODO: missing optimal query planner
-	return 1
+	if _, ok := sp.predicate.EquatesWithConstant(fieldName); ok {
+		return 1
+	}
+	if otherField, ok := sp.predicate.EquatesWithField(fieldName); ok {
+		return min(sp.plan.DistinctValues(fieldName), sp.plan.DistinctValues(otherField))
+	}
+	return min(sp.plan.DistinctValues(fieldName), max(sp.RecordsOutput(), 1))
 }
 
 // Returns the schema of the selection,
@@ -49,7 +65,72 @@ func (sp *SelectPlan) Schema() record.Schema {
 	return sp.plan.Schema()
 }
 
+// Calculates the extent to which selecting on the predicate
+// reduces the number of records output by a query.
+// For example if the reduction factor is 2, then the
+// predicate cuts the size of the output in half.
 func ReductionFactor(pred *query.Predicate, p Plan) int64 {
-	//TODO: missing optimal query planner
-	return 1
+	if pred.Condition.IsEmpty() {
+		return 1
+	}
+	return conditionReductionFactor(pred.Condition, p)
+}
+
+// Calculates the reduction factor of a condition tree.
+// The children of a conjunction multiply their factors,
+// while a disjunction keeps the smallest one.
+// A negation is assumed to keep most records.
+func conditionReductionFactor(condition parser.Condition, p Plan) int64 {
+	switch condition.Op {
+	case "and":
+		factor := int64(1)
+		for _, child := range condition.Children {
+			childFactor := conditionReductionFactor(child, p)
+			if factor > math.MaxInt64/childFactor {
+				return math.MaxInt64
+			}
+			factor *= childFactor
+		}
+		return factor
+	case "or":
+		factor := int64(math.MaxInt64)
+		for _, child := range condition.Children {
+			factor = min(factor, conditionReductionFactor(child, p))
+		}
+		return factor
+	case "not":
+		return 1
+	}
+	return termReductionFactor(condition.Term, p)
+}
+
+// Calculates the extent to which selecting on the term reduces
+// the number of records output by a query.
+// An equality between a field and a constant keeps one of the
+// field's distinct values, and an equality between two fields
+// keeps one of the distinct values of the larger domain.
+// An equality between two constants keeps all the records or none.
+// Inequalities and equalities between computed values
+// are assumed to keep most records.
+func termReductionFactor(term parser.Term, p Plan) int64 {
+	if term.Op != "=" {
+		return 1
+	}
+	if term.Left.IsFieldName() && term.Right.IsFieldName() {
+		return max(p.DistinctValues(term.Left.AsFieldExpr()), p.DistinctValues(term.Right.AsFieldExpr()))
+	}
+	if term.Left.IsFieldName() {
+		return p.DistinctValues(term.Left.AsFieldExpr())
+	}
+	if term.Right.IsFieldName() {
+		return p.DistinctValues(term.Right.AsFieldExpr())
+	}
+	if !term.Left.IsLiteral() || !term.Right.IsLiteral() {
+		return 1
+	}
+	left := query.NewConstant(term.Left.AsLiteralExpr().Value)
+	if left.Equals(query.NewConstant(term.Right.AsLiteralExpr().Value)) {
+		return 1
+	}
+	return math.MaxInt64
 }
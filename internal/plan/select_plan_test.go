@@ -0,0 +1,193 @@
+package plan_test
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectPlanNulls(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_select_plan_nulls")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	// The columns missing from an insert are null.
+	for _, stmt := range []string{
+		"create table items(id int, name varchar(10), price int)",
+		"create index items_price on items(price)",
+		"insert into items(id, name, price) values (1, 'pen', 3)",
+		"insert into items(id, name) values (2, 'ink')",
+		"insert into items(id, price) values (3, 5)",
+		"insert into items(id, name, price) values (4, null, null)",
+		"update items set price = null where id = 1",
+		"update items set price = 3 where id = 4",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err, stmt)
+	}
+
+	readIds := func(queryStr string) []int64 {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err)
+		ids := make([]int64, 0)
+		scan := result.(plan.Plan).Open()
+		for scan.Next() {
+			ids = append(ids, scan.GetInt("id"))
+		}
+		scan.Close()
+		return ids
+	}
+
+	assert.ElementsMatch([]int64{1, 2}, readIds("select id from items where price is null"))
+	assert.ElementsMatch([]int64{3, 4}, readIds("select id from items where price is not null"))
+	assert.ElementsMatch([]int64{3, 4}, readIds("select id from items where name is null"))
+
+	// A comparison with null is unknown, and so is its negation,
+	// unless the other operand of a conjunction or disjunction
+	// decides the result.
+	assert.ElementsMatch([]int64{3}, readIds("select id from items where price > 3"))
+	assert.ElementsMatch([]int64{4}, readIds("select id from items where not price > 3"))
+	assert.ElementsMatch([]int64{}, readIds("select id from items where price = null"))
+	assert.ElementsMatch([]int64{}, readIds("select id from items where not price = null"))
+	assert.ElementsMatch([]int64{1, 2, 3}, readIds("select id from items where price > 3 or id < 3"))
+	assert.ElementsMatch([]int64{4}, readIds("select id from items where not (price > 3 or id = 2)"))
+	assert.ElementsMatch([]int64{2, 3}, readIds("select id from items where not (price = 3 and name = 'pen')"))
+
+	// The index only holds the values that are not null.
+	assert.ElementsMatch([]int64{4}, readIds("select id from items where price = 3"))
+
+	// Null values are rendered as NULL, and sorted first.
+	result, err := planner.ExecuteQuery("select name, price from items order by price, id", tx)
+	assert.Nil(err)
+	rows := make([]string, 0)
+	scan := result.(plan.Plan).Open()
+	for scan.Next() {
+		name := scan.GetValue("name")
+		price := scan.GetValue("price")
+		assert.Equal(name.IsNull(), scan.IsNull("name"))
+		rows = append(rows, name.String()+":"+price.String())
+	}
+	scan.Close()
+	assert.Equal([]string{"pen:NULL", "ink:NULL", "NULL:3", "NULL:5"}, rows)
+
+	// Aggregates ignore nulls, and only count is not null
+	// over a group without other values, or without records.
+	readAggregates := func(queryStr string) []string {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err)
+		p := result.(plan.Plan)
+		schema := p.Schema()
+		rows := make([]string, 0)
+		scan := p.Open()
+		for scan.Next() {
+			row := make([]string, 0)
+			for _, fieldName := range schema.Fields() {
+				value := scan.GetValue(fieldName)
+				row = append(row, value.String())
+			}
+			rows = append(rows, strings.Join(row, ":"))
+		}
+		scan.Close()
+		return rows
+	}
+	assert.Equal([]string{"2:8:4:3:5"}, readAggregates("select count(price), sum(price), avg(price), min(price), max(price) from items"))
+	assert.Equal([]string{"0:NULL:NULL:NULL:NULL"}, readAggregates("select count(price), sum(price), avg(price), min(price), max(price) from items where id < 3"))
+	assert.Equal([]string{"0:NULL:NULL:NULL:NULL"}, readAggregates("select count(price), sum(price), avg(price), min(name), max(name) from items where id > 4"))
+	assert.ElementsMatch([]string{"1:NULL:NULL", "2:NULL:NULL", "3:5:5", "4:3:3"}, readAggregates("select id, sum(price), min(price) from items group by id"))
+	tx.Commit()
+}
+
+func TestSelectPlanColumnTypes(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_select_plan_column_types")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	// Integers are converted to doubles, and dates to timestamps.
+	for _, stmt := range []string{
+		"create table events(id bigint, done boolean, cost double, day date, at timestamp)",
+		"create index events_cost on events(cost)",
+		"create index events_day on events(day)",
+		"insert into events(id, done, cost, day, at) values (1, true, 2.5, date '2024-01-31', timestamp '2024-01-31 08:15:00')",
+		"insert into events(id, done, cost, day, at) values (2, false, 3, date '2024-02-01', date '2024-02-01')",
+		"insert into events(id, done, cost, day, at) values (3, false, -1.25, date '1969-12-31', timestamp '2024-02-01 23:59:59.5')",
+		"update events set done = true, cost = cost * 2 where id = 3",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err, stmt)
+	}
+
+	readRows := func(queryStr string) []string {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err, queryStr)
+		p := result.(plan.Plan)
+		schema := p.Schema()
+		rows := make([]string, 0)
+		scan := p.Open()
+		for scan.Next() {
+			row := ""
+			for i, fieldName := range schema.Fields() {
+				if i > 0 {
+					row += "|"
+				}
+				value := scan.GetValue(fieldName)
+				row += value.String()
+			}
+			rows = append(rows, row)
+		}
+		scan.Close()
+		return rows
+	}
+
+	assert.Equal([]string{
+		"1|true|2.5|2024-01-31|2024-01-31 08:15:00",
+		"2|false|3|2024-02-01|2024-02-01 00:00:00",
+		"3|true|-2.5|1969-12-31|2024-02-01 23:59:59.5",
+	}, readRows("select id, done, cost, day, at from events order by id"))
+
+	// Values of different numeric or temporal types compare by value,
+	// also when looked up in an index.
+	assert.Equal([]string{"2"}, readRows("select id from events where cost = 3"))
+	assert.Equal([]string{"2"}, readRows("select id from events where day = date '2024-02-01'"))
+	assert.Equal([]string{"2", "3"}, readRows("select id from events where at >= date '2024-02-01' order by id"))
+	assert.Equal([]string{"1", "3"}, readRows("select id from events where done = true order by id"))
+
+	// Strings compared with a column convert to its type,
+	// as they do when they are stored in it.
+	assert.Equal([]string{"2"}, readRows("select id from events where day = '2024-02-01'"))
+	assert.Equal([]string{"2", "3"}, readRows("select id from events where '2024-02-01 00:00:00' <= at order by id"))
+	assert.Equal([]string{"1"}, readRows("select e.id from events e left join events f on e.id = f.id and f.day = '2024-01-31' where f.id is not null"))
+	assert.Equal([]string{"3", "1", "2"}, readRows("select id from events order by cost"))
+	assert.Equal([]string{"3", "1", "2"}, readRows("select id from events order by day"))
+	assert.Equal([]string{"1.25", "1.5"}, readRows("select cost / 2 from events where cost > 0 order by id"))
+	assert.Equal([]string{"3|1|-2.5|3"}, readRows("select sum(cost), avg(cost), min(cost), max(cost) from events"))
+	assert.Equal([]string{"false|1", "true|2"}, readRows("select done, count(id) from events group by done order by done"))
+
+	for _, stmt := range []string{
+		"insert into events(id, done) values (4, 1)",
+		"insert into events(id, cost) values (4, 'free')",
+		"insert into events(id, day) values (4, 'someday')",
+		"select id from events where cost < 'free'",
+		"select id from events where day = 'someday'",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.ErrorContains(err, "type mismatch", stmt)
+	}
+	tx.Commit()
+}
@@ -0,0 +1,243 @@
+package plan
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The Plan class for the sort operator.
+// The sort is an external merge sort: the input is split
+// into sorted runs as large as the available buffers can
+// hold, which are written to temporary tables and merged
+// as many at a time as there are available buffers.
+// An input that fits in a single run is sorted in memory.
+type SortPlan struct {
+	tx         *recovery.Transaction
+	plan       Plan
+	schema     record.Schema
+	orderBy    []parser.OrderExpr
+	comparator *query.RecordComparator
+}
+
+// A record of the input, along with the values of its sort keys.
+type sortRecord struct {
+	keys   []query.Constant
+	values []query.Constant
+}
+
+// Create a sort plan for the specified query,
+// ordered by the specified sort keys.
+func NewSortPlan(tx *recovery.Transaction, plan Plan, orderBy []parser.OrderExpr) *SortPlan {
+	schema := plan.Schema()
+	exprs := make([]*query.Expression, 0, len(orderBy))
+	desc := make([]bool, 0, len(orderBy))
+	for _, orderExpr := range orderBy {
+		expr := query.NewExpression(orderExpr.Expr)
+		if !schema.AppliesTo(expr) {
+			panic(fmt.Errorf("%w: sort key `%v` refers to unknown fields", query.ErrColumnNotFound, orderExpr.Expr.String()))
+		}
+		exprs = append(exprs, expr)
+		desc = append(desc, orderExpr.Desc)
+	}
+	return &SortPlan{tx, plan, schema, orderBy, query.NewRecordComparator(exprs, desc)}
+}
+
+// This method is where most of the action is.
+// Up to 2 sorting passes are usually needed.
+// The first pass splits the input into sorted runs,
+// the subsequent ones merge groups of runs until
+// at most 2 are left for the sort scan to merge them,
+// so that the open scan only pins 2 buffers, leaving
+// the others to the scans opened along with it,
+// such as the other input of a merge join.
+func (sp *SortPlan) Open() query.Scan {
+	src := sp.plan.Open()
+	runs, records := sp.splitIntoRuns(src)
+	src.Close()
+	if runs == nil {
+		return query.NewMemoryScan(sp.schema.Fields(), records)
+	}
+
+	fanIn := max(sp.availableBuffers()-1, 2)
+	for len(runs) > 2 {
+		runs = sp.doMergeIteration(runs, fanIn)
+	}
+	return sp.mergeScan(runs)
+}
+
+// Return the number of blocks in the sorted table,
+// which is the cost of reading it back.
+// It does not include the one-time cost
+// of splitting and merging the runs.
+func (sp *SortPlan) BlockAccessed() int64 {
+	return estimatedBlocks(sp.tx, &sp.schema, sp.plan.RecordsOutput())
+}
+
+// Return the number of records in the sorted table,
+// which is the same as in the underlying query.
+func (sp *SortPlan) RecordsOutput() int64 {
+	return sp.plan.RecordsOutput()
+}
+
+// Return the number of distinct field values in
+// the sorted table, which is the same as in
+// the underlying query.
+func (sp *SortPlan) DistinctValues(fieldName string) int64 {
+	return sp.plan.DistinctValues(fieldName)
+}
+
+// Return the schema of the sorted table, which
+// is the same as in the underlying query.
+func (sp *SortPlan) Schema() record.Schema {
+	return sp.schema
+}
+
+// Reads the input in chunks of as many records as the
+// available buffers can hold, and writes each chunk,
+// once sorted, to a temporary table.
+// When the whole input fits in a single chunk, no run
+// is written and the sorted records are returned instead.
+func (sp *SortPlan) splitIntoRuns(src query.Scan) ([]*record.TempTable, [][]query.Constant) {
+	capacity := sp.runCapacity()
+	var runs []*record.TempTable
+	chunk := make([]sortRecord, 0)
+	for src.Next() {
+		if int64(len(chunk)) == capacity {
+			runs = append(runs, sp.writeRun(chunk))
+			chunk = chunk[:0]
+		}
+		values := make([]query.Constant, 0, len(sp.schema.Fields()))
+		for _, fieldName := range sp.schema.Fields() {
+			values = append(values, src.GetValue(fieldName))
+		}
+		chunk = append(chunk, sortRecord{sp.comparator.Keys(src), values})
+	}
+
+	if runs == nil {
+		sp.sortChunk(chunk)
+		records := make([][]query.Constant, 0, len(chunk))
+		for _, rec := range chunk {
+			records = append(records, rec.values)
+		}
+		return nil, records
+	}
+	if len(chunk) > 0 {
+		runs = append(runs, sp.writeRun(chunk))
+	}
+	return runs, nil
+}
+
+// Returns the number of records a run can hold,
+// which fill all the available buffers except the one
+// needed to write the run.
+func (sp *SortPlan) runCapacity() int64 {
+	layout := record.NewLayout(&sp.schema)
+	recordsPerBlock := max(sp.tx.BlockSize()/layout.SlotSize(), 1)
+	blocks := max(sp.availableBuffers()-1, 1)
+	return blocks * recordsPerBlock
+}
+
+// Returns the number of available buffers the runs may use.
+// When values may be stored out of line, two buffers are left
+// to read and write them, since writing a value pins the
+// header of the overflow file along with one of its blocks.
+func (sp *SortPlan) availableBuffers() int64 {
+	available := sp.tx.AvailableBuffers()
+	for _, fieldName := range sp.schema.Fields() {
+		if sp.schema.MayBeOutOfLine(fieldName) {
+			return available - 2
+		}
+	}
+	return available
+}
+
+func (sp *SortPlan) sortChunk(chunk []sortRecord) {
+	slices.SortStableFunc(chunk, func(a, b sortRecord) int {
+		return sp.comparator.CompareKeys(a.keys, b.keys)
+	})
+}
+
+func (sp *SortPlan) writeRun(chunk []sortRecord) *record.TempTable {
+	sp.sortChunk(chunk)
+	run := record.NewTempTable(sp.tx, &sp.schema)
+	dest := run.Open()
+	for _, rec := range chunk {
+		dest.Insert()
+		for i, fieldName := range sp.schema.Fields() {
+			dest.SetValue(fieldName, rec.values[i])
+		}
+	}
+	dest.Close()
+	return run
+}
+
+// Merges the runs by groups of the specified size,
+// each group becoming a single run.
+func (sp *SortPlan) doMergeIteration(runs []*record.TempTable, fanIn int64) []*record.TempTable {
+	result := make([]*record.TempTable, 0)
+	for start := 0; start < len(runs); start += int(fanIn) {
+		group := runs[start:min(start+int(fanIn), len(runs))]
+		if len(group) == 1 {
+			result = append(result, group[0])
+			continue
+		}
+		result = append(result, sp.mergeRuns(group))
+	}
+	return result
+}
+
+func (sp *SortPlan) mergeRuns(runs []*record.TempTable) *record.TempTable {
+	src := sp.mergeScan(runs)
+	result := record.NewTempTable(sp.tx, &sp.schema)
+	dest := result.Open()
+	copyRecords(src, dest, sp.schema.Fields())
+	src.Close()
+	dest.Close()
+	return result
+}
+
+func (sp *SortPlan) mergeScan(runs []*record.TempTable) *query.SortScan {
+	scans := make([]query.Scan, 0, len(runs))
+	for _, run := range runs {
+		scans = append(scans, run.Open())
+	}
+	return query.NewSortScan(scans, sp.comparator)
+}
+
+// Returns true if the records of the plan are known to
+// be ordered by the specified sort keys, in which case
+// they need not be sorted again.
+// Selections and the nested loop joins keep the order
+// of their input, or of their LHS input.
+func isSortedOn(plan Plan, orderBy []parser.OrderExpr) bool {
+	switch p := plan.(type) {
+	case *SortPlan:
+		if len(orderBy) > len(p.orderBy) {
+			return false
+		}
+		for i, orderExpr := range orderBy {
+			if orderExpr.Desc != p.orderBy[i].Desc || orderExpr.Expr.String() != p.orderBy[i].Expr.String() {
+				return false
+			}
+		}
+		return true
+	case *MergeJoinPlan:
+		if len(orderBy) != 1 || orderBy[0].Desc || !orderBy[0].Expr.IsFieldName() {
+			return false
+		}
+		fieldName := orderBy[0].Expr.AsFieldExpr()
+		return fieldName == p.leftField || fieldName == p.rightField
+	case *SelectPlan:
+		return isSortedOn(p.plan, orderBy)
+	case *ProductPlan:
+		return isSortedOn(p.left, orderBy)
+	case *IndexJoinPlan:
+		return isSortedOn(p.left, orderBy)
+	}
+	return false
+}
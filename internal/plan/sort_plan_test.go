@@ -0,0 +1,123 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortPlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_sort_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	_, err = planner.ExecuteQuery("create table items(id int, grp int, name varchar(10))", tx)
+	assert.Nil(err)
+	// Ids are inserted in a scrambled order.
+	for i := 0; i < 500; i++ {
+		id := (i * 37) % 500
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into items(id, grp, name) values (%d, %d, 'item_%03d')", id, id%7, id), tx)
+		assert.Nil(err)
+	}
+
+	tempFiles := func() []string {
+		files, err := filepath.Glob(path.Join(dbDir, "temp-*.tbl"))
+		assert.Nil(err)
+		return files
+	}
+	readIds := func(queryStr string) []int64 {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err)
+		ids := make([]int64, 0)
+		scan := result.(plan.Plan).Open()
+		for scan.Next() {
+			ids = append(ids, scan.GetInt("id"))
+		}
+		scan.Close()
+		return ids
+	}
+
+	// A few records are sorted in memory.
+	assert.Equal([]int64{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, readIds("select id from items where id < 10 order by id desc"))
+	assert.Empty(tempFiles())
+
+	// The whole table does not fit in the buffers, so the sorted runs are
+	// spilled to temporary tables, and merged back in more than one pass.
+	expected := make([]int64, 0)
+	for i := 0; i < 500; i++ {
+		expected = append(expected, int64(i))
+	}
+	assert.Equal(expected, readIds("select id from items order by id"))
+	assert.Greater(len(tempFiles()), 2)
+
+	// Records are sorted on the first key, then on the next ones.
+	expected = slices.Clone(expected)
+	slices.SortStableFunc(expected, func(a, b int64) int {
+		if a%7 != b%7 {
+			return int(a%7 - b%7)
+		}
+		return int(b - a)
+	})
+	assert.Equal(expected, readIds("select id, name from items order by grp, name desc"))
+
+	// Sort keys may be expressions and aggregates, which need not be selected.
+	assert.Equal([]int64{499, 498, 497}, readIds("select id from items where id > 496 order by 0 - id"))
+	result, err := planner.ExecuteQuery("select grp from items group by grp order by max(id) % 10, grp desc", tx)
+	assert.Nil(err)
+	grps := make([]int64, 0)
+	scan := result.(plan.Plan).Open()
+	for scan.Next() {
+		grps = append(grps, scan.GetInt("grp"))
+	}
+	scan.Close()
+	// The largest ids of the groups 0 to 6 are 497, 498, 499, 493, 494, 495 and 496.
+	assert.Equal([]int64{3, 4, 5, 6, 0, 1, 2}, grps)
+
+	_, err = planner.ExecuteQuery("select id from items order by price", tx)
+	assert.NotNil(err)
+
+	// Runs holding values stored out of line leave
+	// buffers to read and write them.
+	for _, stmt := range []string{"create table docs(id int, body varchar(300))", "create table copies(n int)"} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 60; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into docs(id, body) values (%d, '%s')", i, strings.Repeat("y", 150+i)), tx)
+		assert.Nil(err)
+	}
+	for i := 0; i < 5; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into copies(n) values (%d)", i), tx)
+		assert.Nil(err)
+	}
+	expected = make([]int64, 0)
+	for i := 59; i >= 0; i-- {
+		for j := 0; j < 5; j++ {
+			expected = append(expected, int64(i))
+		}
+	}
+	assert.Equal(expected, readIds("select id, body from docs, copies order by body desc"))
+
+	// The sort plan estimates the blocks of the sorted table.
+	selectStmt := parseSelectStmt(t, "select id from items order by name")
+	sortPlan := plan.NewSortPlan(tx, plan.NewTablePlan(tx, "items", db.MetadataManager()), selectStmt.OrderBy)
+	assert.Greater(sortPlan.BlockAccessed(), int64(0))
+	tx.Commit()
+
+	// The runs are deleted along with the transaction.
+	assert.Empty(tempFiles())
+}
@@ -2,6 +2,7 @@ package plan
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/evanxg852000/simpledb/internal/metadata"
 	"github.com/evanxg852000/simpledb/internal/query"
@@ -12,6 +13,7 @@ import (
 // The Plan class corresponding to a table.
 type TablePlan struct {
 	tableName string
+	alias     string
 	tx        *recovery.Transaction
 	layout    *record.Layout
 	statInfo  metadata.StatInfo
@@ -22,22 +24,35 @@ type TablePlan struct {
 func NewTablePlan(tx *recovery.Transaction, tableName string, mdtManager *metadata.MetadataManager) *TablePlan {
 	layout, err := mdtManager.GetLayout(tableName, tx)
 	if err != nil {
-		panic(fmt.Sprintf("could not retrieve table `%s` layout", tableName))
+		panic(err)
 	}
 	statInfo := mdtManager.GetStatInfo(tableName, layout, tx)
 	return &TablePlan{
 		tableName,
+		"",
 		tx,
 		layout,
 		statInfo,
 	}
 }
 
+// Creates a leaf node in the query tree corresponding
+// to the specified table, as referred to by the specified
+// alias in the from clause of a query.
+// The fields of the table are qualified with the alias,
+// so that they cannot collide with those of other tables.
+func NewAliasedTablePlan(tx *recovery.Transaction, tableName string, alias string, mdtManager *metadata.MetadataManager) *TablePlan {
+	tblPlan := NewTablePlan(tx, tableName, mdtManager)
+	tblPlan.alias = alias
+	tblPlan.layout = tblPlan.layout.Qualified(alias)
+	return tblPlan
+}
+
 // Creates a table scan for this query.
 func (tblPlan *TablePlan) Open() query.Scan {
 	scan, err := record.NewTableScan(tblPlan.tx, tblPlan.tableName, tblPlan.layout)
 	if err != nil {
-		panic(fmt.Sprint("could not create table plan", err))
+		panic(fmt.Errorf("could not create table plan: %w", err))
 	}
 	return any(scan).(query.Scan)
 }
@@ -57,7 +72,7 @@ func (tblPlan *TablePlan) RecordsOutput() int64 {
 // Estimates the number of distinct field values in the table,
 // which is obtainable from the statistics manager.
 func (tblPlan *TablePlan) DistinctValues(fieldName string) int64 {
-	return tblPlan.statInfo.DistinctValues(fieldName)
+	return tblPlan.statInfo.DistinctValues(tblPlan.unqualify(fieldName))
 }
 
 // Determines the schema of the table,
@@ -65,3 +80,21 @@ func (tblPlan *TablePlan) DistinctValues(fieldName string) int64 {
 func (tblPlan *TablePlan) Schema() record.Schema {
 	return *tblPlan.layout.Schema
 }
+
+// Returns the name of the specified column of the table
+// in the schema of the plan.
+func (tblPlan *TablePlan) qualify(columnName string) string {
+	if tblPlan.alias == "" {
+		return columnName
+	}
+	return tblPlan.alias + "." + columnName
+}
+
+// Returns the column of the table named by
+// the specified field of the plan.
+func (tblPlan *TablePlan) unqualify(fieldName string) string {
+	if tblPlan.alias == "" {
+		return fieldName
+	}
+	return strings.TrimPrefix(fieldName, tblPlan.alias+".")
+}
@@ -0,0 +1,148 @@
+package plan_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/plan"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlottedTablePlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_slotted_table_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	for _, stmt := range []string{
+		"create table notes(id int, body varchar(100)) format slotted",
+		"create index notes_id on notes(id)",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err, stmt)
+	}
+	for i := 0; i < 40; i++ {
+		_, err = planner.ExecuteQuery(fmt.Sprintf("insert into notes(id, body) values (%d, 'note %d')", i, i), tx)
+		assert.Nil(err)
+	}
+	_, err = planner.ExecuteQuery("create table other(id int) format compact", tx)
+	assert.EqualError(err, "not supported: table format `compact`")
+	tx.Commit()
+
+	// The format of the table is kept in the catalog.
+	db = server.NewSimpleDB(dbDir, 400, 8)
+	planner = db.Planner()
+	tx = db.NewTx()
+	layout, err := db.MetadataManager().GetLayout("notes", tx)
+	assert.Nil(err)
+	assert.Equal(int64(record.SLOTTED_FORMAT), layout.Format())
+	size, err := tx.Size("notes.tbl")
+	assert.Nil(err)
+	assert.Less(size, int64(40/(400/layout.MaxRecordSize())))
+
+	readRows := func(queryStr string) []string {
+		result, err := planner.ExecuteQuery(queryStr, tx)
+		assert.Nil(err, queryStr)
+		p := result.(plan.Plan)
+		rows := make([]string, 0)
+		scan := p.Open()
+		for scan.Next() {
+			value := scan.GetValue("body")
+			rows = append(rows, value.String())
+		}
+		scan.Close()
+		return rows
+	}
+
+	// Records keep their identifier when their values grow,
+	// so that the index still finds them.
+	long := strings.Repeat("x", 90)
+	for _, stmt := range []string{
+		"delete from notes where id > 20",
+		fmt.Sprintf("update notes set body = '%v' where id = 3", long),
+		"update notes set body = null where id = 4",
+		"update notes set body = 'n' where id = 5",
+	} {
+		_, err = planner.ExecuteQuery(stmt, tx)
+		assert.Nil(err, stmt)
+	}
+	assert.Equal([]string{long}, readRows("select body from notes where id = 3"))
+	assert.Equal([]string{"NULL"}, readRows("select body from notes where id = 4"))
+	assert.Equal([]string{"n"}, readRows("select body from notes where id = 5"))
+	assert.Equal([]string{"note 20"}, readRows("select body from notes where id = 20"))
+	assert.Empty(readRows("select body from notes where id = 21"))
+	assert.Len(readRows("select body from notes"), 21)
+	tx.Commit()
+}
+
+func TestOverflowTablePlan(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_overflow_table_plan")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	planner := db.Planner()
+	tx := db.NewTx()
+
+	// Values larger than a block are stored in the overflow
+	// file of the table, in both formats.
+	long := strings.Repeat("0123456789", 100)
+	for tblName, format := range map[string]string{"docs": "", "slotted_docs": "format slotted"} {
+		for _, stmt := range []string{
+			fmt.Sprintf("create table %s(id int, body varchar(1000), data blob) %s", tblName, format),
+			fmt.Sprintf("insert into %s(id, body, data) values (1, '%s', x'cafe')", tblName, long),
+			fmt.Sprintf("insert into %s(id, body) values (2, 'short')", tblName),
+			fmt.Sprintf("update %s set body = '%s' where id = 2", tblName, long[:500]),
+		} {
+			_, err = planner.ExecuteQuery(stmt, tx)
+			assert.Nil(err, stmt)
+		}
+		result, err := planner.ExecuteQuery(fmt.Sprintf("select id, body, data from %s", tblName), tx)
+		assert.Nil(err)
+		scan := result.(plan.Plan).Open()
+		rows := make([]string, 0)
+		for scan.Next() {
+			data := scan.GetValue("data")
+			rows = append(rows, fmt.Sprintf("%d %s %s", scan.GetInt("id"), scan.GetString("body"), data.String()))
+		}
+		scan.Close()
+		assert.Equal([]string{"1 " + long + " x'cafe'", "2 " + long[:500] + " NULL"}, rows)
+	}
+
+	_, err = planner.ExecuteQuery("create index docs_body on docs(body)", tx)
+	assert.EqualError(err, "field `body` of table `docs` may be stored out of line and cannot be indexed")
+
+	// View definitions are no longer limited to a slot.
+	condition := make([]string, 0)
+	for i := 0; i < 50; i++ {
+		condition = append(condition, fmt.Sprintf("id != %d", i+10))
+	}
+	viewDef := "select id from docs where " + strings.Join(condition, " and ")
+	_, err = planner.ExecuteQuery("create view doc_ids as "+viewDef, tx)
+	assert.Nil(err)
+	storedDef, err := db.MetadataManager().GetViewDef("doc_ids", tx)
+	assert.Nil(err)
+	assert.Equal(viewDef, storedDef)
+	result, err := planner.ExecuteQuery("select id from doc_ids", tx)
+	assert.Nil(err)
+	scan := result.(plan.Plan).Open()
+	ids := make([]int64, 0)
+	for scan.Next() {
+		ids = append(ids, scan.GetInt("id"))
+	}
+	scan.Close()
+	assert.Equal([]int64{1, 2}, ids)
+	tx.Commit()
+}
@@ -0,0 +1,157 @@
+package plan
+
+import (
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// This class contains methods for planning a single table
+// (or view) of a query.
+type TablePlanner struct {
+	tx        *recovery.Transaction
+	tablePlan *TablePlan
+	plan      Plan
+	predicate *query.Predicate
+	schema    record.Schema
+	indexes   map[string]metadata.IndexInfo
+}
+
+// Creates a new table planner for the specified table plan.
+// The specified predicate applies to the entire query.
+// The table planner is responsible for determining
+// which portion of the predicate is useful to the table,
+// and when indexes are useful.
+func NewTablePlanner(tablePlan *TablePlan, predicate *query.Predicate, tx *recovery.Transaction, mdtManager *metadata.MetadataManager) *TablePlanner {
+	indexes, err := mdtManager.GetIndexInfo(tablePlan.tableName, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching table indexes: %w", err))
+	}
+	return &TablePlanner{tx, tablePlan, tablePlan, predicate, tablePlan.Schema(), indexes}
+}
+
+// Creates a new planner for an already planned view,
+// or outer join of the from clause. Such a plan has
+// no indexes, so it is always read in full.
+func NewViewPlanner(viewPlan Plan, predicate *query.Predicate, tx *recovery.Transaction) *TablePlanner {
+	return &TablePlanner{tx, nil, viewPlan, predicate, viewPlan.Schema(), map[string]metadata.IndexInfo{}}
+}
+
+// Constructs a select plan for the table.
+// The plan will use an indexselect, if possible.
+func (tp *TablePlanner) MakeSelectPlan() Plan {
+	plan := tp.makeIndexSelect()
+	if plan == nil {
+		plan = tp.plan
+	}
+	return tp.addSelectPred(plan)
+}
+
+// Constructs a join plan of the specified plan
+// and the table. The plan will use an indexjoin, if possible.
+// Otherwise, when the table is joined on an equality, it will
+// use a mergejoin if it produces the specified order or costs
+// less than a hashjoin, and a hashjoin if not.
+// The method first considers the part of the predicate
+// joining the table to the specified plan;
+// if there is none, the method returns nil.
+func (tp *TablePlanner) MakeJoinPlan(current Plan, orderBy []parser.OrderExpr) Plan {
+	currentSchema := current.Schema()
+	joinPred := tp.predicate.JoinSubPred(&tp.schema, &currentSchema)
+	if joinPred == nil {
+		return nil
+	}
+	plan := tp.makeIndexJoin(current, currentSchema)
+	if plan == nil {
+		plan = tp.makeEquiJoin(current, currentSchema, orderBy)
+	}
+	if plan == nil {
+		plan = tp.makeProductJoin(current, currentSchema)
+	}
+	return plan
+}
+
+// Constructs a product plan of the specified plan and
+// this table.
+func (tp *TablePlanner) MakeProductPlan(current Plan) Plan {
+	plan := tp.addSelectPred(tp.plan)
+	return NewProductPlan(current, plan)
+}
+
+func (tp *TablePlanner) makeIndexSelect() Plan {
+	if tp.tablePlan == nil {
+		return nil
+	}
+	var plan Plan
+	for _, fieldName := range sortedIndexFields(tp.indexes) {
+		value, ok := tp.predicate.EquatesWithConstant(tp.tablePlan.qualify(fieldName))
+		if !ok {
+			continue
+		}
+		indexInfo := tp.indexes[fieldName]
+		indexPlan := NewIndexSelectPlan(tp.tablePlan, &indexInfo, value)
+		if plan == nil || indexPlan.BlockAccessed() < plan.BlockAccessed() {
+			plan = indexPlan
+		}
+	}
+	return plan
+}
+
+func (tp *TablePlanner) makeIndexJoin(current Plan, currentSchema record.Schema) Plan {
+	if tp.tablePlan == nil {
+		return nil
+	}
+	for _, fieldName := range sortedIndexFields(tp.indexes) {
+		outerField, ok := tp.predicate.EquatesWithField(tp.tablePlan.qualify(fieldName))
+		if !ok || !currentSchema.HasField(outerField) {
+			continue
+		}
+		indexInfo := tp.indexes[fieldName]
+		var plan Plan = NewIndexJoinPlan(current, tp.tablePlan, &indexInfo, outerField)
+		plan = tp.addSelectPred(plan)
+		return tp.addJoinPred(plan, currentSchema)
+	}
+	return nil
+}
+
+func (tp *TablePlanner) makeEquiJoin(current Plan, currentSchema record.Schema, orderBy []parser.OrderExpr) Plan {
+	for _, fieldName := range tp.schema.Fields() {
+		outerField, ok := tp.predicate.EquatesWithField(fieldName)
+		if !ok || !currentSchema.HasField(outerField) {
+			continue
+		}
+		plan := tp.addSelectPred(tp.plan)
+		var joinPlan Plan = NewHashJoinPlan(tp.tx, current, plan, outerField, fieldName)
+		mergeJoinPlan := NewMergeJoinPlan(tp.tx, current, plan, outerField, fieldName)
+		if (len(orderBy) > 0 && isSortedOn(mergeJoinPlan, orderBy)) || mergeJoinPlan.BlockAccessed() < joinPlan.BlockAccessed() {
+			joinPlan = mergeJoinPlan
+		}
+		return tp.addJoinPred(joinPlan, currentSchema)
+	}
+	return nil
+}
+
+func (tp *TablePlanner) makeProductJoin(current Plan, currentSchema record.Schema) Plan {
+	plan := tp.MakeProductPlan(current)
+	return tp.addJoinPred(plan, currentSchema)
+}
+
+func (tp *TablePlanner) addSelectPred(plan Plan) Plan {
+	selectPred := tp.predicate.SelectSubPred(&tp.schema)
+	if selectPred == nil {
+		return plan
+	}
+	return NewSelectPlan(plan, selectPred)
+}
+
+func (tp *TablePlanner) addJoinPred(plan Plan, currentSchema record.Schema) Plan {
+	joinPred := tp.predicate.JoinSubPred(&currentSchema, &tp.schema)
+	if joinPred == nil {
+		return plan
+	}
+	return NewSelectPlan(plan, joinPred)
+}
@@ -0,0 +1,359 @@
+package plan
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/evanxg852000/simpledb/internal/metadata"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The error of a statement that creates a table, a view or an
+// index under a name that is already used, or that names
+// the same column or table twice.
+var ErrDuplicateName = errors.New("duplicate name")
+
+// The error of a string that is longer than
+// the varchar field it is stored in.
+var ErrValueTooLong = errors.New("value too long")
+
+// Verifies the statement against the catalog before it is planned.
+// Each table, view and column the statement mentions must exist,
+// an insert must have a value for each of its columns, a literal
+// must convert to the type of the column it is stored in or
+// compared with, and fit in it if it is a string, and a new
+// table, view, index or column must not have the name of an
+// existing one.
+// The error names the offending identifier, and wraps the error
+// of its kind, such as metadata.ErrTableNotFound,
+// query.ErrColumnNotFound, query.ErrTypeMismatch,
+// ErrDuplicateName or ErrValueTooLong.
+func (planner *Planner) VerifyStatement(sqlStmt parser.Statement, tx *recovery.Transaction) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError(r)
+		}
+	}()
+
+	switch stmt := sqlStmt.(type) {
+	case parser.SelectStmt:
+		planner.verifySelect(stmt, tx)
+	case parser.InsertStmt:
+		planner.verifyInsert(stmt, tx)
+	case parser.UpdateStmt:
+		planner.verifyModify(stmt, tx)
+	case parser.DeleteStmt:
+		schema := planner.tableSchema(stmt.Table, tx)
+		verifyCondition(stmt.Table, schema, stmt.Condition)
+	case parser.CreateTableStmt:
+		planner.verifyCreateTable(stmt, tx)
+	case parser.CreateViewStmt:
+		planner.verifyCreateView(stmt, tx)
+	case parser.CreateIndexStmt:
+		planner.verifyCreateIndex(stmt, tx)
+	}
+	return nil
+}
+
+// Binds the tables of the from clause of the query, and each of
+// its column references to one of their fields, as the query
+// planners do, then checks the comparisons of its conditions.
+func (planner *Planner) verifySelect(stmt parser.SelectStmt, tx *recovery.Transaction) {
+	scope := NewScope()
+	schema := record.NewSchema()
+	for _, fromItem := range stmt.From {
+		for _, tableRef := range fromItem.TableRefs() {
+			name := tableRef.Name()
+			refSchema := planner.fromSchema(tableRef.Table, tx)
+			scope.Add(name, refSchema.Fields())
+			for _, fldName := range refSchema.Fields() {
+				schema.AddField(name+"."+fldName, refSchema.FieldType(fldName), refSchema.FieldLength(fldName))
+			}
+		}
+	}
+
+	stmt = scope.ResolveSelectStmt(stmt)
+	for i, field := range stmt.Fields {
+		if slices.Contains(stmt.Fields[:i], field) {
+			panic(fmt.Errorf("%w: column `%s` is selected more than once", ErrDuplicateName, field))
+		}
+	}
+	verifyComparisons(schema, stmt.Condition)
+	for _, fromItem := range stmt.From {
+		for _, join := range fromItem.Joins {
+			verifyComparisons(schema, join.Condition)
+		}
+	}
+	verifyComparisons(schema, stmt.Having)
+}
+
+func (planner *Planner) verifyInsert(stmt parser.InsertStmt, tx *recovery.Transaction) {
+	schema := planner.tableSchema(stmt.Table, tx)
+	fields := insertFields(stmt, *schema)
+	verifyColumns(stmt.Table, schema, fields)
+	if stmt.Fields == nil && len(stmt.Values) != len(fields) {
+		panic(fmt.Errorf("insert into `%s` has %d values but the table has %d columns", stmt.Table, len(stmt.Values), len(fields)))
+	}
+	if len(stmt.Values) != len(fields) {
+		panic(fmt.Errorf("insert into `%s` names %d columns but has %d values", stmt.Table, len(fields), len(stmt.Values)))
+	}
+	for i, fldName := range fields {
+		verifyValue(schema, fldName, stmt.Values[i])
+	}
+}
+
+func (planner *Planner) verifyModify(stmt parser.UpdateStmt, tx *recovery.Transaction) {
+	schema := planner.tableSchema(stmt.Table, tx)
+	fields := make([]string, 0, len(stmt.Exprs))
+	for _, updateExpr := range stmt.Exprs {
+		fields = append(fields, updateExpr.Field)
+	}
+	verifyColumns(stmt.Table, schema, fields)
+	for _, updateExpr := range stmt.Exprs {
+		verifyFieldsExist(stmt.Table, schema, updateExpr.Value.FieldNames())
+		if updateExpr.Value.IsLiteral() {
+			verifyValue(schema, updateExpr.Field, updateExpr.Value.AsLiteralExpr())
+		}
+	}
+	verifyCondition(stmt.Table, schema, stmt.Condition)
+}
+
+func (planner *Planner) verifyCreateTable(stmt parser.CreateTableStmt, tx *recovery.Transaction) {
+	planner.verifyNewTableName(stmt.Table, tx)
+	fields := make([]string, 0, len(stmt.Fields))
+	for _, fieldSpec := range stmt.Fields {
+		verifyNameLength("column", fieldSpec.Name)
+		if slices.Contains(fields, fieldSpec.Name) {
+			panic(fmt.Errorf("%w: column `%s` is defined more than once", ErrDuplicateName, fieldSpec.Name))
+		}
+		fields = append(fields, fieldSpec.Name)
+	}
+}
+
+func (planner *Planner) verifyCreateView(stmt parser.CreateViewStmt, tx *recovery.Transaction) {
+	planner.verifyNewTableName(stmt.Name, tx)
+	if len(stmt.QueryStr) > metadata.MAX_VIEW_DEF {
+		panic(fmt.Errorf("the definition of view `%s` is longer than %d characters", stmt.Name, metadata.MAX_VIEW_DEF))
+	}
+	planner.verifySelect(stmt.Query, tx)
+}
+
+func (planner *Planner) verifyCreateIndex(stmt parser.CreateIndexStmt, tx *recovery.Transaction) {
+	verifyNameLength("index", stmt.Name)
+	exists, err := planner.mdtManager.HasIndex(stmt.Name, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching index info: %w", err))
+	}
+	if exists {
+		panic(fmt.Errorf("%w: index `%s` already exists", ErrDuplicateName, stmt.Name))
+	}
+	schema := planner.tableSchema(stmt.Table, tx)
+	verifyFieldsExist(stmt.Table, schema, []string{stmt.Field})
+	// the indexes of a table are found by the column they index,
+	// so a column cannot have more than one
+	indexes, err := planner.mdtManager.GetIndexInfo(stmt.Table, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching index info: %w", err))
+	}
+	if indexInfo, ok := indexes[stmt.Field]; ok {
+		panic(fmt.Errorf("%w: column `%s` of table `%s` already has index `%s`", ErrDuplicateName, stmt.Field, stmt.Table, indexInfo.IndexName))
+	}
+}
+
+// Returns the schema of the specified table, which
+// cannot be a view, since the records of a view
+// are neither stored nor indexed.
+func (planner *Planner) tableSchema(tblName string, tx *recovery.Transaction) *record.Schema {
+	layout, err := planner.mdtManager.GetLayout(tblName, tx)
+	if errors.Is(err, metadata.ErrTableNotFound) && planner.viewDef(tblName, tx) != "" {
+		panic(fmt.Errorf("`%s` is a view, not a table", tblName))
+	}
+	if err != nil {
+		panic(err)
+	}
+	return layout.Schema
+}
+
+// Returns the schema of the table of a from clause,
+// or of the records of the view it names.
+// The query of a view is planned to find the types
+// of the columns it computes.
+func (planner *Planner) fromSchema(name string, tx *recovery.Transaction) *record.Schema {
+	if viewDef := planner.viewDef(name, tx); viewDef != "" {
+		stmts, err := parser.ParseQuery(viewDef)
+		if err != nil {
+			panic(fmt.Errorf("error parsing view definition: %w", err))
+		}
+		schema := planner.queryPlanner.CreatePlan(stmts[0].(parser.SelectStmt), tx).Schema()
+		return &schema
+	}
+	layout, err := planner.mdtManager.GetLayout(name, tx)
+	if err != nil {
+		panic(err)
+	}
+	return layout.Schema
+}
+
+func (planner *Planner) viewDef(viewName string, tx *recovery.Transaction) string {
+	viewDef, err := planner.mdtManager.GetViewDef(viewName, tx)
+	if err != nil {
+		panic(fmt.Errorf("error fetching view definition: %w", err))
+	}
+	return viewDef
+}
+
+// Tables and views are named in the same from clauses,
+// so that a new one cannot have the name of either.
+func (planner *Planner) verifyNewTableName(name string, tx *recovery.Transaction) {
+	verifyNameLength("table", name)
+	if planner.viewDef(name, tx) != "" {
+		panic(fmt.Errorf("%w: view `%s` already exists", ErrDuplicateName, name))
+	}
+	_, err := planner.mdtManager.GetLayout(name, tx)
+	if err == nil {
+		panic(fmt.Errorf("%w: table `%s` already exists", ErrDuplicateName, name))
+	}
+	if !errors.Is(err, metadata.ErrTableNotFound) {
+		panic(err)
+	}
+}
+
+// The names of the catalog are varchar fields.
+func verifyNameLength(kind string, name string) {
+	if len(name) > metadata.MAX_NAME_LENGTH {
+		panic(fmt.Errorf("%w: %s name `%s` has more than %d characters", ErrValueTooLong, kind, name, metadata.MAX_NAME_LENGTH))
+	}
+}
+
+// Verifies that the columns of an insert or an update
+// are fields of the table, and are not named twice.
+func verifyColumns(tblName string, schema *record.Schema, fields []string) {
+	verifyFieldsExist(tblName, schema, fields)
+	for i, fldName := range fields {
+		if slices.Contains(fields[:i], fldName) {
+			panic(fmt.Errorf("%w: column `%s` is assigned more than once", ErrDuplicateName, fldName))
+		}
+	}
+}
+
+func verifyFieldsExist(tblName string, schema *record.Schema, fields []string) {
+	for _, fldName := range fields {
+		if !schema.HasField(fldName) {
+			panic(fmt.Errorf("%w: `%s` in table `%s`", query.ErrColumnNotFound, fldName, tblName))
+		}
+	}
+}
+
+// Verifies the condition of an update or a delete,
+// whose columns are the fields of a single table.
+func verifyCondition(tblName string, schema *record.Schema, condition parser.Condition) {
+	verifyFieldsExist(tblName, schema, condition.FieldNames())
+	verifyComparisons(schema, condition)
+}
+
+// Verifies that the literal can be stored in the specified field:
+// it must convert to the type of the field, as record.CoerceValue
+// does, and a string must not be longer than a varchar field.
+func verifyValue(schema *record.Schema, fldName string, literal parser.Literal) {
+	if literal.IsNull() {
+		return
+	}
+	fldType := schema.FieldType(fldName)
+	value := query.NewConstant(literal.Value)
+	if !typeChecks(func() { record.CoerceValue(fldType, value) }) {
+		panic(fmt.Errorf("%w: cannot store `%v` in column `%s` of type %s", query.ErrTypeMismatch, value.String(), fldName, typeName(schema, fldName)))
+	}
+	if str, ok := literal.Value.(string); ok && fldType == record.STRING_TYPE && int64(len(str)) > schema.FieldLength(fldName) {
+		panic(fmt.Errorf("%w: `%s` has %d characters, more than column `%s` of type %s", ErrValueTooLong, str, len(str), fldName, typeName(schema, fldName)))
+	}
+}
+
+// Verifies that each term of the condition that compares a field
+// of the schema with a literal compares values of the same kind,
+// once the literal is converted as coerceLiterals does.
+// Null tests and comparisons with null always type check.
+func verifyComparisons(schema *record.Schema, condition parser.Condition) {
+	coerceLiterals(schema, condition)
+}
+
+// Returns the condition whose literals compared with a field of
+// the schema are converted to the type of the field when they
+// cannot be compared with it as they are. They are converted as
+// record.CoerceValue converts a literal stored in the field, so
+// that a date column compares with a string of the form
+// "yyyy-mm-dd" as it stores it. A literal that does not convert
+// panics with a type mismatch.
+func coerceLiterals(schema *record.Schema, condition parser.Condition) parser.Condition {
+	if !condition.IsTerm() {
+		if len(condition.Children) == 0 {
+			return condition
+		}
+		children := make([]parser.Condition, 0, len(condition.Children))
+		for _, child := range condition.Children {
+			children = append(children, coerceLiterals(schema, child))
+		}
+		condition.Children = children
+		return condition
+	}
+	condition.Term.Right = coerceLiteral(schema, condition.Term.Left, condition.Term.Right)
+	condition.Term.Left = coerceLiteral(schema, condition.Term.Right, condition.Term.Left)
+	return condition
+}
+
+func coerceLiteral(schema *record.Schema, field parser.Expr, other parser.Expr) parser.Expr {
+	if !field.IsFieldName() || !other.IsLiteral() || !schema.HasField(field.AsFieldExpr()) {
+		return other
+	}
+	literal := other.AsLiteralExpr()
+	if literal.IsNull() {
+		return other
+	}
+	fldName := field.AsFieldExpr()
+	fldType := schema.FieldType(fldName)
+	fieldValue := record.ZeroValue(fldType)
+	value := query.NewConstant(literal.Value)
+	if typeChecks(func() { fieldValue.CompareTo(value) }) {
+		return other
+	}
+	if !typeChecks(func() { value = record.CoerceValue(fldType, value) }) {
+		panic(fmt.Errorf("%w: cannot compare column `%s` of type %s with `%v`", query.ErrTypeMismatch, fldName, typeName(schema, fldName), value.String()))
+	}
+	return parser.Expr{Value: parser.Literal{Value: value.Value()}}
+}
+
+// Returns false if the function panics with a type mismatch.
+func typeChecks(check func()) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if err, isErr := r.(error); !isErr || !errors.Is(err, query.ErrTypeMismatch) {
+				panic(r)
+			}
+		}
+	}()
+	check()
+	return true
+}
+
+// Returns the type of the field, as it is written
+// in a create table statement.
+func typeName(schema *record.Schema, fldName string) string {
+	switch schema.FieldType(fldName) {
+	case record.STRING_TYPE:
+		return fmt.Sprintf("varchar(%d)", schema.FieldLength(fldName))
+	case record.BOOLEAN_TYPE:
+		return "boolean"
+	case record.DOUBLE_TYPE:
+		return "double"
+	case record.DATE_TYPE:
+		return "date"
+	case record.TIMESTAMP_TYPE:
+		return "timestamp"
+	case record.BLOB_TYPE:
+		return "blob"
+	}
+	return "int"
+}
@@ -0,0 +1,214 @@
+package query
+
+// The interface implemented by aggregation functions.
+// Aggregation functions are used by the group by operator.
+type AggregationFn interface {
+	// Returns a new aggregation function of the same kind,
+	// over the same expression, that has not processed
+	// any record yet.
+	New() AggregationFn
+
+	// Use the current record of the specified scan
+	// to be the first record in the group.
+	ProcessFirst(scan Scan)
+
+	// Use the current record of the specified scan
+	// to be the next record in the group.
+	ProcessNext(scan Scan)
+
+	// Return the name of the new aggregation field.
+	FieldName() string
+
+	// Return the computed aggregation value.
+	// Null values are ignored, and the value over a group
+	// without other values is null, except for a count.
+	Value() Constant
+}
+
+// The count aggregation function.
+// Counting an expression counts the records for which
+// it is not null, while counting without an expression,
+// as in count(*), counts all the records of the group.
+type CountFn struct {
+	fieldName string
+	expr      *Expression
+	count     int64
+}
+
+func NewCountFn(fieldName string, expr *Expression) *CountFn {
+	return &CountFn{fieldName: fieldName, expr: expr}
+}
+
+func (fn *CountFn) New() AggregationFn {
+	return NewCountFn(fn.fieldName, fn.expr)
+}
+
+func (fn *CountFn) ProcessFirst(scan Scan) {
+	fn.count = 0
+	fn.ProcessNext(scan)
+}
+
+func (fn *CountFn) ProcessNext(scan Scan) {
+	if fn.expr != nil {
+		value := fn.expr.Evaluate(scan)
+		if value.IsNull() {
+			return
+		}
+	}
+	fn.count++
+}
+
+func (fn *CountFn) FieldName() string {
+	return fn.fieldName
+}
+
+func (fn *CountFn) Value() Constant {
+	return NewConstant(fn.count)
+}
+
+// The sum aggregation function.
+// The sum is a double as soon as one of the values is,
+// and null until a value that is not null is processed.
+type SumFn struct {
+	fieldName string
+	expr      *Expression
+	sum       Constant
+}
+
+func NewSumFn(fieldName string, expr *Expression) *SumFn {
+	return &SumFn{fieldName: fieldName, expr: expr, sum: NewNullConstant()}
+}
+
+func (fn *SumFn) New() AggregationFn {
+	return NewSumFn(fn.fieldName, fn.expr)
+}
+
+func (fn *SumFn) ProcessFirst(scan Scan) {
+	fn.sum = NewNullConstant()
+	fn.ProcessNext(scan)
+}
+
+func (fn *SumFn) ProcessNext(scan Scan) {
+	value := fn.expr.Evaluate(scan)
+	if value.IsNull() {
+		return
+	}
+	value = numericOperand("sum", value)
+	if fn.sum.IsNull() {
+		fn.sum = value
+		return
+	}
+	fn.sum = evaluateBinary("+", fn.sum, value)
+}
+
+func (fn *SumFn) FieldName() string {
+	return fn.fieldName
+}
+
+func (fn *SumFn) Value() Constant {
+	return fn.sum
+}
+
+// The avg aggregation function.
+// The average of integers is truncated to an integer,
+// while the average of doubles is a double.
+// The average of no value is null.
+type AvgFn struct {
+	fieldName string
+	expr      *Expression
+	sum       Constant
+	count     int64
+}
+
+func NewAvgFn(fieldName string, expr *Expression) *AvgFn {
+	return &AvgFn{fieldName: fieldName, expr: expr, sum: NewConstant(int64(0))}
+}
+
+func (fn *AvgFn) New() AggregationFn {
+	return NewAvgFn(fn.fieldName, fn.expr)
+}
+
+func (fn *AvgFn) ProcessFirst(scan Scan) {
+	fn.sum = NewConstant(int64(0))
+	fn.count = 0
+	fn.ProcessNext(scan)
+}
+
+func (fn *AvgFn) ProcessNext(scan Scan) {
+	value := fn.expr.Evaluate(scan)
+	if value.IsNull() {
+		return
+	}
+	fn.sum = evaluateBinary("+", fn.sum, numericOperand("avg", value))
+	fn.count++
+}
+
+func (fn *AvgFn) FieldName() string {
+	return fn.fieldName
+}
+
+func (fn *AvgFn) Value() Constant {
+	if fn.count == 0 {
+		return NewNullConstant()
+	}
+	return evaluateBinary("/", fn.sum, NewConstant(fn.count))
+}
+
+// Returns the value aggregated by the function,
+// which must be a number.
+func numericOperand(fnName string, value Constant) Constant {
+	floatOperand(fnName, value)
+	return value
+}
+
+// The min and max aggregation functions,
+// which keep the smallest or the largest value
+// depending on the sign of the comparison they keep.
+// Their value is null until a value that is not null
+// is seen.
+type extremumFn struct {
+	fieldName string
+	expr      *Expression
+	sign      int
+	value     Constant
+	seen      bool
+}
+
+// Creates the min aggregation function.
+func NewMinFn(fieldName string, expr *Expression) AggregationFn {
+	return &extremumFn{fieldName, expr, -1, NewNullConstant(), false}
+}
+
+// Creates the max aggregation function.
+func NewMaxFn(fieldName string, expr *Expression) AggregationFn {
+	return &extremumFn{fieldName, expr, 1, NewNullConstant(), false}
+}
+
+func (fn *extremumFn) New() AggregationFn {
+	return &extremumFn{fn.fieldName, fn.expr, fn.sign, NewNullConstant(), false}
+}
+
+func (fn *extremumFn) ProcessFirst(scan Scan) {
+	fn.value = NewNullConstant()
+	fn.seen = false
+	fn.ProcessNext(scan)
+}
+
+func (fn *extremumFn) ProcessNext(scan Scan) {
+	value := fn.expr.Evaluate(scan)
+	if value.IsNull() {
+		return
+	}
+	if !fn.seen || value.CompareTo(fn.value)*fn.sign > 0 {
+		fn.value = value
+		fn.seen = true
+	}
+}
+
+func (fn *extremumFn) FieldName() string {
+	return fn.fieldName
+}
+
+func (fn *extremumFn) Value() Constant {
+	return fn.value
+}
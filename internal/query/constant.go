@@ -1,9 +1,21 @@
 package query
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
 )
 
+// The error of a value used where a value of another,
+// incompatible type is expected.
+var ErrTypeMismatch = errors.New("type mismatch")
+
 type Constant struct {
 	value any
 }
@@ -12,28 +24,230 @@ func NewConstant(value any) Constant {
 	return Constant{value}
 }
 
+// Creates the null constant, which stands for a missing value,
+// such as the fields of the unmatched side of an outer join.
+func NewNullConstant() Constant {
+	return Constant{nil}
+}
+
+func (c *Constant) IsNull() bool {
+	return c.value == nil
+}
+
+// Returns the value of the constant, or nil for null.
+func (c *Constant) Value() any {
+	return c.value
+}
+
 func (c *Constant) AsInt() int64 {
-	return c.value.(int64)
+	value, ok := c.value.(int64)
+	if !ok {
+		panic(fmt.Errorf("%w: `%v` is not an integer", ErrTypeMismatch, c.String()))
+	}
+	return value
 }
 
 func (c *Constant) AsString() string {
-	return c.value.(string)
+	value, ok := c.value.(string)
+	if !ok {
+		panic(fmt.Errorf("%w: `%v` is not a string", ErrTypeMismatch, c.String()))
+	}
+	return value
+}
+
+func (c *Constant) AsBool() bool {
+	value, ok := c.value.(bool)
+	if !ok {
+		panic(fmt.Errorf("%w: `%v` is not a boolean", ErrTypeMismatch, c.String()))
+	}
+	return value
+}
+
+// Returns the value as a double,
+// which an integer is converted to.
+func (c *Constant) AsFloat() float64 {
+	switch value := c.value.(type) {
+	case float64:
+		return value
+	case int64:
+		return float64(value)
+	}
+	panic(fmt.Errorf("%w: `%v` is not a number", ErrTypeMismatch, c.String()))
+}
+
+// Returns the value as a date,
+// which a string of the form "yyyy-mm-dd" is parsed to.
+func (c *Constant) AsDate() parser.Date {
+	switch value := c.value.(type) {
+	case parser.Date:
+		return value
+	case string:
+		date, err := parser.ParseDate(value)
+		if err != nil {
+			panic(fmt.Errorf("%w: %v", ErrTypeMismatch, err))
+		}
+		return date
+	}
+	panic(fmt.Errorf("%w: `%v` is not a date", ErrTypeMismatch, c.String()))
+}
+
+// Returns the value as a timestamp, which a date
+// or a string of the form "yyyy-mm-dd hh:mm:ss" is converted to.
+func (c *Constant) AsTimestamp() parser.Timestamp {
+	switch value := c.value.(type) {
+	case parser.Timestamp:
+		return value
+	case parser.Date:
+		return value.Timestamp()
+	case string:
+		timestamp, err := parser.ParseTimestamp(value)
+		if err != nil {
+			panic(fmt.Errorf("%w: %v", ErrTypeMismatch, err))
+		}
+		return timestamp
+	}
+	panic(fmt.Errorf("%w: `%v` is not a timestamp", ErrTypeMismatch, c.String()))
+}
+
+// Returns the value as a blob,
+// which a string is converted to.
+func (c *Constant) AsBlob() parser.Blob {
+	switch value := c.value.(type) {
+	case parser.Blob:
+		return value
+	case string:
+		return parser.Blob(value)
+	}
+	panic(fmt.Errorf("%w: `%v` is not a blob", ErrTypeMismatch, c.String()))
 }
 
 func (c *Constant) String() string {
+	switch value := c.value.(type) {
+	case nil:
+		return "NULL"
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
 	return fmt.Sprint(c.value)
 }
 
+// Returns true if the constant has the same value as the other.
+// An integer and a double are equal when their numeric values
+// are, and so are a date and a timestamp at its midnight.
 func (c *Constant) Equals(other Constant) bool {
-	switch c.value.(type) {
+	if c.IsNull() || other.IsNull() || !comparable(c.value, other.value) {
+		return false
+	}
+	return c.CompareTo(other) == 0
+}
+
+// Returns a non-negative hash of the constant value,
+// suitable for choosing a bucket of a hash index.
+// Equal constants have the same hash, even when
+// their types differ.
+func (c *Constant) HashCode() int64 {
+	switch value := c.value.(type) {
+	case int64:
+		return hashInt(value)
+	case float64:
+		if value == math.Trunc(value) && math.Abs(value) < math.MaxInt64 {
+			return hashInt(int64(value))
+		}
+		return int64(math.Float64bits(value) & math.MaxInt64)
+	case string:
+		return hashString(value)
+	case parser.Blob:
+		return hashString(string(value))
+	case bool:
+		if value {
+			return 1
+		}
+	case parser.Date:
+		return hashInt(int64(value.Timestamp()))
+	case parser.Timestamp:
+		return hashInt(int64(value))
+	}
+	return 0
+}
+
+// Compares the constant with another constant of a comparable type.
+// Returns a negative number, zero or a positive number when
+// the constant is respectively less than, equal to or
+// greater than the other one.
+// Null sorts before any other value, and false before true.
+func (c *Constant) CompareTo(other Constant) int {
+	if c.IsNull() || other.IsNull() {
+		if !other.IsNull() {
+			return -1
+		} else if !c.IsNull() {
+			return 1
+		}
+		return 0
+	}
+	if !comparable(c.value, other.value) {
+		panic(fmt.Errorf("%w: cannot compare `%v` with `%v`", ErrTypeMismatch, c.String(), other.String()))
+	}
+	switch value := c.value.(type) {
 	case int64:
-		if other_value, ok := other.value.(int64); ok {
-			return c.AsInt() == other_value
+		if otherValue, ok := other.value.(int64); ok {
+			return cmp.Compare(value, otherValue)
 		}
+		return cmp.Compare(float64(value), other.AsFloat())
+	case float64:
+		return cmp.Compare(value, other.AsFloat())
 	case string:
-		if other_value, ok := other.value.(string); ok {
-			return c.AsString() == other_value
+		return strings.Compare(value, other.value.(string))
+	case parser.Blob:
+		return strings.Compare(string(value), string(other.value.(parser.Blob)))
+	case bool:
+		otherValue := other.value.(bool)
+		if value == otherValue {
+			return 0
+		} else if !value {
+			return -1
+		}
+		return 1
+	case parser.Date:
+		if otherValue, ok := other.value.(parser.Date); ok {
+			return cmp.Compare(value, otherValue)
 		}
+		return cmp.Compare(value.Timestamp(), other.AsTimestamp())
+	case parser.Timestamp:
+		return cmp.Compare(value, other.AsTimestamp())
+	}
+	return 0
+}
+
+// Clears the sign bit rather than negating the value,
+// since math.MinInt64 has no positive counterpart.
+func hashInt(value int64) int64 {
+	return value & math.MaxInt64
+}
+
+func hashString(value string) int64 {
+	hasher := fnv.New32a()
+	hasher.Write([]byte(value))
+	return int64(hasher.Sum32())
+}
+
+// Returns the kind of values the value can be compared with:
+// numbers, strings, booleans, points in time, or blobs.
+func valueKind(value any) int {
+	switch value.(type) {
+	case int64, float64:
+		return parser.DOUBLE_TYPE
+	case string:
+		return parser.STRING_TYPE
+	case bool:
+		return parser.BOOLEAN_TYPE
+	case parser.Date, parser.Timestamp:
+		return parser.TIMESTAMP_TYPE
+	case parser.Blob:
+		return parser.BLOB_TYPE
 	}
-	return false
+	return 0
+}
+
+func comparable(value any, other any) bool {
+	return valueKind(value) == valueKind(other)
 }
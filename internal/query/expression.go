@@ -1,11 +1,24 @@
 package query
 
 import (
+	"errors"
 	"fmt"
+	"math"
 
 	"github.com/evanxg852000/simpledb/internal/parser"
 )
 
+// The error of an integer operation whose result
+// does not fit in 64 bits.
+var ErrOverflow = errors.New("integer overflow")
+
+// The error of a division or a remainder by zero.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// The error of an expression or an operator
+// the engine does not know how to evaluate.
+var ErrInvalidExpression = errors.New("invalid expression")
+
 type Expression struct {
 	inner parser.Expr
 }
@@ -17,11 +30,7 @@ func NewExpression(inner parser.Expr) *Expression {
 // Evaluate the expression with respect to the
 // current record of the specified scan.
 func (expr *Expression) Evaluate(scan Scan) Constant {
-	if !expr.inner.IsFieldName() {
-		return NewConstant(expr.inner.AsLiteralExpr().Value)
-	}
-
-	return scan.GetValue(expr.inner.AsFieldExpr())
+	return EvaluateExpr(scan, expr.inner)
 }
 
 // Return true if the expression is a field reference.
@@ -43,9 +52,147 @@ func (expr *Expression) AsFieldName() string {
 	return expr.inner.AsFieldExpr()
 }
 
+// Return the names of the fields the expression refers to.
+func (expr *Expression) FieldNames() []string {
+	return expr.inner.FieldNames()
+}
+
+// Return the expression tree this expression evaluates.
+func (expr *Expression) Inner() parser.Expr {
+	return expr.inner
+}
+
 func (expr *Expression) toString() string {
-	if !expr.inner.IsFieldName() {
-		return fmt.Sprintf("%v", expr.inner.AsLiteralExpr().Value)
+	return expr.inner.String()
+}
+
+// Evaluates the expression tree against the current record of the scan.
+// Arithmetic operators apply to numbers, and yield a double
+// when either operand is one, while the concatenation operator
+// accepts operands of any type.
+// An operator applied to null evaluates to null, and an
+// integer result that does not fit in 64 bits is an ErrOverflow.
+func EvaluateExpr(scan Scan, expr parser.Expr) Constant {
+	switch value := expr.Value.(type) {
+	case string:
+		return scan.GetValue(value)
+	case parser.Literal:
+		return NewConstant(value.Value)
+	case parser.UnaryExpr:
+		operand := EvaluateExpr(scan, value.Operand)
+		if operand.IsNull() {
+			return operand
+		}
+		if _, ok := operand.value.(float64); ok {
+			return NewConstant(-operand.AsFloat())
+		}
+		integer := integerOperand(value.Op, operand)
+		if integer == math.MinInt64 {
+			panic(fmt.Errorf("%w: `-(%d)`", ErrOverflow, integer))
+		}
+		return NewConstant(-integer)
+	case parser.BinaryExpr:
+		left := EvaluateExpr(scan, value.Left)
+		right := EvaluateExpr(scan, value.Right)
+		return evaluateBinary(value.Op, left, right)
+	case parser.AggregateExpr:
+		// Aggregates are computed by a group scan,
+		// which exposes each of them as a field.
+		return scan.GetValue(expr.String())
 	}
-	return expr.inner.AsFieldExpr()
+	panic(fmt.Errorf("%w: `%v`", ErrInvalidExpression, expr.Value))
+}
+
+func evaluateBinary(op string, left, right Constant) Constant {
+	if left.IsNull() || right.IsNull() {
+		return NewNullConstant()
+	}
+	if op == "||" {
+		return NewConstant(left.String() + right.String())
+	}
+	_, leftFloat := left.value.(float64)
+	_, rightFloat := right.value.(float64)
+	if leftFloat || rightFloat {
+		return evaluateFloat(op, floatOperand(op, left), floatOperand(op, right))
+	}
+	lhs := integerOperand(op, left)
+	rhs := integerOperand(op, right)
+	switch op {
+	case "+":
+		result := lhs + rhs
+		// The sum overflows when both operands have a sign
+		// different from the sign of the result.
+		if (lhs^result)&(rhs^result) < 0 {
+			panic(overflowError(op, lhs, rhs))
+		}
+		return NewConstant(result)
+	case "-":
+		result := lhs - rhs
+		// The difference overflows when the operands have
+		// different signs, and the result has the sign of rhs.
+		if (lhs^rhs)&(lhs^result) < 0 {
+			panic(overflowError(op, lhs, rhs))
+		}
+		return NewConstant(result)
+	case "*":
+		result := lhs * rhs
+		if lhs != 0 && (result/lhs != rhs || (lhs == -1 && rhs == math.MinInt64)) {
+			panic(overflowError(op, lhs, rhs))
+		}
+		return NewConstant(result)
+	case "/", "%":
+		if rhs == 0 {
+			panic(fmt.Errorf("%w: `%d %s %d`", ErrDivisionByZero, lhs, op, rhs))
+		}
+		if op == "/" {
+			if lhs == math.MinInt64 && rhs == -1 {
+				panic(overflowError(op, lhs, rhs))
+			}
+			return NewConstant(lhs / rhs)
+		}
+		return NewConstant(lhs % rhs)
+	}
+	panic(fmt.Errorf("%w: unknown operator `%v`", ErrInvalidExpression, op))
+}
+
+func overflowError(op string, lhs, rhs int64) error {
+	return fmt.Errorf("%w: `%d %s %d`", ErrOverflow, lhs, op, rhs)
+}
+
+func integerOperand(op string, operand Constant) int64 {
+	value, ok := operand.value.(int64)
+	if !ok {
+		panic(fmt.Errorf("%w: operator `%v` expects integer operands, got `%v`", ErrTypeMismatch, op, operand.String()))
+	}
+	return value
+}
+
+func evaluateFloat(op string, lhs, rhs float64) Constant {
+	switch op {
+	case "+":
+		return NewConstant(lhs + rhs)
+	case "-":
+		return NewConstant(lhs - rhs)
+	case "*":
+		return NewConstant(lhs * rhs)
+	case "/", "%":
+		if rhs == 0 {
+			panic(fmt.Errorf("%w: `%v %s %v`", ErrDivisionByZero, lhs, op, rhs))
+		}
+		if op == "/" {
+			return NewConstant(lhs / rhs)
+		}
+		return NewConstant(math.Mod(lhs, rhs))
+	}
+	panic(fmt.Errorf("%w: unknown operator `%v`", ErrInvalidExpression, op))
+}
+
+func floatOperand(op string, operand Constant) float64 {
+	switch value := operand.value.(type) {
+	case float64:
+		return value
+	case int64:
+		return float64(value)
+	}
+	panic(fmt.Errorf("%w: operator `%v` expects numeric operands, got `%v`", ErrTypeMismatch, op, operand.String()))
 }
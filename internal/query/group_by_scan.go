@@ -0,0 +1,147 @@
+package query
+
+import (
+	"fmt"
+	"slices"
+)
+
+// The Scan class for the groupby operator.
+// The groups are built by hashing the group field values
+// of the underlying records when the scan is positioned
+// before its first record; only the group values and the
+// state of their aggregation functions are kept in memory.
+// Without group fields, the whole input makes up a single
+// group, even when it is empty.
+type GroupByScan struct {
+	scan        Scan
+	groupFields []string
+	aggFns      []AggregationFn
+	groups      []*group
+	current     int
+}
+
+type group struct {
+	values []Constant
+	aggFns []AggregationFn
+}
+
+// Create a groupby scan, given the underlying scan,
+// the group fields and the aggregation functions,
+// which are copied for each group.
+func NewGroupByScan(scan Scan, groupFields []string, aggFns []AggregationFn) *GroupByScan {
+	gs := &GroupByScan{scan: scan, groupFields: groupFields, aggFns: aggFns}
+	gs.BeforeFirst()
+	return gs
+}
+
+// Position the scan before the first group,
+// computing the groups from the underlying scan.
+func (gs *GroupByScan) BeforeFirst() {
+	gs.groups = make([]*group, 0)
+	gs.current = -1
+	if len(gs.groupFields) == 0 {
+		gs.groups = append(gs.groups, gs.newGroup(nil))
+	}
+
+	positions := make(map[string]int)
+	gs.scan.BeforeFirst()
+	for gs.scan.Next() {
+		values := make([]Constant, 0, len(gs.groupFields))
+		for _, fieldName := range gs.groupFields {
+			values = append(values, gs.scan.GetValue(fieldName))
+		}
+		key := groupKey(values)
+		position, ok := positions[key]
+		if !ok {
+			if len(gs.groupFields) > 0 {
+				gs.groups = append(gs.groups, gs.newGroup(values))
+			}
+			position = len(gs.groups) - 1
+			positions[key] = position
+		}
+		for _, fn := range gs.groups[position].aggFns {
+			if ok {
+				fn.ProcessNext(gs.scan)
+			} else {
+				fn.ProcessFirst(gs.scan)
+			}
+		}
+	}
+}
+
+func (gs *GroupByScan) newGroup(values []Constant) *group {
+	aggFns := make([]AggregationFn, 0, len(gs.aggFns))
+	for _, fn := range gs.aggFns {
+		aggFns = append(aggFns, fn.New())
+	}
+	return &group{values, aggFns}
+}
+
+// Returns a key that is the same for equal group values only.
+func groupKey(values []Constant) string {
+	raw := make([]any, 0, len(values))
+	for _, value := range values {
+		raw = append(raw, value.value)
+	}
+	return fmt.Sprintf("%#v", raw)
+}
+
+// Move to the next group.
+func (gs *GroupByScan) Next() bool {
+	if gs.current+1 >= len(gs.groups) {
+		return false
+	}
+	gs.current++
+	return true
+}
+
+func (gs *GroupByScan) GetInt(fieldName string) int64 {
+	value := gs.GetValue(fieldName)
+	return value.AsInt()
+}
+
+func (gs *GroupByScan) GetString(fieldName string) string {
+	value := gs.GetValue(fieldName)
+	return value.AsString()
+}
+
+// Get the Constant value of the specified field.
+// If the field is a group field, then its value can
+// be obtained from the saved group value.
+// Otherwise, the value is obtained from the
+// appropriate aggregation function.
+func (gs *GroupByScan) GetValue(fieldName string) Constant {
+	g := gs.groups[gs.current]
+	if idx := slices.Index(gs.groupFields, fieldName); idx >= 0 {
+		return g.values[idx]
+	}
+	for _, fn := range g.aggFns {
+		if fn.FieldName() == fieldName {
+			return fn.Value()
+		}
+	}
+	panic(NewColumnNotFoundError(fieldName))
+}
+
+func (gs *GroupByScan) IsNull(fieldName string) bool {
+	value := gs.GetValue(fieldName)
+	return value.IsNull()
+}
+
+// Return true if the specified field is either a
+// grouping field or created by an aggregation function.
+func (gs *GroupByScan) HasField(fieldName string) bool {
+	if slices.Contains(gs.groupFields, fieldName) {
+		return true
+	}
+	for _, fn := range gs.aggFns {
+		if fn.FieldName() == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
+func (gs *GroupByScan) Close() {
+	gs.scan.Close()
+}
@@ -0,0 +1,213 @@
+package query
+
+import (
+	"slices"
+)
+
+// A pair of partitions of the hash join inputs, such that
+// the records matching those of the build partition are
+// all in the probe partition.
+// The partitions are opened only when the scan reaches them.
+type HashJoinPartition struct {
+	Build func() Scan
+	Probe func() Scan
+}
+
+// The Scan class for the hash join operator.
+// For each pair of partitions, the records of the build partition
+// are loaded into an in-memory hash table on the build field,
+// which is then probed with each record of the probe partition.
+// A build partition with more records than the hash table holds,
+// such as one where a single value is very frequent, is loaded
+// in chunks, and the probe partition is read once for each of them,
+// as in a block nested loop join.
+type HashJoinScan struct {
+	partitions  []HashJoinPartition
+	buildFields []string
+	probeFields []string
+	buildField  string
+	probeField  string
+	capacity    int64
+	current     int
+	build       Scan
+	pending     bool
+	chunk       int
+	probe       Scan
+	table       map[int64][][]Constant
+	matches     [][]Constant
+	match       int
+}
+
+// Create a hash join scan over the specified pairs of partitions,
+// whose hash table holds up to the specified number of build records.
+// The values of the build records are read in the order
+// of the build fields, which include the build field.
+func NewHashJoinScan(partitions []HashJoinPartition, buildFields []string, buildField string, probeFields []string, probeField string, capacity int64) *HashJoinScan {
+	hjs := &HashJoinScan{
+		partitions:  partitions,
+		buildFields: buildFields,
+		probeFields: probeFields,
+		buildField:  buildField,
+		probeField:  probeField,
+		capacity:    max(capacity, 1),
+		current:     -1,
+	}
+	hjs.BeforeFirst()
+	return hjs
+}
+
+// Position the scan before its first record.
+// When the scan is on the first chunk of the first pair
+// of partitions, its hash table is kept and the probe
+// partition is rewound, so that the scan can be cheaply reread.
+func (hjs *HashJoinScan) BeforeFirst() {
+	hjs.matches = nil
+	hjs.match = 0
+	if hjs.current == 0 && hjs.chunk == 0 {
+		hjs.probe.BeforeFirst()
+		return
+	}
+	hjs.closeProbe()
+	hjs.closeBuild()
+	hjs.current = -1
+}
+
+// Move to the next joined record.
+// The method moves to the next build record matching the
+// current probe record, if possible. Otherwise, it moves
+// to the next probe record having matches. Whenever the
+// current probe partition is exhausted, it loads the next
+// chunk of the build partition and rewinds the probe
+// partition, or loads the next pair of partitions.
+func (hjs *HashJoinScan) Next() bool {
+	hjs.match++
+	for hjs.match >= len(hjs.matches) {
+		if hjs.probe == nil || !hjs.probe.Next() {
+			if hjs.probe != nil && hjs.pending {
+				hjs.nextChunk()
+				hjs.probe.BeforeFirst()
+				continue
+			}
+			if !hjs.nextPartition() {
+				return false
+			}
+			continue
+		}
+		hjs.matches = hjs.findMatches(hjs.probe.GetValue(hjs.probeField))
+		hjs.match = 0
+	}
+	return true
+}
+
+func (hjs *HashJoinScan) GetInt(fieldName string) int64 {
+	value := hjs.GetValue(fieldName)
+	return value.AsInt()
+}
+
+func (hjs *HashJoinScan) GetString(fieldName string) string {
+	value := hjs.GetValue(fieldName)
+	return value.AsString()
+}
+
+// Return the value of the specified field, which is
+// obtained from the matching build record if it is
+// one of the build fields, or from the probe record.
+func (hjs *HashJoinScan) GetValue(fieldName string) Constant {
+	idx := slices.Index(hjs.buildFields, fieldName)
+	if idx >= 0 {
+		return hjs.matches[hjs.match][idx]
+	}
+	return hjs.probe.GetValue(fieldName)
+}
+
+func (hjs *HashJoinScan) IsNull(fieldName string) bool {
+	value := hjs.GetValue(fieldName)
+	return value.IsNull()
+}
+
+// Returns true if the specified field is in
+// either of the underlying inputs.
+func (hjs *HashJoinScan) HasField(fieldName string) bool {
+	return slices.Contains(hjs.buildFields, fieldName) || slices.Contains(hjs.probeFields, fieldName)
+}
+
+func (hjs *HashJoinScan) Close() {
+	hjs.closeProbe()
+	hjs.closeBuild()
+}
+
+// Moves to the next pair of partitions, loads the first
+// chunk of its build partition into the hash table and
+// opens its probe partition.
+// Returns false if there are no more partitions.
+func (hjs *HashJoinScan) nextPartition() bool {
+	hjs.closeProbe()
+	hjs.closeBuild()
+	hjs.current++
+	hjs.matches = nil
+	hjs.match = 0
+	if hjs.current >= len(hjs.partitions) {
+		hjs.current = len(hjs.partitions)
+		return false
+	}
+
+	partition := hjs.partitions[hjs.current]
+	hjs.build = partition.Build()
+	hjs.pending = false
+	hjs.chunk = -1
+	hjs.nextChunk()
+	hjs.probe = partition.Probe()
+	return true
+}
+
+// Loads the next records of the build partition into
+// the hash table, as many as it holds.
+// The build partition stays open, positioned on the
+// record following them, if any.
+func (hjs *HashJoinScan) nextChunk() {
+	hjs.chunk++
+	hjs.table = make(map[int64][][]Constant)
+	count := int64(0)
+	for count < hjs.capacity && (hjs.pending || hjs.build.Next()) {
+		values := make([]Constant, 0, len(hjs.buildFields))
+		for _, fieldName := range hjs.buildFields {
+			values = append(values, hjs.build.GetValue(fieldName))
+		}
+		key := hjs.build.GetValue(hjs.buildField)
+		hash := key.HashCode()
+		hjs.table[hash] = append(hjs.table[hash], values)
+		hjs.pending = false
+		count++
+	}
+	if count == hjs.capacity {
+		hjs.pending = hjs.build.Next()
+	}
+}
+
+// Returns the build records whose build field
+// equals the specified value.
+func (hjs *HashJoinScan) findMatches(value Constant) [][]Constant {
+	keyIndex := slices.Index(hjs.buildFields, hjs.buildField)
+	matches := make([][]Constant, 0)
+	for _, values := range hjs.table[value.HashCode()] {
+		if value.Equals(values[keyIndex]) {
+			matches = append(matches, values)
+		}
+	}
+	return matches
+}
+
+func (hjs *HashJoinScan) closeBuild() {
+	if hjs.build != nil {
+		hjs.build.Close()
+		hjs.build = nil
+	}
+	hjs.pending = false
+}
+
+func (hjs *HashJoinScan) closeProbe() {
+	if hjs.probe != nil {
+		hjs.probe.Close()
+		hjs.probe = nil
+	}
+}
@@ -0,0 +1,60 @@
+package query
+
+import "slices"
+
+// A scan over records held in memory,
+// such as the records of a sort that fit in the
+// buffers the sort is allowed to use.
+type MemoryScan struct {
+	fields  []string
+	records [][]Constant
+	current int
+}
+
+// Create a scan over the specified records,
+// whose values are given in the order of the fields.
+func NewMemoryScan(fields []string, records [][]Constant) *MemoryScan {
+	return &MemoryScan{fields, records, -1}
+}
+
+func (ms *MemoryScan) BeforeFirst() {
+	ms.current = -1
+}
+
+func (ms *MemoryScan) Next() bool {
+	if ms.current+1 >= len(ms.records) {
+		ms.current = len(ms.records)
+		return false
+	}
+	ms.current++
+	return true
+}
+
+func (ms *MemoryScan) GetInt(fieldName string) int64 {
+	value := ms.GetValue(fieldName)
+	return value.AsInt()
+}
+
+func (ms *MemoryScan) GetString(fieldName string) string {
+	value := ms.GetValue(fieldName)
+	return value.AsString()
+}
+
+func (ms *MemoryScan) GetValue(fieldName string) Constant {
+	idx := slices.Index(ms.fields, fieldName)
+	if idx < 0 {
+		panic(NewColumnNotFoundError(fieldName))
+	}
+	return ms.records[ms.current][idx]
+}
+
+func (ms *MemoryScan) IsNull(fieldName string) bool {
+	value := ms.GetValue(fieldName)
+	return value.IsNull()
+}
+
+func (ms *MemoryScan) HasField(fieldName string) bool {
+	return slices.Contains(ms.fields, fieldName)
+}
+
+func (ms *MemoryScan) Close() {}
@@ -0,0 +1,136 @@
+package query
+
+import (
+	"slices"
+)
+
+// The Scan class for the mergejoin operator.
+// Both underlying scans are sorted on their join field.
+// The RHS records sharing the join value of the current
+// LHS record are kept in memory as a group, so that
+// LHS records with a duplicate join value are joined
+// to the same group without rereading the RHS scan.
+type MergeJoinScan struct {
+	left        Scan
+	right       Scan
+	leftField   string
+	rightField  string
+	rightFields []string
+	hasRight    bool
+	groupValue  Constant
+	group       [][]Constant
+	match       int
+}
+
+// Create a mergejoin scan for the two underlying sorted scans.
+// The values of the RHS records are read in the order
+// of the RHS fields, which include the RHS join field.
+func NewMergeJoinScan(left Scan, right Scan, leftField string, rightField string, rightFields []string) *MergeJoinScan {
+	mjs := &MergeJoinScan{
+		left:        left,
+		right:       right,
+		leftField:   leftField,
+		rightField:  rightField,
+		rightFields: rightFields,
+	}
+	mjs.BeforeFirst()
+	return mjs
+}
+
+// Position the scan before the first record,
+// by positioning each underlying scan before
+// their first records.
+func (mjs *MergeJoinScan) BeforeFirst() {
+	mjs.left.BeforeFirst()
+	mjs.right.BeforeFirst()
+	mjs.hasRight = mjs.right.Next()
+	mjs.group = nil
+	mjs.match = 0
+}
+
+// Move to the next record.
+// The method moves to the next RHS record of the current group,
+// if possible. Otherwise, it moves to the next LHS record.
+// If its join value is the same as the group's, the LHS record
+// is joined to the group again. Otherwise, the RHS scan is moved
+// forward until its join value is no longer smaller, and the RHS
+// records with the same join value become the new group.
+// LHS records without matching RHS records are skipped.
+func (mjs *MergeJoinScan) Next() bool {
+	mjs.match++
+	if mjs.match < len(mjs.group) {
+		return true
+	}
+
+	for mjs.left.Next() {
+		value := mjs.left.GetValue(mjs.leftField)
+		mjs.match = 0
+		if len(mjs.group) > 0 && value.Equals(mjs.groupValue) {
+			return true
+		}
+
+		mjs.group = nil
+		for mjs.hasRight {
+			rightValue := mjs.right.GetValue(mjs.rightField)
+			if rightValue.CompareTo(value) >= 0 {
+				break
+			}
+			mjs.hasRight = mjs.right.Next()
+		}
+		for mjs.hasRight {
+			rightValue := mjs.right.GetValue(mjs.rightField)
+			if !rightValue.Equals(value) {
+				break
+			}
+			values := make([]Constant, 0, len(mjs.rightFields))
+			for _, fieldName := range mjs.rightFields {
+				values = append(values, mjs.right.GetValue(fieldName))
+			}
+			mjs.group = append(mjs.group, values)
+			mjs.hasRight = mjs.right.Next()
+		}
+		if len(mjs.group) > 0 {
+			mjs.groupValue = value
+			return true
+		}
+	}
+	return false
+}
+
+func (mjs *MergeJoinScan) GetInt(fieldName string) int64 {
+	value := mjs.GetValue(fieldName)
+	return value.AsInt()
+}
+
+func (mjs *MergeJoinScan) GetString(fieldName string) string {
+	value := mjs.GetValue(fieldName)
+	return value.AsString()
+}
+
+// Return the value of the specified field, which is
+// obtained from the current RHS record of the group
+// if it is one of the RHS fields, or from the LHS scan.
+func (mjs *MergeJoinScan) GetValue(fieldName string) Constant {
+	idx := slices.Index(mjs.rightFields, fieldName)
+	if idx >= 0 {
+		return mjs.group[mjs.match][idx]
+	}
+	return mjs.left.GetValue(fieldName)
+}
+
+func (mjs *MergeJoinScan) IsNull(fieldName string) bool {
+	value := mjs.GetValue(fieldName)
+	return value.IsNull()
+}
+
+// Returns true if the specified field is in
+// either of the underlying scans.
+func (mjs *MergeJoinScan) HasField(fieldName string) bool {
+	return mjs.left.HasField(fieldName) || slices.Contains(mjs.rightFields, fieldName)
+}
+
+// Close the scan by closing the two underlying scans.
+func (mjs *MergeJoinScan) Close() {
+	mjs.left.Close()
+	mjs.right.Close()
+}
@@ -0,0 +1,162 @@
+package query
+
+import (
+	"slices"
+)
+
+// The Scan class for the outer join operators.
+// The scan loops through the RHS records for each LHS record,
+// like a product, and returns the pairs satisfying the join
+// predicate. The records of a preserved side that match no
+// record of the other side are returned once, with nulls
+// in place of the fields of the other side: an LHS record
+// right after its (lack of) matches, and the RHS records
+// once all the LHS records have been read.
+type OuterJoinScan struct {
+	left          Scan
+	right         Scan
+	predicate     *Predicate
+	leftFields    []string
+	keepLeft      bool
+	keepRight     bool
+	nextLeft      bool
+	leftMatched   bool
+	rightPosition int
+	rightMatched  []bool
+	unmatchedPass bool
+	nullLeft      bool
+	nullRight     bool
+}
+
+// Create an outer join scan of the two underlying scans,
+// joined on the specified predicate.
+// The LHS records without a match are kept if keepLeft is true,
+// and the RHS records without a match if keepRight is true.
+func NewOuterJoinScan(left Scan, right Scan, predicate *Predicate, leftFields []string, keepLeft bool, keepRight bool) *OuterJoinScan {
+	ojs := &OuterJoinScan{
+		left:       left,
+		right:      right,
+		predicate:  predicate,
+		leftFields: leftFields,
+		keepLeft:   keepLeft,
+		keepRight:  keepRight,
+	}
+	ojs.BeforeFirst()
+	return ojs
+}
+
+// Position the scan before its first record.
+func (ojs *OuterJoinScan) BeforeFirst() {
+	ojs.left.BeforeFirst()
+	ojs.nextLeft = true
+	ojs.rightMatched = nil
+	ojs.unmatchedPass = false
+	ojs.nullLeft = false
+	ojs.nullRight = false
+}
+
+// Move the scan to the next record.
+// The method moves to the next RHS record matching the current
+// LHS record, if possible. Otherwise, it returns the LHS record
+// padded with nulls if it is unmatched and preserved, and
+// moves to the next LHS record and the first RHS record.
+// Once there are no more LHS records, the method moves through
+// the unmatched RHS records if they are preserved.
+func (ojs *OuterJoinScan) Next() bool {
+	if ojs.unmatchedPass {
+		return ojs.nextUnmatchedRight()
+	}
+	ojs.nullLeft = false
+	ojs.nullRight = false
+	for {
+		if ojs.nextLeft {
+			if !ojs.left.Next() {
+				if !ojs.keepRight {
+					return false
+				}
+				ojs.unmatchedPass = true
+				ojs.right.BeforeFirst()
+				ojs.rightPosition = -1
+				return ojs.nextUnmatchedRight()
+			}
+			ojs.nextLeft = false
+			ojs.leftMatched = false
+			ojs.right.BeforeFirst()
+			ojs.rightPosition = -1
+		}
+
+		for ojs.right.Next() {
+			ojs.rightPosition++
+			if ojs.rightPosition >= len(ojs.rightMatched) {
+				ojs.rightMatched = append(ojs.rightMatched, false)
+			}
+			if ojs.predicate.IsSatisfied(ojs) {
+				ojs.leftMatched = true
+				ojs.rightMatched[ojs.rightPosition] = true
+				return true
+			}
+		}
+
+		ojs.nextLeft = true
+		if ojs.keepLeft && !ojs.leftMatched {
+			ojs.nullRight = true
+			return true
+		}
+	}
+}
+
+func (ojs *OuterJoinScan) GetInt(fieldName string) int64 {
+	value := ojs.GetValue(fieldName)
+	return value.AsInt()
+}
+
+func (ojs *OuterJoinScan) GetString(fieldName string) string {
+	value := ojs.GetValue(fieldName)
+	return value.AsString()
+}
+
+// Return the value of the specified field.
+// The value is obtained from whichever scan contains
+// the field, or is null when that side is unmatched.
+func (ojs *OuterJoinScan) GetValue(fieldName string) Constant {
+	if slices.Contains(ojs.leftFields, fieldName) {
+		if ojs.nullLeft {
+			return NewNullConstant()
+		}
+		return ojs.left.GetValue(fieldName)
+	}
+	if ojs.nullRight {
+		return NewNullConstant()
+	}
+	return ojs.right.GetValue(fieldName)
+}
+
+func (ojs *OuterJoinScan) IsNull(fieldName string) bool {
+	value := ojs.GetValue(fieldName)
+	return value.IsNull()
+}
+
+// Returns true if the specified field is in
+// either of the underlying scans.
+func (ojs *OuterJoinScan) HasField(fieldName string) bool {
+	return slices.Contains(ojs.leftFields, fieldName) || ojs.right.HasField(fieldName)
+}
+
+func (ojs *OuterJoinScan) Close() {
+	ojs.left.Close()
+	ojs.right.Close()
+}
+
+// Moves to the next RHS record that matched no LHS record,
+// with nulls in place of the LHS fields.
+func (ojs *OuterJoinScan) nextUnmatchedRight() bool {
+	ojs.nullLeft = true
+	ojs.nullRight = false
+	for ojs.right.Next() {
+		ojs.rightPosition++
+		if ojs.rightPosition >= len(ojs.rightMatched) || !ojs.rightMatched[ojs.rightPosition] {
+			return true
+		}
+	}
+	return false
+}
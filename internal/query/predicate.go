@@ -11,42 +11,239 @@ func NewPredicate(condition parser.Condition) *Predicate {
 	return &Predicate{condition}
 }
 
+// Returns true if the predicate evaluates to true
+// with respect to the current record of the specified scan.
+// A predicate that evaluates to unknown is not satisfied.
 func (pred *Predicate) IsSatisfied(scan Scan) bool {
-	if pred.Condition == (parser.Condition{}) {
+	if pred.Condition.IsEmpty() {
 		return true
 	}
+	return EvaluateCondition(scan, pred.Condition) == TRUE
+}
 
-	left := EvaluateTerm(scan, pred.Condition.Left)
-	if pred.Condition.Right == (parser.Term{}) {
-		return left
-	}
+// The truth values of a condition, which follow a three-valued
+// logic: a comparison with null is neither true nor false,
+// but unknown.
+type Truth int
+
+const (
+	FALSE Truth = iota
+	UNKNOWN
+	TRUE
+)
 
-	right := EvaluateTerm(scan, pred.Condition.Right)
+func truthOf(value bool) Truth {
+	if value {
+		return TRUE
+	}
+	return FALSE
+}
 
-	switch pred.Condition.Op {
+// Evaluates the condition tree against the current record of the scan.
+// The children of a conjunction or a disjunction are evaluated
+// from left to right and stop as soon as the result is known.
+// A conjunction is false if any child is false, and otherwise
+// unknown if any child is unknown; a disjunction is true if
+// any child is true, and otherwise unknown if any child is
+// unknown; the negation of unknown is unknown.
+func EvaluateCondition(scan Scan, condition parser.Condition) Truth {
+	switch condition.Op {
 	case "and":
-		return left && right
+		result := TRUE
+		for _, child := range condition.Children {
+			result = min(result, EvaluateCondition(scan, child))
+			if result == FALSE {
+				return FALSE
+			}
+		}
+		return result
 	case "or":
-		return left || right
+		result := FALSE
+		for _, child := range condition.Children {
+			result = max(result, EvaluateCondition(scan, child))
+			if result == TRUE {
+				return TRUE
+			}
+		}
+		return result
+	case "not":
+		return TRUE - EvaluateCondition(scan, condition.Children[0])
 	}
-	return false
+	return EvaluateTerm(scan, condition.Term)
 }
 
-func EvaluateTerm(scan Scan, term parser.Term) bool {
+// Evaluates the term against the current record of the scan.
+// A comparison with null is unknown, while a null test
+// is always true or false.
+func EvaluateTerm(scan Scan, term parser.Term) Truth {
 	left := EvaluateExpr(scan, term.Left)
+	switch term.Op {
+	case "is null":
+		return truthOf(left.IsNull())
+	case "is not null":
+		return truthOf(!left.IsNull())
+	}
 	right := EvaluateExpr(scan, term.Right)
+	if left.IsNull() || right.IsNull() {
+		return UNKNOWN
+	}
 	switch term.Op {
 	case "=":
-		return left.Equals(right)
+		return truthOf(left.Equals(right))
 	case "!=":
-		return !left.Equals(right)
+		return truthOf(!left.Equals(right))
+	case "<":
+		return truthOf(left.CompareTo(right) < 0)
+	case "<=":
+		return truthOf(left.CompareTo(right) <= 0)
+	case ">":
+		return truthOf(left.CompareTo(right) > 0)
+	case ">=":
+		return truthOf(left.CompareTo(right) >= 0)
+	}
+	return FALSE
+}
+
+// Determines if there is a term of the form "F=c"
+// where F is the specified field and c is some constant.
+// If so, the method returns that constant and true.
+func (pred *Predicate) EquatesWithConstant(fieldName string) (Constant, bool) {
+	for _, conjunct := range pred.conjuncts() {
+		term := conjunct.Term
+		if !conjunct.IsTerm() || term.Op != "=" {
+			continue
+		}
+		if term.Left.IsFieldName() && term.Left.AsFieldExpr() == fieldName && isValueLiteral(term.Right) {
+			return NewConstant(term.Right.AsLiteralExpr().Value), true
+		}
+		if term.Right.IsFieldName() && term.Right.AsFieldExpr() == fieldName && isValueLiteral(term.Left) {
+			return NewConstant(term.Left.AsLiteralExpr().Value), true
+		}
+	}
+	return Constant{}, false
+}
+
+// Returns true if the expression is a literal other than null,
+// which no field value is equal to.
+func isValueLiteral(expr parser.Expr) bool {
+	if !expr.IsLiteral() {
+		return false
+	}
+	literal := expr.AsLiteralExpr()
+	return !literal.IsNull()
+}
+
+// Determines if there is a term of the form "F1=F2"
+// where F1 is the specified field and F2 is another field.
+// If so, the method returns the name of that field and true.
+func (pred *Predicate) EquatesWithField(fieldName string) (string, bool) {
+	for _, conjunct := range pred.conjuncts() {
+		term := conjunct.Term
+		if !conjunct.IsTerm() || term.Op != "=" || !term.Left.IsFieldName() || !term.Right.IsFieldName() {
+			continue
+		}
+		if term.Left.AsFieldExpr() == fieldName && term.Right.AsFieldExpr() != fieldName {
+			return term.Right.AsFieldExpr(), true
+		}
+		if term.Right.AsFieldExpr() == fieldName && term.Left.AsFieldExpr() != fieldName {
+			return term.Left.AsFieldExpr(), true
+		}
+	}
+	return "", false
+}
+
+// Returns the sub-conditions that must all hold for the
+// predicate to be satisfied. Nested conjunctions are flattened,
+// while a disjunction or a negation is kept as a single conjunct
+// since its terms cannot be checked on their own.
+func (pred *Predicate) conjuncts() []parser.Condition {
+	if pred.Condition.IsEmpty() {
+		return nil
 	}
-	return false
+	return flattenConjuncts(pred.Condition, nil)
 }
 
-func EvaluateExpr(scan Scan, expr parser.Expr) Constant {
-	if expr.IsFieldName() {
-		return scan.GetValue(expr.AsFieldExpr())
+func flattenConjuncts(condition parser.Condition, conjuncts []parser.Condition) []parser.Condition {
+	if condition.Op != "and" {
+		return append(conjuncts, condition)
 	}
-	return NewConstant(expr.AsLiteralExpr().Value)
+	for _, child := range condition.Children {
+		conjuncts = flattenConjuncts(child, conjuncts)
+	}
+	return conjuncts
+}
+
+// A set of field names a predicate can be restricted to,
+// such as the schema of a table or of a query.
+type FieldSet interface {
+	HasField(fieldName string) bool
+}
+
+// Returns the sub-predicate that applies to the specified fields,
+// or nil if no part of the predicate does.
+func (pred *Predicate) SelectSubPred(fields FieldSet) *Predicate {
+	return pred.subPred(func(condition parser.Condition) bool {
+		return conditionAppliesTo(condition, fields)
+	})
+}
+
+// Returns the sub-predicate consisting of terms that apply
+// to the union of the two specified sets of fields,
+// but not to either set individually.
+// Returns nil if there is no such part of the predicate.
+func (pred *Predicate) JoinSubPred(fields1, fields2 FieldSet) *Predicate {
+	union := unionFieldSet{fields1, fields2}
+	return pred.subPred(func(condition parser.Condition) bool {
+		return !conditionAppliesTo(condition, fields1) &&
+			!conditionAppliesTo(condition, fields2) &&
+			conditionAppliesTo(condition, union)
+	})
+}
+
+// Returns the conjunction of the conjuncts accepted by
+// the specified function, or nil if none is.
+func (pred *Predicate) subPred(accept func(condition parser.Condition) bool) *Predicate {
+	conditions := make([]parser.Condition, 0)
+	for _, conjunct := range pred.conjuncts() {
+		if accept(conjunct) {
+			conditions = append(conditions, conjunct)
+		}
+	}
+	switch len(conditions) {
+	case 0:
+		return nil
+	case 1:
+		return NewPredicate(conditions[0])
+	}
+	return NewPredicate(parser.Condition{Op: "and", Children: conditions})
+}
+
+// Returns true if every field mentioned in the condition
+// belongs to the specified fields.
+func conditionAppliesTo(condition parser.Condition, fields FieldSet) bool {
+	if condition.Op != "" {
+		for _, child := range condition.Children {
+			if !conditionAppliesTo(child, fields) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, expr := range []parser.Expr{condition.Term.Left, condition.Term.Right} {
+		for _, fieldName := range expr.FieldNames() {
+			if !fields.HasField(fieldName) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+type unionFieldSet struct {
+	fields1 FieldSet
+	fields2 FieldSet
+}
+
+func (u unionFieldSet) HasField(fieldName string) bool {
+	return u.fields1.HasField(fieldName) || u.fields2.HasField(fieldName)
 }
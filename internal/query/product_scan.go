@@ -1,13 +1,14 @@
 package query
 
 type ProductScan struct {
-	left  Scan
-	right Scan
+	left    Scan
+	right   Scan
+	hasLeft bool
 }
 
 // Create a product scan having the two underlying scans.
 func NewProductScan(left Scan, right Scan) *ProductScan {
-	scan := &ProductScan{left, right}
+	scan := &ProductScan{left, right, false}
 	scan.BeforeFirst()
 	return scan
 }
@@ -18,7 +19,7 @@ func NewProductScan(left Scan, right Scan) *ProductScan {
 // is positioned before its first record.
 func (ps *ProductScan) BeforeFirst() {
 	ps.left.BeforeFirst()
-	ps.left.Next()
+	ps.hasLeft = ps.left.Next()
 	ps.right.BeforeFirst()
 }
 
@@ -28,11 +29,15 @@ func (ps *ProductScan) BeforeFirst() {
 // first RHS record.
 // If there are no more LHS records, the method returns false.
 func (ps *ProductScan) Next() bool {
-	if ps.left.Next() {
+	if !ps.hasLeft {
+		return false
+	}
+	if ps.right.Next() {
 		return true
 	} else {
 		ps.right.BeforeFirst()
-		return ps.right.Next() && ps.left.Next()
+		ps.hasLeft = ps.right.Next() && ps.left.Next()
+		return ps.hasLeft
 	}
 }
 
@@ -66,6 +71,11 @@ func (ps *ProductScan) GetValue(fieldName string) Constant {
 	return ps.right.GetValue(fieldName)
 }
 
+func (ps *ProductScan) IsNull(fieldName string) bool {
+	value := ps.GetValue(fieldName)
+	return value.IsNull()
+}
+
 // Returns true if the specified field is in
 // either of the underlying scans.
 func (ps *ProductScan) HasField(fieldName string) bool {
@@ -1,23 +1,22 @@
 package query
 
-import (
-	"fmt"
-	"slices"
-)
+import "slices"
 
 // The scan class corresponding to the <i>project</i> relational
 // algebra operator.
 // All methods except hasField delegate their work to the
-// underlying scan.
+// underlying scan, except for the computed fields whose
+// values are evaluated from the current underlying record.
 type ProjectScan struct {
-	scan   Scan
-	fields []string
+	scan     Scan
+	fields   []string
+	computed map[string]*Expression
 }
 
 // Create a project scan having the specified
-// underlying scan and field list.
-func NewProjectScan(scan Scan, fields []string) *ProjectScan {
-	return &ProjectScan{scan, fields}
+// underlying scan, field list and computed fields.
+func NewProjectScan(scan Scan, fields []string, computed map[string]*Expression) *ProjectScan {
+	return &ProjectScan{scan, fields, computed}
 }
 
 func (ps *ProjectScan) BeforeFirst() {
@@ -29,24 +28,40 @@ func (ps *ProjectScan) Next() bool {
 }
 
 func (ps *ProjectScan) GetInt(fieldName string) int64 {
+	if expr, ok := ps.computed[fieldName]; ok {
+		value := expr.Evaluate(ps.scan)
+		return value.AsInt()
+	}
 	if ps.HasField(fieldName) {
 		return ps.scan.GetInt(fieldName)
 	}
-	panic(fmt.Sprintf("field `%v` not found.", fieldName))
+	panic(NewColumnNotFoundError(fieldName))
 }
 
 func (ps *ProjectScan) GetString(fieldName string) string {
+	if expr, ok := ps.computed[fieldName]; ok {
+		value := expr.Evaluate(ps.scan)
+		return value.AsString()
+	}
 	if ps.HasField(fieldName) {
 		return ps.scan.GetString(fieldName)
 	}
-	panic(fmt.Sprintf("field `%v` not found.", fieldName))
+	panic(NewColumnNotFoundError(fieldName))
 }
 
 func (ps *ProjectScan) GetValue(fieldName string) Constant {
+	if expr, ok := ps.computed[fieldName]; ok {
+		return expr.Evaluate(ps.scan)
+	}
 	if ps.HasField(fieldName) {
 		return ps.scan.GetValue(fieldName)
 	}
-	panic(fmt.Sprintf("field `%v` not found.", fieldName))
+	panic(NewColumnNotFoundError(fieldName))
+}
+
+func (ps *ProjectScan) IsNull(fieldName string) bool {
+	value := ps.GetValue(fieldName)
+	return value.IsNull()
 }
 
 func (ps *ProjectScan) HasField(fieldName string) bool {
@@ -0,0 +1,51 @@
+package query
+
+// A comparator for the records of scans,
+// which orders them on a list of sort keys.
+// Each sort key is an expression over the fields of the
+// records, sorted in ascending or descending order.
+type RecordComparator struct {
+	exprs []*Expression
+	desc  []bool
+}
+
+// Create a comparator using the specified sort keys,
+// in order of significance.
+func NewRecordComparator(exprs []*Expression, desc []bool) *RecordComparator {
+	return &RecordComparator{exprs, desc}
+}
+
+// Return the values of the sort keys
+// for the current record of the specified scan.
+func (rc *RecordComparator) Keys(scan Scan) []Constant {
+	keys := make([]Constant, 0, len(rc.exprs))
+	for _, expr := range rc.exprs {
+		keys = append(keys, expr.Evaluate(scan))
+	}
+	return keys
+}
+
+// Compare the values of the sort keys of two records.
+// The keys are considered in order, and the first
+// key that differs determines the result.
+// Returns a negative number, zero or a positive number when
+// the first record respectively comes before, at the same
+// position or after the second one.
+func (rc *RecordComparator) CompareKeys(keys1, keys2 []Constant) int {
+	for i := range keys1 {
+		result := keys1[i].CompareTo(keys2[i])
+		if result == 0 {
+			continue
+		}
+		if rc.desc[i] {
+			return -result
+		}
+		return result
+	}
+	return 0
+}
+
+// Compare the current records of the two specified scans.
+func (rc *RecordComparator) Compare(scan1, scan2 Scan) int {
+	return rc.CompareKeys(rc.Keys(scan1), rc.Keys(scan2))
+}
@@ -1,5 +1,20 @@
 package query
 
+import (
+	"errors"
+	"fmt"
+)
+
+// The error of a reference to a field
+// that a scan or a table does not have.
+var ErrColumnNotFound = errors.New("column not found")
+
+// Return the error of a reference to the specified
+// field, which a scan or a table does not have.
+func NewColumnNotFoundError(fieldName string) error {
+	return fmt.Errorf("%w: `%v`", ErrColumnNotFound, fieldName)
+}
+
 // The interface will be implemented by each query scan.
 // There is a Scan class for each relational
 // algebra operator.
@@ -23,6 +38,10 @@ type Scan interface {
 	// The value is expressed as a Constant.
 	GetValue(fieldName string) Constant
 
+	// Return true if the value of the specified field
+	// in the current record is null.
+	IsNull(fieldName string) bool
+
 	// Return true if the scan has the specified field.
 	HasField(fieldName string) bool
 
@@ -41,6 +41,10 @@ func (ss *SelectScan) GetValue(fieldName string) Constant {
 	return ss.scan.GetValue(fieldName)
 }
 
+func (ss *SelectScan) IsNull(fieldName string) bool {
+	return ss.scan.IsNull(fieldName)
+}
+
 func (ss *SelectScan) HasField(fieldName string) bool {
 	return ss.scan.HasField(fieldName)
 }
@@ -66,6 +70,11 @@ func (ss *SelectScan) SetValue(fieldName string, value Constant) {
 	us.SetValue(fieldName, value)
 }
 
+func (ss *SelectScan) SetNull(fieldName string) {
+	us := any(ss.scan).(UpdateScan)
+	us.SetNull(fieldName)
+}
+
 func (ss *SelectScan) Delete() {
 	us := any(ss.scan).(UpdateScan)
 	us.Delete()
@@ -0,0 +1,84 @@
+package query
+
+// The Scan class for the sort operator.
+// The scan merges sorted runs, each of them read through
+// its own scan, by moving at each step to the run whose
+// current record comes first.
+// Records that compare equal are returned in the order
+// of their runs.
+type SortScan struct {
+	runs       []Scan
+	hasMore    []bool
+	current    int
+	comparator *RecordComparator
+}
+
+// Create a sort scan merging the specified runs,
+// which are sorted according to the comparator.
+func NewSortScan(runs []Scan, comparator *RecordComparator) *SortScan {
+	ss := &SortScan{
+		runs:       runs,
+		hasMore:    make([]bool, len(runs)),
+		comparator: comparator,
+	}
+	ss.BeforeFirst()
+	return ss
+}
+
+// Position the scan before the first record in sorted order.
+// Internally, it moves to the first record of each run.
+func (ss *SortScan) BeforeFirst() {
+	ss.current = -1
+	for i, run := range ss.runs {
+		run.BeforeFirst()
+		ss.hasMore[i] = run.Next()
+	}
+}
+
+// Move to the next record in sorted order.
+// First, the current run is moved to its next record.
+// Then the run whose record comes first becomes current.
+func (ss *SortScan) Next() bool {
+	if ss.current >= 0 {
+		ss.hasMore[ss.current] = ss.runs[ss.current].Next()
+	}
+
+	ss.current = -1
+	for i, run := range ss.runs {
+		if !ss.hasMore[i] {
+			continue
+		}
+		if ss.current < 0 || ss.comparator.Compare(run, ss.runs[ss.current]) < 0 {
+			ss.current = i
+		}
+	}
+	return ss.current >= 0
+}
+
+func (ss *SortScan) GetInt(fieldName string) int64 {
+	return ss.runs[ss.current].GetInt(fieldName)
+}
+
+func (ss *SortScan) GetString(fieldName string) string {
+	return ss.runs[ss.current].GetString(fieldName)
+}
+
+func (ss *SortScan) GetValue(fieldName string) Constant {
+	return ss.runs[ss.current].GetValue(fieldName)
+}
+
+func (ss *SortScan) IsNull(fieldName string) bool {
+	value := ss.GetValue(fieldName)
+	return value.IsNull()
+}
+
+func (ss *SortScan) HasField(fieldName string) bool {
+	return ss.runs[0].HasField(fieldName)
+}
+
+// Close the scan by closing all of its runs.
+func (ss *SortScan) Close() {
+	for _, run := range ss.runs {
+		run.Close()
+	}
+}
@@ -13,6 +13,9 @@ type UpdateScan interface {
 	// Modify the field value of the current record.
 	SetString(fldName string, value string)
 
+	// Set the field of the current record to null.
+	SetNull(fldName string)
+
 	// Insert a new record somewhere in the scan.
 	Insert()
 
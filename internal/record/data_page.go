@@ -0,0 +1,46 @@
+package record
+
+import (
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The records of a block of a table, which a table scan
+// accesses by slot, whatever the format of the block.
+type DataPage interface {
+	GetInt(slot int64, fldName string) (int64, error)
+	GetString(slot int64, fldName string) (string, error)
+	GetValue(slot int64, fldName string) (query.Constant, error)
+	IsNull(slot int64, fldName string) (bool, error)
+	SetInt(slot int64, fldName string, value int64) error
+	SetString(slot int64, fldName string, value string) error
+	SetValue(slot int64, fldName string, value query.Constant) error
+	SetNull(slot int64, fldName string) error
+
+	// Delete the record of the specified slot.
+	Delete(slot int64)
+
+	// Format a new block, which holds no record.
+	Format() error
+
+	// Return the first used slot after the specified one,
+	// or -1 if there is none.
+	NextAfter(slot int64) int64
+
+	// Insert a new record, whose fields are all null,
+	// in a slot after the specified one.
+	// Return the slot, or -1 if the block is full.
+	InsertAfter(slot int64) int64
+
+	BlockId() file.BlockId
+}
+
+// Return the page of the specified block,
+// in the format of the specified layout.
+func NewDataPage(tx *recovery.Transaction, blockId file.BlockId, layout *Layout) DataPage {
+	if layout.Format() == SLOTTED_FORMAT {
+		return NewSlottedPage(tx, blockId, layout)
+	}
+	return NewRecordPage(tx, blockId, layout)
+}
@@ -0,0 +1,115 @@
+package record
+
+import (
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// Read the value of a field of the specified type
+// stored at the specified offset of a block.
+// Doubles are stored as such, strings and blobs with their
+// length, and the other types as 8-byte integers: booleans
+// as 0 or 1, dates as days and timestamps as microseconds
+// since the epoch.
+func ReadValue(tx *recovery.Transaction, blockId file.BlockId, offset int64, fldType int64) (query.Constant, error) {
+	switch fldType {
+	case STRING_TYPE:
+		value, err := tx.GetString(blockId, offset)
+		return query.NewConstant(value), err
+	case BLOB_TYPE:
+		value, err := tx.GetString(blockId, offset)
+		return query.NewConstant(parser.Blob(value)), err
+	case DOUBLE_TYPE:
+		value, err := tx.GetFloat(blockId, offset)
+		return query.NewConstant(value), err
+	}
+	value, err := tx.GetInt(blockId, offset)
+	switch fldType {
+	case BOOLEAN_TYPE:
+		return query.NewConstant(value != 0), err
+	case DATE_TYPE:
+		return query.NewConstant(parser.Date(value)), err
+	case TIMESTAMP_TYPE:
+		return query.NewConstant(parser.Timestamp(value)), err
+	}
+	return query.NewConstant(value), err
+}
+
+// Write the value of a field of the specified type
+// at the specified offset of a block, as ReadValue
+// expects to find it.
+// The value is converted to the type of the field first.
+func WriteValue(tx *recovery.Transaction, blockId file.BlockId, offset int64, fldType int64, value query.Constant, okToLog bool) error {
+	value = CoerceValue(fldType, value)
+	switch fldType {
+	case STRING_TYPE:
+		return tx.SetString(blockId, offset, value.AsString(), okToLog)
+	case BLOB_TYPE:
+		return tx.SetString(blockId, offset, string(value.AsBlob()), okToLog)
+	case DOUBLE_TYPE:
+		return tx.SetFloat(blockId, offset, value.AsFloat(), okToLog)
+	case BOOLEAN_TYPE:
+		flag := int64(0)
+		if value.AsBool() {
+			flag = 1
+		}
+		return tx.SetInt(blockId, offset, flag, okToLog)
+	case DATE_TYPE:
+		return tx.SetInt(blockId, offset, int64(value.AsDate()), okToLog)
+	case TIMESTAMP_TYPE:
+		return tx.SetInt(blockId, offset, int64(value.AsTimestamp()), okToLog)
+	}
+	return tx.SetInt(blockId, offset, value.AsInt(), okToLog)
+}
+
+// Return the value converted to the specified field type.
+// An integer converts to a double, a date to a timestamp,
+// and a string to a date or a timestamp if it has the format
+// of one, or to a blob.
+// Any other conversion panics with a type mismatch.
+func CoerceValue(fldType int64, value query.Constant) query.Constant {
+	if value.IsNull() {
+		return value
+	}
+	switch fldType {
+	case INTEGER_TYPE:
+		return query.NewConstant(value.AsInt())
+	case STRING_TYPE:
+		return query.NewConstant(value.AsString())
+	case BOOLEAN_TYPE:
+		return query.NewConstant(value.AsBool())
+	case DOUBLE_TYPE:
+		return query.NewConstant(value.AsFloat())
+	case DATE_TYPE:
+		return query.NewConstant(value.AsDate())
+	case TIMESTAMP_TYPE:
+		return query.NewConstant(value.AsTimestamp())
+	case BLOB_TYPE:
+		return query.NewConstant(value.AsBlob())
+	}
+	panic(fmt.Errorf("%w: unknown field type %v", query.ErrTypeMismatch, fldType))
+}
+
+// Return the zero value of the specified field type,
+// which new blocks are formatted with.
+func ZeroValue(fldType int64) query.Constant {
+	switch fldType {
+	case STRING_TYPE:
+		return query.NewConstant("")
+	case BOOLEAN_TYPE:
+		return query.NewConstant(false)
+	case DOUBLE_TYPE:
+		return query.NewConstant(float64(0))
+	case DATE_TYPE:
+		return query.NewConstant(parser.Date(0))
+	case TIMESTAMP_TYPE:
+		return query.NewConstant(parser.Timestamp(0))
+	case BLOB_TYPE:
+		return query.NewConstant(parser.Blob(""))
+	}
+	return query.NewConstant(int64(0))
+}
@@ -2,13 +2,36 @@ package record
 
 import "github.com/evanxg852000/simpledb/internal/file"
 
+// The formats of the blocks of a table.
+// A fixed format block is an array of slots of the
+// same size, each able to hold the longest possible record.
+// A slotted block has a directory of the records it holds,
+// which only take the space their values need.
+const (
+	FIXED_FORMAT = iota
+	SLOTTED_FORMAT
+)
+
 // Description of the structure of a record.
 // It contains the name, type, length and offset of
 // each field of the table.
+// In the fixed format, a record starts with its
+// empty/in-use flag, followed by a null bitmap having
+// one bit for each field, in the order of the schema,
+// stored in as many integers as needed.
+// A field whose values may be out of line starts with the
+// reference to its out of line value, or 0, followed by
+// the room of a value stored in the record.
+// In the slotted format, a record starts with its null
+// bitmap, and each field takes 8 bytes, a string or blob
+// field holding the position of its value in the block,
+// or the reference to its out of line value.
 type Layout struct {
 	Schema   *Schema
 	offsets  map[string]int64
+	nullBits map[string]int64
 	slotSize int64
+	format   int64
 }
 
 // This constructor creates a Layout object from a schema.
@@ -17,6 +40,7 @@ type Layout struct {
 // each field within the record.
 func NewLayout(schema *Schema) *Layout {
 	pos := int64(8) // // leave space for the empty/in-use flag
+	pos += nullBitmapSize(schema)
 	offsets := map[string]int64{}
 	for _, fldName := range schema.Fields() {
 		offsets[fldName] = pos
@@ -25,18 +49,41 @@ func NewLayout(schema *Schema) *Layout {
 	return &Layout{
 		Schema:   schema,
 		offsets:  offsets,
+		nullBits: nullBits(schema),
 		slotSize: pos,
+		format:   FIXED_FORMAT,
+	}
+}
+
+// Create a Layout object for the records of a table
+// in the slotted format. The slot size is the size of the
+// fixed part of a record, which excludes its string values.
+func NewSlottedLayout(schema *Schema) *Layout {
+	pos := nullBitmapSize(schema)
+	offsets := map[string]int64{}
+	for _, fldName := range schema.Fields() {
+		offsets[fldName] = pos
+		pos += 8
+	}
+	return &Layout{
+		Schema:   schema,
+		offsets:  offsets,
+		nullBits: nullBits(schema),
+		slotSize: pos,
+		format:   SLOTTED_FORMAT,
 	}
 }
 
 // Create a Layout object from the specified metadata.
 // This constructor is used when the metadata
 // is retrieved from the catalog.
-func NewLayoutFromMetadata(schema *Schema, offsets map[string]int64, slotSize int64) *Layout {
+func NewLayoutFromMetadata(schema *Schema, offsets map[string]int64, slotSize int64, format int64) *Layout {
 	return &Layout{
 		Schema:   schema,
 		offsets:  offsets,
+		nullBits: nullBits(schema),
 		slotSize: slotSize,
+		format:   format,
 	}
 }
 
@@ -45,16 +92,85 @@ func (layout *Layout) Offset(fldName string) int64 {
 	return layout.offsets[fldName]
 }
 
+// Return the offset within a record of the integer of the
+// null bitmap holding the bit of the specified field,
+// along with the mask of that bit.
+func (layout *Layout) NullBit(fldName string) (int64, int64) {
+	bit := layout.nullBits[fldName]
+	bitmapOffset := int64(8) // after the empty/in-use flag
+	if layout.format == SLOTTED_FORMAT {
+		bitmapOffset = 0
+	}
+	return bitmapOffset + 8*(bit/64), int64(1) << (bit % 64)
+}
+
+// Return the number of integers of the null bitmap.
+func (layout *Layout) NullBitmapLength() int64 {
+	return nullBitmapSize(layout.Schema) / 8
+}
+
 // Return the size of a slot, in bytes.
 func (layout *Layout) SlotSize() int64 {
 	return layout.slotSize
 }
 
+// Return the format of the blocks of the table.
+func (layout *Layout) Format() int64 {
+	return layout.format
+}
+
+// Return the size of the largest record, in bytes.
+// In the slotted format, it includes the longest
+// value of each string or blob field stored in the record.
+func (layout *Layout) MaxRecordSize() int64 {
+	if layout.format != SLOTTED_FORMAT {
+		return layout.slotSize
+	}
+	size := layout.slotSize
+	for _, fldName := range layout.Schema.Fields() {
+		if layout.Schema.MayBeOutOfLine(fldName) {
+			size += file.GetEncodingLength(MAX_INLINE_LENGTH)
+		} else if layout.Schema.FieldType(fldName) == STRING_TYPE {
+			size += lengthInBytes(layout.Schema, fldName)
+		}
+	}
+	return size
+}
+
+func nullBitmapSize(schema *Schema) int64 {
+	return 8 * ((int64(len(schema.Fields())) + 63) / 64)
+}
+
+func nullBits(schema *Schema) map[string]int64 {
+	bits := map[string]int64{}
+	for i, fldName := range schema.Fields() {
+		bits[fldName] = int64(i)
+	}
+	return bits
+}
+
 func lengthInBytes(schema *Schema, fldName string) int64 {
-	fldType := schema.FieldType(fldName)
-	if fldType == INTEGER_TYPE {
-		return 8
+	if schema.MayBeOutOfLine(fldName) {
+		return 8 + file.GetEncodingLength(MAX_INLINE_LENGTH)
+	}
+	if schema.FieldType(fldName) == STRING_TYPE {
+		return file.GetEncodingLength(schema.FieldLength(fldName))
+	}
+	// The other types are stored in 8 bytes.
+	return 8
+}
+
+// Return a layout of the same records, whose fields are
+// qualified with the specified name, as in "name.field".
+// A table is read through such a layout when the query
+// refers to it by an alias.
+func (layout *Layout) Qualified(name string) *Layout {
+	schema := NewSchema()
+	offsets := map[string]int64{}
+	for _, fldName := range layout.Schema.Fields() {
+		qualifiedName := name + "." + fldName
+		schema.AddField(qualifiedName, layout.Schema.FieldType(fldName), layout.Schema.FieldLength(fldName))
+		offsets[qualifiedName] = layout.offsets[fldName]
 	}
-	// STRING_TYPE
-	return file.GetEncodingLength(schema.FieldLength(fldName))
+	return NewLayoutFromMetadata(schema, offsets, layout.slotSize, layout.format)
 }
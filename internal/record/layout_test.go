@@ -16,9 +16,25 @@ func TestLayout(t *testing.T) {
 	schema.AddIntField("C")
 
 	layout := record.NewLayout(schema)
-	expectedOffsets := []int64{8, 16, 33}
+	// The fields follow the flag and the null bitmap.
+	expectedOffsets := []int64{16, 24, 41}
 	for idx, fldName := range layout.Schema.Fields() {
 		offset := layout.Offset(fldName)
 		assert.Equal(expectedOffsets[idx], offset)
 	}
 }
+
+func TestQualifiedLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	schema := record.NewSchema()
+	schema.AddIntField("A")
+	schema.AddStringField("B", 9)
+
+	layout := record.NewLayout(schema)
+	qualified := layout.Qualified("t")
+	assert.Equal([]string{"t.A", "t.B"}, qualified.Schema.Fields())
+	assert.Equal(layout.Offset("B"), qualified.Offset("t.B"))
+	assert.Equal(int64(9), qualified.Schema.FieldLength("t.B"))
+	assert.Equal(layout.SlotSize(), qualified.SlotSize())
+}
@@ -0,0 +1,264 @@
+package record
+
+import (
+	"strings"
+
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The overflow file of a table holds the values of its
+// fields that are too long to be stored in their record,
+// each one split into chunks stored in a chain of blocks.
+// A block of a chain starts with the number of the next
+// block of the chain, or 0 for the last one, followed by
+// its chunk, as a string. The first block of the file
+// holds the first block of the chain of free blocks,
+// which the blocks of the values that are no longer
+// used are added to, and new chains are taken from.
+// A record refers to a value by the number of the
+// first block of its chain, negated.
+type OverflowFile struct {
+	tx       *recovery.Transaction
+	fileName string
+}
+
+// Return the overflow file of the table stored
+// in the specified file.
+func NewOverflowFile(tx *recovery.Transaction, tblFileName string) *OverflowFile {
+	return &OverflowFile{tx, OverflowFileName(tblFileName)}
+}
+
+// Return the name of the overflow file of the table
+// stored in the specified file.
+func OverflowFileName(tblFileName string) string {
+	return strings.TrimSuffix(tblFileName, ".tbl") + ".ovf"
+}
+
+// Read the value of the chain starting
+// at the specified block.
+func (of *OverflowFile) Read(blockNum int64) (string, error) {
+	var value strings.Builder
+	for blockNum != 0 {
+		blockId := file.NewBlockId(of.fileName, blockNum)
+		err := of.tx.Pin(blockId)
+		if err != nil {
+			return "", err
+		}
+		next, err := of.tx.GetInt(blockId, 0)
+		if err != nil {
+			of.tx.Unpin(blockId)
+			return "", err
+		}
+		chunk, err := of.tx.GetString(blockId, 8)
+		of.tx.Unpin(blockId)
+		if err != nil {
+			return "", err
+		}
+		value.WriteString(chunk)
+		blockNum = next
+	}
+	return value.String(), nil
+}
+
+// Write the value in a new chain of blocks,
+// and return the number of its first block.
+// The chunks are written from the last one, so that
+// each block can refer to the next one.
+func (of *OverflowFile) Write(value string) (int64, error) {
+	chunkSize := of.chunkSize()
+	chunks := make([]string, 0)
+	for len(value) > 0 {
+		chunk := value[:min(int64(len(value)), chunkSize)]
+		chunks = append(chunks, chunk)
+		value = value[len(chunk):]
+	}
+	next := int64(0)
+	for i := len(chunks) - 1; i >= 0; i-- {
+		blockNum, err := of.allocate()
+		if err != nil {
+			return 0, err
+		}
+		blockId := file.NewBlockId(of.fileName, blockNum)
+		err = of.tx.Pin(blockId)
+		if err != nil {
+			return 0, err
+		}
+		err = of.tx.SetInt(blockId, 0, next, true)
+		if err == nil {
+			err = of.tx.SetString(blockId, 8, chunks[i], true)
+		}
+		of.tx.Unpin(blockId)
+		if err != nil {
+			return 0, err
+		}
+		next = blockNum
+	}
+	return next, nil
+}
+
+// Add the blocks of the chain starting at the
+// specified block to the chain of free blocks.
+func (of *OverflowFile) Free(blockNum int64) error {
+	if blockNum == 0 {
+		return nil
+	}
+	header := file.NewBlockId(of.fileName, 0)
+	err := of.tx.Pin(header)
+	if err != nil {
+		return err
+	}
+	defer of.tx.Unpin(header)
+	freeHead, err := of.tx.GetInt(header, 0)
+	if err != nil {
+		return err
+	}
+
+	last := blockNum
+	for {
+		blockId := file.NewBlockId(of.fileName, last)
+		err := of.tx.Pin(blockId)
+		if err != nil {
+			return err
+		}
+		next, err := of.tx.GetInt(blockId, 0)
+		if err == nil && next == 0 {
+			err = of.tx.SetInt(blockId, 0, freeHead, true)
+		}
+		of.tx.Unpin(blockId)
+		if err != nil {
+			return err
+		}
+		if next == 0 {
+			break
+		}
+		last = next
+	}
+	return of.tx.SetInt(header, 0, blockNum, true)
+}
+
+// Return the length of the chunk of a block.
+// Writing a chunk to a block reused from a freed chain logs
+// the chunk it replaces, so that the log record, which also
+// holds the operator, the transaction, the block and the
+// offset, must fit in a block of the log.
+func (of *OverflowFile) chunkSize() int64 {
+	return of.tx.BlockSize() - 64 - int64(len(of.fileName))
+}
+
+// Return a block for a chain, taken from the chain of free
+// blocks, or appended to the file if there is none.
+// The first block of a new file is its header.
+func (of *OverflowFile) allocate() (int64, error) {
+	size, err := of.tx.Size(of.fileName)
+	if err != nil {
+		return 0, err
+	}
+	if size == 0 {
+		_, err = of.tx.Append(of.fileName)
+		if err != nil {
+			return 0, err
+		}
+	}
+	header := file.NewBlockId(of.fileName, 0)
+	err = of.tx.Pin(header)
+	if err != nil {
+		return 0, err
+	}
+	defer of.tx.Unpin(header)
+	freeHead, err := of.tx.GetInt(header, 0)
+	if err != nil {
+		return 0, err
+	}
+	if freeHead == 0 {
+		blockId, err := of.tx.Append(of.fileName)
+		return blockId.BlockNum, err
+	}
+
+	blockId := file.NewBlockId(of.fileName, freeHead)
+	err = of.tx.Pin(blockId)
+	if err != nil {
+		return 0, err
+	}
+	next, err := of.tx.GetInt(blockId, 0)
+	of.tx.Unpin(blockId)
+	if err != nil {
+		return 0, err
+	}
+	return freeHead, of.tx.SetInt(header, 0, next, true)
+}
+
+// Return true if the data of a value is too
+// long to be stored in its record.
+func isOutOfLine(data string) bool {
+	return int64(len(data)) > MAX_INLINE_LENGTH
+}
+
+// Return the data of a value of a string or blob field,
+// as stored in a record or in the overflow file.
+func fieldData(fldType int64, value query.Constant) string {
+	value = CoerceValue(fldType, value)
+	if fldType == BLOB_TYPE {
+		return string(value.AsBlob())
+	}
+	return value.AsString()
+}
+
+// Return the value of a string or blob field
+// from its data.
+func dataValue(fldType int64, data string) query.Constant {
+	if fldType == BLOB_TYPE {
+		return query.NewConstant(parser.Blob(data))
+	}
+	return query.NewConstant(data)
+}
+
+// Return the number of the first block of the chain of the
+// out of line value whose reference is at the specified offset
+// of a block, or 0 if the value is stored in the record.
+// A reference is stored as the negated number of the block,
+// which tells it apart from what a record stores there for
+// an inline value.
+func outOfLineBlock(tx *recovery.Transaction, blockId file.BlockId, offset int64) (int64, error) {
+	reference, err := tx.GetInt(blockId, offset)
+	if err != nil || reference >= 0 {
+		return 0, err
+	}
+	return -reference, nil
+}
+
+// Read the out of line value of a field of the specified
+// type, whose chain starts at the specified block of the
+// overflow file of a table block.
+func readOutOfLine(tx *recovery.Transaction, blockId file.BlockId, blockNum int64, fldType int64) (query.Constant, error) {
+	data, err := NewOverflowFile(tx, blockId.FileName).Read(blockNum)
+	return dataValue(fldType, data), err
+}
+
+// Write the data of a value in a new chain, and store the
+// reference to it at the specified offset of a block.
+// The chain of the previous value must have been freed.
+func writeOutOfLine(tx *recovery.Transaction, blockId file.BlockId, offset int64, data string) error {
+	blockNum, err := NewOverflowFile(tx, blockId.FileName).Write(data)
+	if err != nil {
+		return err
+	}
+	return tx.SetInt(blockId, offset, -blockNum, true)
+}
+
+// Free the chain of the out of line value whose reference
+// is at the specified offset of a block, if any, and reset
+// the reference, so that the value is stored in the record.
+func clearOutOfLine(tx *recovery.Transaction, blockId file.BlockId, offset int64) error {
+	blockNum, err := outOfLineBlock(tx, blockId, offset)
+	if err != nil || blockNum == 0 {
+		return err
+	}
+	err = NewOverflowFile(tx, blockId.FileName).Free(blockNum)
+	if err != nil {
+		return err
+	}
+	return tx.SetInt(blockId, offset, 0, true)
+}
@@ -0,0 +1,224 @@
+package record_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/parser"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverflowFile(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_overflow_file")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	tx := db.NewTx()
+	overflowFile := record.NewOverflowFile(tx, "T.tbl")
+
+	// A value is split over as many blocks as needed.
+	long := strings.Repeat("abcdefghij", 200)
+	first, err := overflowFile.Write(long)
+	assert.Nil(err)
+	value, err := overflowFile.Read(first)
+	assert.Nil(err)
+	assert.Equal(long, value)
+	size, err := tx.Size("T.ovf")
+	assert.Nil(err)
+	assert.Equal(int64(1+(2000+330)/331), size)
+
+	empty, err := overflowFile.Write("")
+	assert.Nil(err)
+	assert.Equal(int64(0), empty)
+	value, err = overflowFile.Read(empty)
+	assert.Nil(err)
+	assert.Equal("", value)
+
+	// Freed blocks are reused before the file grows.
+	assert.Nil(overflowFile.Free(first))
+	first, err = overflowFile.Write(strings.Repeat("z", 1000))
+	assert.Nil(err)
+	second, err := overflowFile.Write(strings.Repeat("y", 900))
+	assert.Nil(err)
+	newSize, err := tx.Size("T.ovf")
+	assert.Nil(err)
+	assert.Equal(size, newSize)
+	value, err = overflowFile.Read(first)
+	assert.Nil(err)
+	assert.Equal(strings.Repeat("z", 1000), value)
+	value, err = overflowFile.Read(second)
+	assert.Nil(err)
+	assert.Equal(strings.Repeat("y", 900), value)
+	tx.Commit()
+}
+
+func TestTableScanOutOfLine(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_table_scan_out_of_line")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+
+	schema := record.NewSchema()
+	schema.AddIntField("A")
+	schema.AddStringField("B", 2000)
+	schema.AddField("C", record.BLOB_TYPE, 0)
+	assert.True(schema.MayBeOutOfLine("B"))
+	assert.True(schema.MayBeOutOfLine("C"))
+	assert.False(schema.MayBeOutOfLine("A"))
+
+	for _, layout := range []*record.Layout{record.NewLayout(schema), record.NewSlottedLayout(schema)} {
+		assert.Less(layout.MaxRecordSize(), int64(400))
+		tblName := fmt.Sprintf("T%d", layout.Format())
+		valueOf := func(a int64) string {
+			return strings.Repeat(fmt.Sprintf("%d-", a), 200)
+		}
+
+		tx := db.NewTx()
+		tblScan, err := record.NewTableScan(tx, tblName, layout)
+		assert.Nil(err)
+		for i := int64(0); i < 10; i++ {
+			tblScan.Insert()
+			tblScan.SetInt("A", i)
+			tblScan.SetString("B", valueOf(i))
+			tblScan.SetValue("C", query.NewConstant(parser.Blob([]byte{byte(i), 0, 255})))
+		}
+		tblScan.Close()
+		tx.Commit()
+
+		ovfFile := fmt.Sprintf("%s.ovf", tblName)
+		tx = db.NewTx()
+		size, err := tx.Size(ovfFile)
+		assert.Nil(err)
+
+		// Values that are replaced, nulled or deleted
+		// free their blocks for the new values.
+		tblScan, err = record.NewTableScan(tx, tblName, layout)
+		assert.Nil(err)
+		for tblScan.Next() {
+			a := tblScan.GetInt("A")
+			assert.Equal(valueOf(a), tblScan.GetString("B"))
+			assert.Equal(query.NewConstant(parser.Blob([]byte{byte(a), 0, 255})), tblScan.GetValue("C"))
+			switch a % 3 {
+			case 0:
+				tblScan.Delete()
+			case 1:
+				tblScan.SetNull("B")
+				assert.True(tblScan.IsNull("B"))
+			case 2:
+				tblScan.SetString("B", valueOf(a+100))
+			}
+		}
+		tblScan.Insert()
+		tblScan.SetInt("A", 100)
+		tblScan.SetString("B", valueOf(100))
+		tblScan.Close()
+		newSize, err := tx.Size(ovfFile)
+		assert.Nil(err)
+		assert.Equal(size, newSize)
+		tx.Rollback()
+
+		// The values are restored on rollback.
+		tx = db.NewTx()
+		tblScan, err = record.NewTableScan(tx, tblName, layout)
+		assert.Nil(err)
+		count := 0
+		for tblScan.Next() {
+			a := tblScan.GetInt("A")
+			assert.False(tblScan.IsNull("B"))
+			assert.Equal(valueOf(a), tblScan.GetString("B"))
+			assert.Equal(query.NewConstant(parser.Blob([]byte{byte(a), 0, 255})), tblScan.GetValue("C"))
+			count++
+		}
+		assert.Equal(10, count)
+		tblScan.Close()
+		tx.Commit()
+	}
+}
+
+func TestTableScanShortValuesInline(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_table_scan_short_values_inline")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+
+	schema := record.NewSchema()
+	schema.AddIntField("A")
+	schema.AddStringField("B", 2000)
+	schema.AddField("C", record.BLOB_TYPE, 0)
+	short := strings.Repeat("b", record.MAX_INLINE_LENGTH)
+	long := strings.Repeat("b", record.MAX_INLINE_LENGTH+1)
+
+	for _, layout := range []*record.Layout{record.NewLayout(schema), record.NewSlottedLayout(schema)} {
+		tblName := fmt.Sprintf("T%d", layout.Format())
+		ovfFile := fmt.Sprintf("%s.ovf", tblName)
+		tx := db.NewTx()
+
+		// The short values of a wide field and of a
+		// blob field take no overflow block.
+		tblScan, err := record.NewTableScan(tx, tblName, layout)
+		assert.Nil(err)
+		for i := int64(0); i < 10; i++ {
+			tblScan.Insert()
+			tblScan.SetInt("A", i)
+			tblScan.SetString("B", short[:i*10])
+			tblScan.SetValue("C", query.NewConstant(parser.Blob([]byte{byte(i), 0, 255})))
+		}
+		size, err := tx.Size(ovfFile)
+		assert.Nil(err)
+		assert.Equal(int64(0), size)
+
+		// A value goes out of line once it is longer,
+		// and back in the record once it is short again.
+		tblScan.BeforeFirst()
+		for tblScan.Next() {
+			switch a := tblScan.GetInt("A"); a {
+			case 3:
+				tblScan.SetString("B", long)
+			case 4:
+				tblScan.SetString("B", long)
+				tblScan.SetString("B", short)
+			case 5:
+				tblScan.SetValue("C", query.NewConstant(parser.Blob(long)))
+			}
+		}
+		size, err = tx.Size(ovfFile)
+		assert.Nil(err)
+		assert.Greater(size, int64(0))
+
+		tblScan.BeforeFirst()
+		count := 0
+		for tblScan.Next() {
+			a := tblScan.GetInt("A")
+			b, c := short[:a*10], query.NewConstant(parser.Blob([]byte{byte(a), 0, 255}))
+			switch a {
+			case 3:
+				b = long
+			case 4:
+				b = short
+			case 5:
+				c = query.NewConstant(parser.Blob(long))
+			}
+			assert.Equal(b, tblScan.GetString("B"))
+			assert.Equal(c, tblScan.GetValue("C"))
+			count++
+		}
+		assert.Equal(10, count)
+		tblScan.Close()
+		tx.Commit()
+	}
+}
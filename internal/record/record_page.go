@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/query"
 	"github.com/evanxg852000/simpledb/internal/tx/recovery"
 )
 
@@ -20,7 +21,10 @@ type RecordPage struct {
 }
 
 func NewRecordPage(tx *recovery.Transaction, blockId file.BlockId, layout *Layout) *RecordPage {
-	tx.Pin(blockId)
+	err := tx.Pin(blockId)
+	if err != nil {
+		panic(err)
+	}
 	return &RecordPage{tx, blockId, layout}
 }
 
@@ -33,26 +37,127 @@ func (rp *RecordPage) GetInt(slot int64, fldName string) (int64, error) {
 
 // Return the string value stored for the
 // specified field of the specified slot.
+// An out of line value is read from the overflow file.
 func (rp *RecordPage) GetString(slot int64, fldName string) (string, error) {
+	if rp.layout.Schema.MayBeOutOfLine(fldName) {
+		value, err := rp.GetValue(slot, fldName)
+		return value.AsString(), err
+	}
 	fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
 	return rp.tx.GetString(rp.blockId, fldPosition)
 }
 
+// Return the value stored for the specified field
+// of the specified slot, whatever its type.
+// The null bitmap is not checked.
+func (rp *RecordPage) GetValue(slot int64, fldName string) (query.Constant, error) {
+	fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
+	fldType := rp.layout.Schema.FieldType(fldName)
+	if rp.layout.Schema.MayBeOutOfLine(fldName) {
+		blockNum, err := outOfLineBlock(rp.tx, rp.blockId, fldPosition)
+		if err != nil {
+			return query.Constant{}, err
+		}
+		if blockNum != 0 {
+			return readOutOfLine(rp.tx, rp.blockId, blockNum, fldType)
+		}
+		// An inline value follows the reference.
+		fldPosition += 8
+	}
+	return ReadValue(rp.tx, rp.blockId, fldPosition, fldType)
+}
+
+// Return true if the specified field
+// of the specified slot is null.
+func (rp *RecordPage) IsNull(slot int64, fldName string) (bool, error) {
+	bitmapOffset, mask := rp.layout.NullBit(fldName)
+	bitmap, err := rp.tx.GetInt(rp.blockId, rp.offset(slot)+bitmapOffset)
+	if err != nil {
+		return false, err
+	}
+	return bitmap&mask != 0, nil
+}
+
 // Store an integer at the specified field
 // of the specified slot.
 func (rp *RecordPage) SetInt(slot int64, fldName string, value int64) error {
 	fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
-	return rp.tx.SetInt(rp.blockId, fldPosition, value, true)
+	err := rp.tx.SetInt(rp.blockId, fldPosition, value, true)
+	if err != nil {
+		return err
+	}
+	return rp.setNullBit(slot, fldName, false)
 }
 
 // Store a string at the specified field
 // of the specified slot.
 func (rp *RecordPage) SetString(slot int64, fldName string, value string) error {
+	if rp.layout.Schema.MayBeOutOfLine(fldName) {
+		return rp.SetValue(slot, fldName, query.NewConstant(value))
+	}
 	fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
-	return rp.tx.SetString(rp.blockId, fldPosition, value, true)
+	err := rp.tx.SetString(rp.blockId, fldPosition, value, true)
+	if err != nil {
+		return err
+	}
+	return rp.setNullBit(slot, fldName, false)
 }
 
+// Store a value of any type at the specified field
+// of the specified slot, converting it to the type
+// of the field.
+// The out of line value the field may have is freed, and
+// a value too long to be stored in the record replaces it.
+func (rp *RecordPage) SetValue(slot int64, fldName string, value query.Constant) error {
+	fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
+	fldType := rp.layout.Schema.FieldType(fldName)
+	var err error
+	if rp.layout.Schema.MayBeOutOfLine(fldName) {
+		err = clearOutOfLine(rp.tx, rp.blockId, fldPosition)
+		if err != nil {
+			return err
+		}
+		if data := fieldData(fldType, value); isOutOfLine(data) {
+			err = writeOutOfLine(rp.tx, rp.blockId, fldPosition, data)
+		} else {
+			err = WriteValue(rp.tx, rp.blockId, fldPosition+8, fldType, value, true)
+		}
+	} else {
+		err = WriteValue(rp.tx, rp.blockId, fldPosition, fldType, value, true)
+	}
+	if err != nil {
+		return err
+	}
+	return rp.setNullBit(slot, fldName, false)
+}
+
+// Set the specified field of the specified slot to null.
+// The value stored for the field is left as is, unless
+// it is out of line, in which case it is freed.
+func (rp *RecordPage) SetNull(slot int64, fldName string) error {
+	if rp.layout.Schema.MayBeOutOfLine(fldName) {
+		fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
+		err := clearOutOfLine(rp.tx, rp.blockId, fldPosition)
+		if err != nil {
+			return err
+		}
+	}
+	return rp.setNullBit(slot, fldName, true)
+}
+
+// Empty the specified slot, freeing
+// its out of line values.
 func (rp *RecordPage) Delete(slot int64) {
+	for _, fldName := range rp.layout.Schema.Fields() {
+		if !rp.layout.Schema.MayBeOutOfLine(fldName) {
+			continue
+		}
+		fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
+		err := clearOutOfLine(rp.tx, rp.blockId, fldPosition)
+		if err != nil {
+			panic(err)
+		}
+	}
 	rp.setFlag(slot, EMPTY)
 }
 
@@ -66,16 +171,15 @@ func (rp *RecordPage) Format() error {
 		schema := rp.layout.Schema
 		for _, fldName := range schema.Fields() {
 			fldPosition := rp.offset(slot) + rp.layout.Offset(fldName)
-			if schema.FieldType(fldName) == INTEGER_TYPE {
-				err := rp.tx.SetInt(rp.blockId, fldPosition, 0, false)
-				if err != nil {
-					return err
-				}
-			} else {
-				err := rp.tx.SetString(rp.blockId, fldPosition, "", false)
-				if err != nil {
-					return err
-				}
+			if schema.MayBeOutOfLine(fldName) {
+				// No value is stored in the overflow file yet.
+				rp.tx.SetInt(rp.blockId, fldPosition, 0, false)
+				fldPosition += 8
+			}
+			fldType := schema.FieldType(fldName)
+			err := WriteValue(rp.tx, rp.blockId, fldPosition, fldType, ZeroValue(fldType), false)
+			if err != nil {
+				return err
 			}
 		}
 		slot += 1
@@ -87,10 +191,16 @@ func (rp *RecordPage) NextAfter(slot int64) int64 {
 	return rp.searchAfter(slot, USED)
 }
 
+// Find the next empty slot after the specified one, and
+// mark it as used, with all of its fields set to null
+// until they are assigned a value.
 func (rp *RecordPage) InsertAfter(slot int64) int64 {
 	newSlot := rp.searchAfter(slot, EMPTY)
 	if newSlot >= 0 {
 		rp.setFlag(newSlot, USED)
+		for i := int64(0); i < rp.layout.NullBitmapLength(); i++ {
+			rp.tx.SetInt(rp.blockId, rp.offset(newSlot)+8+8*i, -1, true)
+		}
 	}
 	return newSlot
 }
@@ -103,6 +213,26 @@ func (rp *RecordPage) setFlag(slot int64, flag int64) {
 	rp.tx.SetInt(rp.blockId, rp.offset(slot), flag, true)
 }
 
+// Set or clear the bit of the specified field in the
+// null bitmap of the specified slot, if it is not
+// already in that state.
+func (rp *RecordPage) setNullBit(slot int64, fldName string, isNull bool) error {
+	bitmapOffset, mask := rp.layout.NullBit(fldName)
+	bitmapPosition := rp.offset(slot) + bitmapOffset
+	bitmap, err := rp.tx.GetInt(rp.blockId, bitmapPosition)
+	if err != nil {
+		return err
+	}
+	newBitmap := bitmap &^ mask
+	if isNull {
+		newBitmap = bitmap | mask
+	}
+	if newBitmap == bitmap {
+		return nil
+	}
+	return rp.tx.SetInt(rp.blockId, bitmapPosition, newBitmap, true)
+}
+
 func (rp *RecordPage) searchAfter(slot int64, flag int64) int64 {
 	slot += 1
 	for rp.isValidSlot(slot) {
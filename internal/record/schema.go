@@ -3,6 +3,7 @@ package record
 import (
 	"slices"
 
+	"github.com/evanxg852000/simpledb/internal/parser"
 	"github.com/evanxg852000/simpledb/internal/query"
 )
 
@@ -10,8 +11,29 @@ const (
 	_ = iota
 	INTEGER_TYPE
 	STRING_TYPE
+	BOOLEAN_TYPE
+	DOUBLE_TYPE
+	DATE_TYPE
+	TIMESTAMP_TYPE
+	BLOB_TYPE
 )
 
+// The length of the longest value stored in its record, in bytes.
+// A field is given this much room when its values may be longer,
+// which is the case of the string fields declared longer and of
+// the blob fields. Their values that do not fit are stored out
+// of line, in the overflow blocks of the table.
+const MAX_INLINE_LENGTH = 100
+
+// The number of characters of the longest integer,
+// which is the minimum 64-bit value with its sign.
+const MAX_INTEGER_DIGITS = 20
+
+// The number of characters of the longest double,
+// as formatted with the shortest representation
+// that reads back to the same value.
+const MAX_DOUBLE_DIGITS = 24
+
 type FieldInfo struct {
 	dataType int64
 	length   int64
@@ -39,7 +61,7 @@ func NewSchema() *Schema {
 
 // Add a field to the schema having a specified
 // name, type, and length.
-// If the field type is "integer", then the length
+// If the field type is not "string", then the length
 // value is irrelevant.
 func (schema *Schema) AddField(fldName string, fldType int64, fldLength int64) {
 	schema.fields = append(schema.fields, fldName)
@@ -93,6 +115,18 @@ func (schema *Schema) FieldType(fldName string) int64 {
 	return schema.info[fldName].dataType
 }
 
+// Return true if the values of the specified field are
+// stored out of line when they are longer than MAX_INLINE_LENGTH.
+func (schema *Schema) MayBeOutOfLine(fldName string) bool {
+	switch schema.FieldType(fldName) {
+	case STRING_TYPE:
+		return schema.FieldLength(fldName) > MAX_INLINE_LENGTH
+	case BLOB_TYPE:
+		return true
+	}
+	return false
+}
+
 // Return the conceptual length of the specified field.
 // If the field is not a string field, then
 // the return value is undefined.
@@ -103,8 +137,68 @@ func (schema *Schema) FieldLength(fldName string) int64 {
 // Determine if all of the fields mentioned in this expression
 // are contained in the specified schema.
 func (schema *Schema) AppliesTo(expr *query.Expression) bool {
-	if !expr.IsFieldName() {
-		return true
+	for _, fldName := range expr.FieldNames() {
+		if !schema.HasField(fldName) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the type and the conceptual length of the values
+// computed by the specified expression over this schema.
+// Arithmetic operations yield integers, or doubles when one
+// of their operands is a double, while a concatenation
+// yields a string as long as its operands put together.
+func (schema *Schema) ExpressionType(expr *query.Expression) (int64, int64) {
+	return schema.exprType(expr.Inner())
+}
+
+func (schema *Schema) exprType(expr parser.Expr) (int64, int64) {
+	switch value := expr.Value.(type) {
+	case string:
+		return schema.FieldType(value), schema.FieldLength(value)
+	case parser.Literal:
+		if str, ok := value.Value.(string); ok {
+			return STRING_TYPE, int64(len(str))
+		}
+		return value.Type(), 0
+	case parser.AggregateExpr:
+		// An aggregate is a field of the grouped records.
+		return schema.FieldType(expr.String()), schema.FieldLength(expr.String())
+	case parser.BinaryExpr:
+		if value.Op == "||" {
+			return STRING_TYPE, schema.displayLength(value.Left) + schema.displayLength(value.Right)
+		}
+		leftType, _ := schema.exprType(value.Left)
+		rightType, _ := schema.exprType(value.Right)
+		if leftType == DOUBLE_TYPE || rightType == DOUBLE_TYPE {
+			return DOUBLE_TYPE, 0
+		}
+	case parser.UnaryExpr:
+		return schema.exprType(value.Operand)
+	}
+	return INTEGER_TYPE, 0
+}
+
+// Return the maximum number of characters
+// needed to display the values of the expression.
+func (schema *Schema) displayLength(expr parser.Expr) int64 {
+	fldType, fldLength := schema.exprType(expr)
+	switch fldType {
+	case INTEGER_TYPE:
+		return MAX_INTEGER_DIGITS
+	case BOOLEAN_TYPE:
+		return int64(len("false"))
+	case DOUBLE_TYPE:
+		return MAX_DOUBLE_DIGITS
+	case DATE_TYPE:
+		return int64(len(parser.DATE_FORMAT))
+	case TIMESTAMP_TYPE:
+		return int64(len(parser.TIMESTAMP_FORMAT))
+	case BLOB_TYPE:
+		// The hexadecimal digits of each byte.
+		return 2*fldLength + 3
 	}
-	return schema.HasField(expr.AsFieldName())
+	return fldLength
 }
@@ -0,0 +1,516 @@
+package record
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+// The size of the header of a slotted block, which holds
+// the number of slots of its directory and the start
+// of its record space.
+const SLOTTED_HEADER_SIZE = 16
+
+// Store records of variable length in a block.
+// The block starts with a header, followed by the slot
+// directory, which holds the position of the record of
+// each slot, or 0 for an empty slot. Records are allocated
+// from the end of the block towards the directory.
+// A record has a fixed part, as described by the layout,
+// and one cell for the value of each string or blob field,
+// found at the position held by the field. A cell is as long
+// as its value, and is reallocated when a longer value
+// does not fit in it. The field of an out of line value
+// holds a reference to the value in the overflow file
+// instead of a position.
+// The space of deleted records and values is reclaimed
+// by compacting the block when it runs out of space.
+// A record is only inserted in a block having room for its
+// longest values, but a value that later grows beyond the
+// room left in the block is refused, since records never
+// move to another block.
+type SlottedPage struct {
+	tx      *recovery.Transaction
+	blockId file.BlockId
+	layout  *Layout
+}
+
+func NewSlottedPage(tx *recovery.Transaction, blockId file.BlockId, layout *Layout) *SlottedPage {
+	err := tx.Pin(blockId)
+	if err != nil {
+		panic(err)
+	}
+	return &SlottedPage{tx, blockId, layout}
+}
+
+// Return the integer value stored for the
+// specified field of a specified slot.
+func (sp *SlottedPage) GetInt(slot int64, fldName string) (int64, error) {
+	fldPosition, err := sp.fieldPosition(slot, fldName)
+	if err != nil {
+		return 0, err
+	}
+	return sp.tx.GetInt(sp.blockId, fldPosition)
+}
+
+// Return the string value stored for the
+// specified field of the specified slot,
+// which is empty if no value was ever stored.
+func (sp *SlottedPage) GetString(slot int64, fldName string) (string, error) {
+	fldPosition, err := sp.fieldPosition(slot, fldName)
+	if err != nil {
+		return "", err
+	}
+	cell, err := sp.tx.GetInt(sp.blockId, fldPosition)
+	if err != nil || cell == 0 {
+		return "", err
+	}
+	if cell < 0 {
+		value, err := readOutOfLine(sp.tx, sp.blockId, -cell, STRING_TYPE)
+		return value.AsString(), err
+	}
+	return sp.tx.GetString(sp.blockId, cell)
+}
+
+// Return the value stored for the specified field
+// of the specified slot, whatever its type.
+func (sp *SlottedPage) GetValue(slot int64, fldName string) (query.Constant, error) {
+	fldType := sp.layout.Schema.FieldType(fldName)
+	if fldType == STRING_TYPE || fldType == BLOB_TYPE {
+		// The data of a blob is read as a string.
+		data, err := sp.GetString(slot, fldName)
+		return dataValue(fldType, data), err
+	}
+	fldPosition, err := sp.fieldPosition(slot, fldName)
+	if err != nil {
+		return query.Constant{}, err
+	}
+	return ReadValue(sp.tx, sp.blockId, fldPosition, fldType)
+}
+
+// Return true if the specified field
+// of the specified slot is null.
+func (sp *SlottedPage) IsNull(slot int64, fldName string) (bool, error) {
+	bitmapOffset, mask := sp.layout.NullBit(fldName)
+	record, err := sp.recordPosition(slot)
+	if err != nil {
+		return false, err
+	}
+	bitmap, err := sp.tx.GetInt(sp.blockId, record+bitmapOffset)
+	if err != nil {
+		return false, err
+	}
+	return bitmap&mask != 0, nil
+}
+
+// Store an integer at the specified field
+// of the specified slot.
+func (sp *SlottedPage) SetInt(slot int64, fldName string, value int64) error {
+	fldPosition, err := sp.fieldPosition(slot, fldName)
+	if err != nil {
+		return err
+	}
+	err = sp.tx.SetInt(sp.blockId, fldPosition, value, true)
+	if err != nil {
+		return err
+	}
+	return sp.setNullBit(slot, fldName, false)
+}
+
+// Store a string at the specified field of the specified
+// slot. The value replaces the previous one in its cell
+// if it fits, and is stored in a new cell otherwise.
+// The out of line value the field may have is freed, and
+// a value too long to be stored in the record replaces it.
+func (sp *SlottedPage) SetString(slot int64, fldName string, value string) error {
+	length := file.GetEncodingLength(int64(len(value)))
+	fldPosition, err := sp.fieldPosition(slot, fldName)
+	if err != nil {
+		return err
+	}
+	if sp.layout.Schema.MayBeOutOfLine(fldName) {
+		err = clearOutOfLine(sp.tx, sp.blockId, fldPosition)
+		if err != nil {
+			return err
+		}
+		if isOutOfLine(value) {
+			// The cell of the previous value, if any,
+			// is reclaimed by the next compaction.
+			err = writeOutOfLine(sp.tx, sp.blockId, fldPosition, value)
+			if err != nil {
+				return err
+			}
+			return sp.setNullBit(slot, fldName, false)
+		}
+	}
+	cell, err := sp.tx.GetInt(sp.blockId, fldPosition)
+	if err != nil {
+		return err
+	}
+	cellLength, err := sp.cellLength(cell)
+	if err != nil {
+		return err
+	}
+	if cell == 0 || cellLength < length {
+		// The allocation may compact the block,
+		// which moves the record.
+		cell, err = sp.allocate(length)
+		if err != nil {
+			return err
+		}
+		fldPosition, err = sp.fieldPosition(slot, fldName)
+		if err != nil {
+			return err
+		}
+		err = sp.tx.SetInt(sp.blockId, fldPosition, cell, true)
+		if err != nil {
+			return err
+		}
+	}
+	err = sp.writeCell(cell, value)
+	if err != nil {
+		return err
+	}
+	return sp.setNullBit(slot, fldName, false)
+}
+
+// Store a value of any type at the specified field
+// of the specified slot, converting it to the type
+// of the field.
+func (sp *SlottedPage) SetValue(slot int64, fldName string, value query.Constant) error {
+	fldType := sp.layout.Schema.FieldType(fldName)
+	if fldType == STRING_TYPE || fldType == BLOB_TYPE {
+		// The data of a blob is stored as a string.
+		return sp.SetString(slot, fldName, fieldData(fldType, value))
+	}
+	fldPosition, err := sp.fieldPosition(slot, fldName)
+	if err != nil {
+		return err
+	}
+	err = WriteValue(sp.tx, sp.blockId, fldPosition, fldType, value, true)
+	if err != nil {
+		return err
+	}
+	return sp.setNullBit(slot, fldName, false)
+}
+
+// Set the specified field of the specified slot to null.
+// The cell of a string or blob value is released, and
+// an out of line value is freed.
+func (sp *SlottedPage) SetNull(slot int64, fldName string) error {
+	fldType := sp.layout.Schema.FieldType(fldName)
+	if fldType == STRING_TYPE || fldType == BLOB_TYPE {
+		fldPosition, err := sp.fieldPosition(slot, fldName)
+		if err != nil {
+			return err
+		}
+		if sp.layout.Schema.MayBeOutOfLine(fldName) {
+			err = clearOutOfLine(sp.tx, sp.blockId, fldPosition)
+			if err != nil {
+				return err
+			}
+		}
+		err = sp.tx.SetInt(sp.blockId, fldPosition, 0, true)
+		if err != nil {
+			return err
+		}
+	}
+	return sp.setNullBit(slot, fldName, true)
+}
+
+// Empty the specified slot, freeing its out of line values.
+// Its space is reclaimed when the block is compacted.
+func (sp *SlottedPage) Delete(slot int64) {
+	for _, fldName := range sp.layout.Schema.Fields() {
+		if !sp.layout.Schema.MayBeOutOfLine(fldName) {
+			continue
+		}
+		fldPosition, err := sp.fieldPosition(slot, fldName)
+		if err == nil {
+			err = clearOutOfLine(sp.tx, sp.blockId, fldPosition)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+	err := sp.tx.SetInt(sp.blockId, directoryEntry(slot), 0, true)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Format a new block with an empty directory.
+// These values should not be logged
+// (because the old values are meaningless).
+func (sp *SlottedPage) Format() error {
+	err := sp.tx.SetInt(sp.blockId, 0, 0, false)
+	if err != nil {
+		return err
+	}
+	return sp.tx.SetInt(sp.blockId, 8, sp.tx.BlockSize(), false)
+}
+
+func (sp *SlottedPage) NextAfter(slot int64) int64 {
+	numSlots := sp.numSlots()
+	for slot += 1; slot < numSlots; slot++ {
+		record, err := sp.tx.GetInt(sp.blockId, directoryEntry(slot))
+		if err != nil {
+			panic(err)
+		}
+		if record != 0 {
+			return slot
+		}
+	}
+	return -1
+}
+
+// Insert a new record in the first empty slot after the
+// specified one, or in a new slot at the end of the
+// directory, if the block has room for the largest
+// possible record. All of its fields are null.
+func (sp *SlottedPage) InsertAfter(slot int64) int64 {
+	numSlots := sp.numSlots()
+	newSlot := numSlots
+	for s := slot + 1; s < numSlots; s++ {
+		record, err := sp.tx.GetInt(sp.blockId, directoryEntry(s))
+		if err != nil {
+			panic(err)
+		}
+		if record == 0 {
+			newSlot = s
+			break
+		}
+	}
+	directorySize := directoryEntry(numSlots)
+	if newSlot == numSlots {
+		directorySize += 8
+	}
+	if sp.availableSpace()-(directorySize-directoryEntry(numSlots)) < sp.layout.MaxRecordSize() {
+		return -1
+	}
+	// The directory must not grow over the new record.
+	if sp.freeEnd()-sp.layout.SlotSize() < directorySize {
+		sp.compact()
+	}
+
+	record, err := sp.allocate(sp.layout.SlotSize())
+	if err != nil {
+		panic(err)
+	}
+	for pos := int64(0); pos < sp.layout.SlotSize(); pos += 8 {
+		value := int64(0)
+		if pos < sp.layout.NullBitmapLength()*8 {
+			value = -1
+		}
+		sp.setInt(record+pos, value)
+	}
+	if newSlot == numSlots {
+		sp.setInt(0, numSlots+1)
+	}
+	sp.setInt(directoryEntry(newSlot), record)
+	return newSlot
+}
+
+func (sp *SlottedPage) BlockId() file.BlockId {
+	return sp.blockId
+}
+
+// Private auxiliary methods
+
+// Allocate space of the specified length at the start of
+// the record space, compacting the block if needed.
+func (sp *SlottedPage) allocate(length int64) (int64, error) {
+	if sp.freeEnd()-length < directoryEntry(sp.numSlots()) {
+		if sp.availableSpace() < length {
+			return 0, fmt.Errorf("no room left in block %v for %d bytes", sp.blockId, length)
+		}
+		sp.compact()
+	}
+	freeEnd := sp.freeEnd() - length
+	return freeEnd, sp.tx.SetInt(sp.blockId, 8, freeEnd, true)
+}
+
+// A record or a string value stored in the block,
+// along with the slot of the record it belongs to,
+// and the field it is the value of, if any.
+type slottedCell struct {
+	position int64
+	length   int64
+	slot     int64
+	fldName  string
+}
+
+// Move the records and values in use to the end of the block,
+// so that the space of the others becomes free.
+// They are moved from the last one of the block, to a
+// position that is never before their current one,
+// so that none is overwritten before being moved.
+func (sp *SlottedPage) compact() {
+	cells := sp.cells()
+	slices.SortFunc(cells, func(a, b slottedCell) int {
+		return cmp.Compare(b.position, a.position)
+	})
+	records := make(map[int64]int64)
+	values := make(map[slottedCell]int64)
+	freeEnd := sp.tx.BlockSize()
+	for _, cell := range cells {
+		freeEnd -= cell.length
+		if cell.fldName == "" {
+			records[cell.slot] = freeEnd
+			if freeEnd != cell.position {
+				words := make([]int64, 0, cell.length/8)
+				for pos := int64(0); pos < cell.length; pos += 8 {
+					words = append(words, sp.getInt(cell.position+pos))
+				}
+				for i, word := range words {
+					sp.setInt(freeEnd+int64(i)*8, word)
+				}
+			}
+			continue
+		}
+		values[cell] = freeEnd
+		if freeEnd != cell.position {
+			value, err := sp.tx.GetString(sp.blockId, cell.position)
+			if err != nil {
+				panic(err)
+			}
+			err = sp.writeCell(freeEnd, value)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+	for slot, record := range records {
+		sp.setInt(directoryEntry(slot), record)
+	}
+	for cell, position := range values {
+		sp.setInt(records[cell.slot]+sp.layout.Offset(cell.fldName), position)
+	}
+	sp.setInt(8, freeEnd)
+}
+
+// Return the records and the string and blob
+// values in use, except the out of line ones.
+func (sp *SlottedPage) cells() []slottedCell {
+	cells := make([]slottedCell, 0)
+	numSlots := sp.numSlots()
+	for slot := int64(0); slot < numSlots; slot++ {
+		record := sp.getInt(directoryEntry(slot))
+		if record == 0 {
+			continue
+		}
+		cells = append(cells, slottedCell{record, sp.layout.SlotSize(), slot, ""})
+		for _, fldName := range sp.layout.Schema.Fields() {
+			fldType := sp.layout.Schema.FieldType(fldName)
+			if fldType != STRING_TYPE && fldType != BLOB_TYPE {
+				continue
+			}
+			cell := sp.getInt(record + sp.layout.Offset(fldName))
+			if cell <= 0 {
+				continue
+			}
+			length, err := sp.cellLength(cell)
+			if err != nil {
+				panic(err)
+			}
+			cells = append(cells, slottedCell{cell, length, slot, fldName})
+		}
+	}
+	return cells
+}
+
+// Return the space left for records and values
+// once the block is compacted.
+func (sp *SlottedPage) availableSpace() int64 {
+	used := int64(0)
+	for _, cell := range sp.cells() {
+		used += cell.length
+	}
+	return sp.tx.BlockSize() - directoryEntry(sp.numSlots()) - used
+}
+
+// Return the length of the cell at the specified
+// position, or 0 for no cell.
+func (sp *SlottedPage) cellLength(cell int64) (int64, error) {
+	if cell == 0 {
+		return 0, nil
+	}
+	length, err := sp.tx.GetInt(sp.blockId, cell)
+	return file.GetEncodingLength(length), err
+}
+
+// Write a string value in the cell at the specified position.
+// The previous content of the cell may be arbitrary bytes,
+// which are logged as the old value of the string once its
+// length is set to the length of the new one, so that
+// a rollback restores them.
+func (sp *SlottedPage) writeCell(cell int64, value string) error {
+	err := sp.tx.SetInt(sp.blockId, cell, int64(len(value)), true)
+	if err != nil {
+		return err
+	}
+	return sp.tx.SetString(sp.blockId, cell, value, true)
+}
+
+// Set or clear the bit of the specified field in the
+// null bitmap of the specified slot, if it is not
+// already in that state.
+func (sp *SlottedPage) setNullBit(slot int64, fldName string, isNull bool) error {
+	bitmapOffset, mask := sp.layout.NullBit(fldName)
+	record, err := sp.recordPosition(slot)
+	if err != nil {
+		return err
+	}
+	bitmap, err := sp.tx.GetInt(sp.blockId, record+bitmapOffset)
+	if err != nil {
+		return err
+	}
+	newBitmap := bitmap &^ mask
+	if isNull {
+		newBitmap = bitmap | mask
+	}
+	if newBitmap == bitmap {
+		return nil
+	}
+	return sp.tx.SetInt(sp.blockId, record+bitmapOffset, newBitmap, true)
+}
+
+func (sp *SlottedPage) recordPosition(slot int64) (int64, error) {
+	return sp.tx.GetInt(sp.blockId, directoryEntry(slot))
+}
+
+func (sp *SlottedPage) fieldPosition(slot int64, fldName string) (int64, error) {
+	record, err := sp.recordPosition(slot)
+	return record + sp.layout.Offset(fldName), err
+}
+
+func (sp *SlottedPage) numSlots() int64 {
+	return sp.getInt(0)
+}
+
+func (sp *SlottedPage) freeEnd() int64 {
+	return sp.getInt(8)
+}
+
+func (sp *SlottedPage) getInt(position int64) int64 {
+	value, err := sp.tx.GetInt(sp.blockId, position)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (sp *SlottedPage) setInt(position int64, value int64) {
+	err := sp.tx.SetInt(sp.blockId, position, value, true)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func directoryEntry(slot int64) int64 {
+	return SLOTTED_HEADER_SIZE + 8*slot
+}
@@ -0,0 +1,189 @@
+package record_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/query"
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlottedPage(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_slotted_page")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	tx := db.NewTx()
+
+	schema := record.NewSchema()
+	schema.AddIntField("A")
+	schema.AddStringField("B", 100)
+	layout := record.NewSlottedLayout(schema)
+	assert.Equal(int64(24), layout.SlotSize())
+	assert.Equal(int64(132), layout.MaxRecordSize())
+
+	blockId, err := tx.Append("test_slotted_page")
+	assert.Nil(err)
+	slottedPage := record.NewSlottedPage(tx, blockId, layout)
+	err = slottedPage.Format()
+	assert.Nil(err)
+
+	// Short strings only take the space they need, so that the
+	// block holds more records than the three slots of 132 bytes
+	// the fixed format would give it.
+	records := make(map[int64]string)
+	slot := slottedPage.InsertAfter(-1)
+	for slot >= 0 {
+		assert.Nil(slottedPage.SetInt(slot, "A", slot))
+		assert.Nil(slottedPage.SetString(slot, "B", fmt.Sprintf("rec_%d", slot)))
+		records[slot] = fmt.Sprintf("rec_%d", slot)
+		slot = slottedPage.InsertAfter(slot)
+	}
+	assert.Greater(len(records), 3)
+	tx.Commit()
+
+	readRecords := func() map[int64]string {
+		values := make(map[int64]string)
+		for slot := slottedPage.NextAfter(-1); slot >= 0; slot = slottedPage.NextAfter(slot) {
+			aVal, err := slottedPage.GetInt(slot, "A")
+			assert.Nil(err)
+			assert.Equal(slot, aVal)
+			bVal, err := slottedPage.GetString(slot, "B")
+			assert.Nil(err)
+			values[slot] = bVal
+		}
+		return values
+	}
+
+	// The space of deleted records is reused by longer
+	// values, which are moved around by compaction.
+	tx = db.NewTx()
+	slottedPage = record.NewSlottedPage(tx, blockId, layout)
+	for slot := range records {
+		if slot%2 == 0 {
+			slottedPage.Delete(slot)
+			delete(records, slot)
+		}
+	}
+	for slot := range records {
+		value := strings.Repeat("x", 20) + fmt.Sprint(slot)
+		assert.Nil(slottedPage.SetString(slot, "B", value))
+		records[slot] = value
+	}
+	assert.Equal(records, readRecords())
+
+	// A deleted slot is reused, and a value that
+	// no longer fits in the block is refused.
+	slot = slottedPage.InsertAfter(-1)
+	assert.Equal(int64(0), slot)
+	assert.Nil(slottedPage.SetInt(slot, "A", slot))
+	isNull, err := slottedPage.IsNull(slot, "B")
+	assert.Nil(err)
+	assert.True(isNull)
+	records[slot] = ""
+	assert.Equal(records, readRecords())
+	for slot := range records {
+		err = slottedPage.SetString(slot, "B", strings.Repeat("y", 100))
+		if err != nil {
+			break
+		}
+		records[slot] = strings.Repeat("y", 100)
+	}
+	assert.ErrorContains(err, "no room left")
+	assert.Equal(records, readRecords())
+	tx.Commit()
+
+	// The moves of a compaction are undone on rollback.
+	tx = db.NewTx()
+	slottedPage = record.NewSlottedPage(tx, blockId, layout)
+	for slot := range records {
+		slottedPage.Delete(slot)
+	}
+	for slot = slottedPage.InsertAfter(-1); slot >= 0; slot = slottedPage.InsertAfter(slot) {
+		assert.Nil(slottedPage.SetInt(slot, "A", slot))
+		assert.Nil(slottedPage.SetString(slot, "B", strings.Repeat("z", 100)))
+	}
+	assert.Equal(map[int64]string{0: strings.Repeat("z", 100), 1: strings.Repeat("z", 100)}, readRecords())
+	tx.Rollback()
+
+	tx = db.NewTx()
+	slottedPage = record.NewSlottedPage(tx, blockId, layout)
+	assert.Equal(records, readRecords())
+	tx.Commit()
+}
+
+func TestTableScanSlotted(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_table_scan_slotted")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	tx := db.NewTx()
+
+	schema := record.NewSchema()
+	schema.AddIntField("A")
+	schema.AddStringField("B", 100)
+
+	// The same records take fewer blocks in the slotted format.
+	for _, layout := range []*record.Layout{record.NewLayout(schema), record.NewSlottedLayout(schema)} {
+		tblName := fmt.Sprintf("T%d", layout.Format())
+		tblScan, err := record.NewTableScan(tx, tblName, layout)
+		assert.Nil(err)
+		for i := int64(0); i < 30; i++ {
+			tblScan.Insert()
+			tblScan.SetInt("A", i)
+			if i%3 != 0 {
+				tblScan.SetString("B", fmt.Sprintf("rec_%d", i))
+			}
+		}
+
+		tblScan.BeforeFirst()
+		count := int64(0)
+		for tblScan.Next() {
+			a := tblScan.GetInt("A")
+			assert.Equal(a%3 == 0, tblScan.IsNull("B"))
+			if a%2 == 0 {
+				tblScan.Delete()
+			} else if a%3 != 0 {
+				assert.Equal(fmt.Sprintf("rec_%d", a), tblScan.GetString("B"))
+				tblScan.SetString("B", fmt.Sprintf("record_%d", a))
+			}
+			count++
+		}
+		assert.Equal(int64(30), count)
+
+		rids := make(map[int64]query.RID)
+		tblScan.BeforeFirst()
+		for tblScan.Next() {
+			a := tblScan.GetInt("A")
+			assert.Equal(int64(1), a%2)
+			if a%3 != 0 {
+				value := tblScan.GetValue("B")
+				assert.Equal(fmt.Sprintf("record_%d", a), value.String())
+			}
+			rids[a] = tblScan.GetRID()
+		}
+		assert.Len(rids, 15)
+		for a, rid := range rids {
+			tblScan.MoveToRID(rid)
+			assert.Equal(a, tblScan.GetInt("A"))
+		}
+		tblScan.Close()
+	}
+	fixedSize, err := tx.Size("T0.tbl")
+	assert.Nil(err)
+	slottedSize, err := tx.Size("T1.tbl")
+	assert.Nil(err)
+	assert.Less(slottedSize, fixedSize)
+	tx.Commit()
+}
@@ -11,7 +11,7 @@ import (
 type TableScan struct {
 	tx          *recovery.Transaction
 	layout      *Layout
-	recordPage  *RecordPage
+	recordPage  DataPage
 	fileName    string
 	currentSlot int64
 }
@@ -65,6 +65,7 @@ func (tblScan *TableScan) Next() bool {
 }
 
 func (tblScan *TableScan) GetInt(fldName string) int64 {
+	tblScan.checkField(fldName)
 	value, err := tblScan.recordPage.GetInt(tblScan.currentSlot, fldName)
 	if err != nil {
 		panic(err)
@@ -73,6 +74,7 @@ func (tblScan *TableScan) GetInt(fldName string) int64 {
 }
 
 func (tblScan *TableScan) GetString(fldName string) string {
+	tblScan.checkField(fldName)
 	value, err := tblScan.recordPage.GetString(tblScan.currentSlot, fldName)
 	if err != nil {
 		panic(err)
@@ -81,13 +83,23 @@ func (tblScan *TableScan) GetString(fldName string) string {
 }
 
 func (tblScan *TableScan) GetValue(fldName string) query.Constant {
-	if tblScan.layout.Schema.FieldType(fldName) == INTEGER_TYPE {
-		iVal := tblScan.GetInt(fldName)
-		return query.NewConstant(iVal)
+	if tblScan.IsNull(fldName) {
+		return query.NewNullConstant()
 	}
+	value, err := tblScan.recordPage.GetValue(tblScan.currentSlot, fldName)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
 
-	sVal := tblScan.GetString(fldName)
-	return query.NewConstant(sVal)
+func (tblScan *TableScan) IsNull(fldName string) bool {
+	tblScan.checkField(fldName)
+	isNull, err := tblScan.recordPage.IsNull(tblScan.currentSlot, fldName)
+	if err != nil {
+		panic(err)
+	}
+	return isNull
 }
 
 func (tblScan *TableScan) HasField(fldName string) bool {
@@ -103,6 +115,7 @@ func (tblScan *TableScan) Close() {
 // Methods that implement UpdateScan
 
 func (tblScan *TableScan) SetInt(fldName string, value int64) {
+	tblScan.checkField(fldName)
 	err := tblScan.recordPage.SetInt(tblScan.currentSlot, fldName, value)
 	if err != nil {
 		panic(err)
@@ -110,18 +123,31 @@ func (tblScan *TableScan) SetInt(fldName string, value int64) {
 }
 
 func (tblScan *TableScan) SetString(fldName string, value string) {
+	tblScan.checkField(fldName)
 	err := tblScan.recordPage.SetString(tblScan.currentSlot, fldName, value)
 	if err != nil {
 		panic(err)
 	}
 }
 
+func (tblScan *TableScan) SetNull(fldName string) {
+	tblScan.checkField(fldName)
+	err := tblScan.recordPage.SetNull(tblScan.currentSlot, fldName)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (tblScan *TableScan) SetValue(fldName string, value query.Constant) {
-	if tblScan.layout.Schema.FieldType(fldName) == INTEGER_TYPE {
-		tblScan.SetInt(fldName, value.AsInt())
+	tblScan.checkField(fldName)
+	if value.IsNull() {
+		tblScan.SetNull(fldName)
 		return
 	}
-	tblScan.SetString(fldName, value.AsString())
+	err := tblScan.recordPage.SetValue(tblScan.currentSlot, fldName, value)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (tblScan *TableScan) Insert() {
@@ -151,7 +177,7 @@ func (tblScan *TableScan) Delete() {
 func (tblScan *TableScan) MoveToRID(rId query.RID) {
 	tblScan.Close()
 	blockId := file.NewBlockId(tblScan.fileName, rId.BlockNum)
-	tblScan.recordPage = NewRecordPage(tblScan.tx, blockId, tblScan.layout)
+	tblScan.recordPage = NewDataPage(tblScan.tx, blockId, tblScan.layout)
 	tblScan.currentSlot = rId.Slot
 }
 
@@ -161,10 +187,18 @@ func (tblScan *TableScan) GetRID() query.RID {
 
 // Private auxiliary methods
 
+// Panic with an error wrapping query.ErrColumnNotFound
+// if the table does not have the specified field.
+func (tblScan *TableScan) checkField(fldName string) {
+	if !tblScan.layout.Schema.HasField(fldName) {
+		panic(query.NewColumnNotFoundError(fldName))
+	}
+}
+
 func (tblScan *TableScan) moveToBlock(blockNum int64) {
 	tblScan.Close()
 	blockId := file.NewBlockId(tblScan.fileName, blockNum)
-	tblScan.recordPage = NewRecordPage(tblScan.tx, blockId, tblScan.layout)
+	tblScan.recordPage = NewDataPage(tblScan.tx, blockId, tblScan.layout)
 	tblScan.currentSlot = -1
 }
 
@@ -175,7 +209,7 @@ func (tblScan *TableScan) moveToNewBlock() error {
 		return err
 	}
 
-	tblScan.recordPage = NewRecordPage(tblScan.tx, blockId, tblScan.layout)
+	tblScan.recordPage = NewDataPage(tblScan.tx, blockId, tblScan.layout)
 	tblScan.recordPage.Format()
 	tblScan.currentSlot = -1
 	return nil
@@ -63,3 +63,46 @@ func TestTableScan(t *testing.T) {
 	tblScan.Close()
 	tx.Commit()
 }
+
+func TestTableScanNulls(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_table_scan_nulls")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	tx := db.NewTx()
+
+	schema := record.NewSchema()
+	schema.AddIntField("A")
+	schema.AddStringField("B", 9)
+	layout := record.NewLayout(schema)
+
+	// The fields of an inserted record are null until they are set.
+	tblScan, err := record.NewTableScan(tx, "T", layout)
+	assert.Nil(err)
+	tblScan.Insert()
+	assert.True(tblScan.IsNull("A"))
+	assert.True(tblScan.IsNull("B"))
+	tblScan.SetInt("A", 1)
+	assert.False(tblScan.IsNull("A"))
+	assert.True(tblScan.IsNull("B"))
+	value := tblScan.GetValue("B")
+	assert.True(value.IsNull())
+
+	tblScan.SetString("B", "rec")
+	tblScan.SetNull("A")
+	tblScan.BeforeFirst()
+	assert.True(tblScan.Next())
+	assert.True(tblScan.IsNull("A"))
+	assert.Equal("rec", tblScan.GetString("B"))
+
+	// A slot reused after a deletion starts with null fields again.
+	tblScan.Delete()
+	tblScan.BeforeFirst()
+	tblScan.Insert()
+	assert.True(tblScan.IsNull("B"))
+	tblScan.Close()
+	tx.Commit()
+}
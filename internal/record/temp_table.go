@@ -0,0 +1,67 @@
+package record
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/evanxg852000/simpledb/internal/file"
+	"github.com/evanxg852000/simpledb/internal/tx/recovery"
+)
+
+var nextTempTableNum = atomic.Int64{}
+
+// A class that creates temporary tables.
+// A temporary table is not registered in the catalog.
+// It holds the intermediate results of a query,
+// and is read and written through a table scan.
+// Its file only lives as long as the transaction
+// that created it.
+type TempTable struct {
+	tx      *recovery.Transaction
+	tblName string
+	layout  *Layout
+}
+
+// Allocate a name for a new temporary table
+// having the specified schema, and register its file
+// to be deleted when the transaction ends, along
+// with its overflow file.
+// Names already taken by a file, or by a file that was
+// deleted, such as those left over by a crash, are skipped.
+func NewTempTable(tx *recovery.Transaction, schema *Schema) *TempTable {
+	for {
+		tblName := fmt.Sprintf("%s%d", file.TEMP_FILE_PREFIX, nextTempTableNum.Add(1))
+		fileName := fmt.Sprintf("%s.tbl", tblName)
+		size, err := tx.Size(fileName)
+		if errors.Is(err, file.ErrFileDeleted) {
+			continue
+		}
+		if err != nil {
+			panic(err)
+		}
+		if size == 0 {
+			tx.AddTempFile(fileName)
+			tx.AddTempFile(OverflowFileName(fileName))
+			return &TempTable{tx, tblName, NewLayout(schema)}
+		}
+	}
+}
+
+// Open a table scan for the temporary table.
+func (tt *TempTable) Open() *TableScan {
+	tableScan, err := NewTableScan(tt.tx, tt.tblName, tt.layout)
+	if err != nil {
+		panic(err)
+	}
+	return tableScan
+}
+
+func (tt *TempTable) TableName() string {
+	return tt.tblName
+}
+
+// Return the table's metadata.
+func (tt *TempTable) Layout() *Layout {
+	return tt.layout
+}
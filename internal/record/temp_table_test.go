@@ -0,0 +1,76 @@
+package record_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/evanxg852000/simpledb/internal/record"
+	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTempTable(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_temp_table")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+
+	schema := record.NewSchema()
+	schema.AddIntField("A")
+	schema.AddStringField("B", 9)
+
+	fillTempTable := func(temp *record.TempTable) {
+		tblScan := temp.Open()
+		for i := 0; i < 30; i++ {
+			tblScan.Insert()
+			tblScan.SetInt("A", int64(i))
+			tblScan.SetString("B", fmt.Sprintf("rec_%d", i))
+		}
+		tblScan.BeforeFirst()
+		count := 0
+		for tblScan.Next() {
+			assert.Equal(int64(count), tblScan.GetInt("A"))
+			count++
+		}
+		assert.Equal(30, count)
+		tblScan.Close()
+	}
+
+	// Each temporary table has its own file,
+	// which is deleted when the transaction commits.
+	tx := db.NewTx()
+	temp1 := record.NewTempTable(tx, schema)
+	temp2 := record.NewTempTable(tx, schema)
+	assert.NotEqual(temp1.TableName(), temp2.TableName())
+	assert.True(strings.HasPrefix(temp1.TableName(), "temp-"))
+	fillTempTable(temp1)
+	fillTempTable(temp2)
+	tempFile1 := path.Join(dbDir, temp1.TableName()+".tbl")
+	tempFile2 := path.Join(dbDir, temp2.TableName()+".tbl")
+	assert.FileExists(tempFile1)
+	assert.FileExists(tempFile2)
+	tx.Commit()
+	assert.NoFileExists(tempFile1)
+	assert.NoFileExists(tempFile2)
+
+	// The files are also deleted when the transaction rolls back.
+	tx = db.NewTx()
+	temp3 := record.NewTempTable(tx, schema)
+	fillTempTable(temp3)
+	tempFile3 := path.Join(dbDir, temp3.TableName()+".tbl")
+	assert.FileExists(tempFile3)
+	tx.Rollback()
+	assert.NoFileExists(tempFile3)
+
+	// Temporary files left over by a crash are deleted on restart.
+	strayFile := path.Join(dbDir, "temp-stray.tbl")
+	assert.Nil(os.WriteFile(strayFile, make([]byte, 400), 0644))
+	server.NewSimpleDB(dbDir, 400, 8)
+	assert.NoFileExists(strayFile)
+}
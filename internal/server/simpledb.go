@@ -17,6 +17,27 @@ const (
 	LOG_FILE    = "simpledb.log"
 )
 
+// The query planners a database can plan select statements with.
+const (
+	HEURISTIC_PLANNER = iota
+	BASIC_PLANNER
+)
+
+// An optional setting of the database.
+type Option func(*options)
+
+type options struct {
+	queryPlanner int
+}
+
+// Plans select statements with the specified query planner,
+// instead of the default heuristic planner.
+func WithQueryPlanner(queryPlanner int) Option {
+	return func(opts *options) {
+		opts.queryPlanner = queryPlanner
+	}
+}
+
 type SimpleDB struct {
 	fileManager     *file.FileManager
 	bufferManager   *buffer.BufferManager
@@ -26,7 +47,12 @@ type SimpleDB struct {
 }
 
 // A constructor useful for debugging.
-func NewSimpleDB(directory string, blockSize int, bufferSize int) *SimpleDB {
+func NewSimpleDB(directory string, blockSize int, bufferSize int, opts ...Option) *SimpleDB {
+	dbOptions := options{queryPlanner: HEURISTIC_PLANNER}
+	for _, opt := range opts {
+		opt(&dbOptions)
+	}
+
 	fileManager, err := file.NewFileManager(directory, int64(blockSize))
 	if err != nil {
 		log.Fatalf("could not open the database")
@@ -46,12 +72,22 @@ func NewSimpleDB(directory string, blockSize int, bufferSize int) *SimpleDB {
 	} else {
 		log.Println("recovering existing database")
 		tx.Recover()
+		// Undoing the changes of temporary tables may recreate their files.
+		err = fileManager.DeleteTempFiles()
+		if err != nil {
+			log.Fatalf("could not delete the temporary files")
+		}
 	}
 
 	metadataManager := metadata.NewMetadataManager(isNew, tx)
+	var queryPlanner plan.QueryPlanner = plan.NewHeuristicQueryPlanner(metadataManager)
+	if dbOptions.queryPlanner == BASIC_PLANNER {
+		queryPlanner = plan.NewBasicQueryPlanner(metadataManager)
+	}
 	planner := plan.NewPlanner(
-		plan.NewBasicQueryPlanner(metadataManager),
-		plan.NewBasicUpdatePlanner(metadataManager),
+		queryPlanner,
+		plan.NewIndexUpdatePlanner(metadataManager),
+		metadataManager,
 	)
 	tx.Commit()
 
@@ -5,8 +5,10 @@ import (
 	"path"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/evanxg852000/simpledb/internal/server"
+	"github.com/evanxg852000/simpledb/internal/tx/concurrency"
 	"github.com/evanxg852000/simpledb/internal/tx/recovery"
 	"github.com/stretchr/testify/assert"
 )
@@ -35,6 +37,44 @@ func TestConcurrency(t *testing.T) {
 	wg.Wait()
 }
 
+func TestLockTimeout(t *testing.T) {
+	assert := assert.New(t)
+	workspaceDir, err := os.MkdirTemp("", "test_lock_timeout")
+	assert.Nil(err)
+	dbDir := path.Join(workspaceDir, "db")
+	defer os.RemoveAll(workspaceDir)
+
+	db := server.NewSimpleDB(dbDir, 400, 8)
+	tx := db.NewTx()
+	blockId, err := tx.Append("lock_timeout")
+	assert.Nil(err)
+	tx.Commit()
+
+	// A transaction waiting for a lock held by another
+	// one gives up with an error, rather than reading
+	// or writing the block without the lock.
+	lockTable := concurrency.GlobalLockTable()
+	lockTable.SetMaxWaitTime(50 * time.Millisecond)
+	defer lockTable.SetMaxWaitTime(concurrency.MAX_WAIT_TIME)
+	tx1 := db.NewTx()
+	assert.Nil(tx1.Pin(blockId))
+	assert.Nil(tx1.SetInt(blockId, 0, 1, true))
+
+	tx2 := db.NewTx()
+	assert.Nil(tx2.Pin(blockId))
+	_, err = tx2.GetInt(blockId, 0)
+	assert.ErrorIs(err, concurrency.ErrLockTimeout)
+	tx2.Rollback()
+
+	tx1.Commit()
+	tx3 := db.NewTx()
+	assert.Nil(tx3.Pin(blockId))
+	value, err := tx3.GetInt(blockId, 0)
+	assert.Nil(err)
+	assert.Equal(int64(1), value)
+	tx3.Commit()
+}
+
 func A(assert *assert.Assertions, tx *recovery.Transaction, wg *sync.WaitGroup) {
 	defer wg.Done()
 	//TODO:
@@ -6,6 +6,11 @@ import "github.com/evanxg852000/simpledb/internal/file"
 // share the same lock table.
 var lockTableInstance *LockTable = NewLockTable()
 
+// Returns the global lock table.
+func GlobalLockTable() *LockTable {
+	return lockTableInstance
+}
+
 // The concurrency manager for the transaction.
 // Each transaction has its own concurrency manager.
 // The concurrency manager keeps track of which locks the
@@ -26,23 +31,34 @@ func NewConcurrencyManager() *ConcurrencyManager {
 // Obtain an SLock on the block, if necessary.
 // The method will ask the lock table for an SLock
 // if the transaction currently has no locks on that block.
-func (cm *ConcurrencyManager) SLock(blockId file.BlockId) {
+func (cm *ConcurrencyManager) SLock(blockId file.BlockId) error {
 	if _, exists := cm.locks[blockId]; !exists {
-		cm.lockTable.SLock(blockId)
+		err := cm.lockTable.SLock(blockId)
+		if err != nil {
+			return err
+		}
 		cm.locks[blockId] = "S"
 	}
+	return nil
 }
 
 // Obtain an XLock on the block, if necessary.
 // If the transaction does not have an XLock on that block,
 // then the method first gets an SLock on that block
 // (if necessary), and then upgrades it to an XLock.
-func (cm *ConcurrencyManager) XLock(blockId file.BlockId) {
+func (cm *ConcurrencyManager) XLock(blockId file.BlockId) error {
 	if !cm.hasXLock(blockId) {
-		cm.SLock(blockId)
-		cm.lockTable.XLock(blockId)
+		err := cm.SLock(blockId)
+		if err != nil {
+			return err
+		}
+		err = cm.lockTable.XLock(blockId)
+		if err != nil {
+			return err
+		}
 		cm.locks[blockId] = "X"
 	}
+	return nil
 }
 
 // Release all locks by asking the lock table to
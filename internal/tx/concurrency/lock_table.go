@@ -2,6 +2,7 @@ package concurrency
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -10,9 +11,14 @@ import (
 )
 
 const (
+	// The default time a lock request waits for.
 	MAX_WAIT_TIME = 10 * time.Second
 )
 
+// The error of a transaction that could not obtain a lock,
+// because a conflicting lock was not released in time.
+var ErrLockTimeout = errors.New("lock request timed out")
+
 // The lock table, which provides methods to lock and unlock blocks.
 // If a transaction requests a lock that causes a conflict with an
 // existing lock, then that transaction is placed on a wait list.
@@ -22,9 +28,10 @@ const (
 // If one of those transactions discovers that the lock it is waiting for
 // is still locked, it will place itself back on the wait list.
 type LockTable struct {
-	locks map[file.BlockId]int
-	mu    *sync.Mutex
-	cond  *sync.Cond
+	locks       map[file.BlockId]int
+	mu          *sync.Mutex
+	cond        *sync.Cond
+	maxWaitTime time.Duration
 }
 
 func NewLockTable() *LockTable {
@@ -32,30 +39,39 @@ func NewLockTable() *LockTable {
 	cond := sync.NewCond(mu)
 
 	return &LockTable{
-		locks: map[file.BlockId]int{},
-		mu:    mu,
-		cond:  cond,
+		locks:       map[file.BlockId]int{},
+		mu:          mu,
+		cond:        cond,
+		maxWaitTime: MAX_WAIT_TIME,
 	}
 }
 
+// Sets the time a lock request waits for
+// before returning ErrLockTimeout.
+func (lt *LockTable) SetMaxWaitTime(maxWaitTime time.Duration) {
+	lt.mu.Lock()
+	defer lt.mu.Unlock()
+	lt.maxWaitTime = maxWaitTime
+}
+
 // Grant an SLock on the specified block.
 // If an XLock exists when the method is called,
 // then the calling thread will be placed on a wait list
 // until the lock is released.
 // If the thread remains on the wait list for a certain
-// amount of time (currently 10 seconds),
-// then an exception is thrown.
+// amount of time (MAX_WAIT_TIME unless set otherwise),
+// then ErrLockTimeout is returned.
 func (lt *LockTable) SLock(blockId file.BlockId) error {
 	lt.mu.Lock()
 	defer lt.mu.Unlock()
 
 	startTimestamp := time.Now().UnixMilli()
 	for lt.hasXLock(blockId) && !lt.waitToLong(startTimestamp) {
-		utils.WaitCondWithTimeout(lt.cond, MAX_WAIT_TIME)
+		utils.WaitCondWithTimeout(lt.cond, lt.maxWaitTime)
 	}
 
 	if lt.hasXLock(blockId) {
-		return errors.New("LockAbortException")
+		return fmt.Errorf("%w: %v", ErrLockTimeout, blockId)
 	}
 
 	value := lt.getLockValue(blockId) // will not be negative
@@ -68,18 +84,18 @@ func (lt *LockTable) SLock(blockId file.BlockId) error {
 // then the calling thread will be placed on a wait list
 // until the locks are released.
 // If the thread remains on the wait list for a certain
-// amount of time (currently 10 seconds),
-// then an exception is thrown.
+// amount of time (MAX_WAIT_TIME unless set otherwise),
+// then ErrLockTimeout is returned.
 func (lt *LockTable) XLock(blockId file.BlockId) error {
 	lt.mu.Lock()
 	defer lt.mu.Unlock()
 
 	startTimestamp := time.Now().UnixMilli()
 	for lt.hasOtherSLocks(blockId) && !lt.waitToLong(startTimestamp) {
-		utils.WaitCondWithTimeout(lt.cond, MAX_WAIT_TIME)
+		utils.WaitCondWithTimeout(lt.cond, lt.maxWaitTime)
 	}
 	if lt.hasOtherSLocks(blockId) {
-		return errors.New("LockAbortException")
+		return fmt.Errorf("%w: %v", ErrLockTimeout, blockId)
 	}
 
 	lt.locks[blockId] = -1
@@ -105,7 +121,7 @@ func (lt *LockTable) hasOtherSLocks(blockId file.BlockId) bool {
 }
 
 func (lt *LockTable) waitToLong(startTimestamp int64) bool {
-	return time.Now().UnixMilli()-startTimestamp > MAX_WAIT_TIME.Milliseconds()
+	return time.Now().UnixMilli()-startTimestamp > lt.maxWaitTime.Milliseconds()
 }
 
 func (lt *LockTable) getLockValue(blockId file.BlockId) int {
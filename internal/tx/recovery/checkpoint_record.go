@@ -26,7 +26,7 @@ func (cr CheckpointRecord) ToString() string {
 
 // Does nothing, because a checkpoint record
 // contains no undo information.
-func (cr CheckpointRecord) Undo(tx *Transaction) {}
+func (cr CheckpointRecord) Undo(tx *Transaction) error { return nil }
 
 // A static method to write a checkpoint record to the log.
 // This log record contains the CHECKPOINT operator,
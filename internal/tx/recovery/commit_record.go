@@ -33,7 +33,7 @@ func (cr CommitRecord) ToString() string {
 
 // Does nothing, because a commit record
 // contains no undo information.
-func (cr CommitRecord) Undo(tx *Transaction) {}
+func (cr CommitRecord) Undo(tx *Transaction) error { return nil }
 
 // A static method to write a commit record to the log.
 // This log record contains the COMMIT operator,
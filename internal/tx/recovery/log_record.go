@@ -11,6 +11,7 @@ const (
 	ROLLBACK
 	SETINT
 	SETSTRING
+	SETFLOAT
 )
 
 // The interface implemented by each type of log record.
@@ -23,8 +24,9 @@ type LogRecord interface {
 
 	// Undoes the operation encoded by this log record.
 	// The only log record types for which this method
-	// does anything interesting are SETINT and SETSTRING.
-	Undo(tx *Transaction)
+	// does anything interesting are SETINT, SETSTRING
+	// and SETFLOAT.
+	Undo(tx *Transaction) error
 
 	// Return string representation
 	ToString() string
@@ -49,6 +51,8 @@ func NewLogRecord(data []byte) (LogRecord, error) {
 		return NewSetIntRecord(page)
 	case SETSTRING:
 		return NewSetStringRecord(page)
+	case SETFLOAT:
+		return NewSetFloatRecord(page)
 	}
 	return nil, nil
 }
@@ -90,6 +90,16 @@ func (rm *RecoveryManager) SetString(buffer *buffer.Buffer, offset int64, value
 	return SetStringRecord.WriteToLog(SetStringRecord{}, rm.logManager, rm.txNum, blockId, offset, oldValue)
 }
 
+// Write a setfloat record to the log and return its lsn.
+func (rm *RecoveryManager) SetFloat(buffer *buffer.Buffer, offset int64, value float64) (int64, error) {
+	oldValue, err := buffer.Content().ReadFloat(offset)
+	if err != nil {
+		return 0, err
+	}
+	blockId := buffer.Block()
+	return SetFloatRecord.WriteToLog(SetFloatRecord{}, rm.logManager, rm.txNum, blockId, offset, oldValue)
+}
+
 // Rollback the transaction, by iterating
 // through the log records until it finds
 // the transaction's START record,
@@ -115,7 +125,10 @@ func (rm *RecoveryManager) doRollback() error {
 			if record.Operation() == START {
 				return nil
 			}
-			record.Undo(rm.tx)
+			err = record.Undo(rm.tx)
+			if err != nil {
+				return err
+			}
 		}
 
 	}
@@ -154,7 +167,10 @@ func (rm *RecoveryManager) doRecover() error {
 		}
 
 		if !slices.Contains(finishedTxs, record.TxNumber()) {
-			record.Undo(rm.tx)
+			err = record.Undo(rm.tx)
+			if err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -33,7 +33,7 @@ func (rr RollbackRecord) ToString() string {
 
 // Does nothing, because a rollback record
 // contains no undo information.
-func (rr RollbackRecord) Undo(tx *Transaction) {}
+func (rr RollbackRecord) Undo(tx *Transaction) error { return nil }
 
 // A static method to write a rollback record to the log.
 // This log record contains the ROLLBACK operator,
@@ -0,0 +1,117 @@
+package recovery
+
+import (
+	"fmt"
+
+	"github.com/evanxg852000/simpledb/internal/file"
+	walog "github.com/evanxg852000/simpledb/internal/log"
+)
+
+type SetFloatRecord struct {
+	txNum   int64
+	offset  int64
+	value   float64
+	blockId file.BlockId
+}
+
+func NewSetFloatRecord(page file.Page) (SetFloatRecord, error) {
+	pos := int64(8)
+	txNum, err := page.ReadInt(pos)
+	if err != nil {
+		return SetFloatRecord{}, err
+	}
+
+	pos += 8
+	fileName, err := page.ReadString(pos)
+	if err != nil {
+		return SetFloatRecord{}, err
+	}
+
+	pos = pos + file.GetEncodingLength(int64(len(fileName)))
+	blockNum, err := page.ReadInt(pos)
+	if err != nil {
+		return SetFloatRecord{}, err
+	}
+	blockId := file.NewBlockId(fileName, blockNum)
+
+	pos = pos + 8
+	offset, err := page.ReadInt(pos)
+	if err != nil {
+		return SetFloatRecord{}, err
+	}
+
+	pos = pos + 8
+	value, err := page.ReadFloat(pos)
+	if err != nil {
+		return SetFloatRecord{}, err
+	}
+
+	return SetFloatRecord{txNum, offset, value, blockId}, nil
+}
+
+func (sfr SetFloatRecord) Operation() int {
+	return SETFLOAT
+}
+
+func (sfr SetFloatRecord) TxNumber() int64 {
+	return sfr.txNum
+}
+
+func (sfr SetFloatRecord) ToString() string {
+	return fmt.Sprintf("<SETFLOAT %d %v %d %v>", sfr.txNum, sfr.blockId, sfr.offset, sfr.value)
+}
+
+// Replace the specified data value with the value saved in the log record.
+// The method pins a buffer to the specified block,
+// calls setFloat to restore the saved value,
+// and unpins the buffer.
+// Returns an error if the block cannot be pinned
+// or the value cannot be restored.
+func (sfr SetFloatRecord) Undo(tx *Transaction) error {
+	err := tx.Pin(sfr.blockId)
+	if err != nil {
+		return err
+	}
+	defer tx.Unpin(sfr.blockId)
+	return tx.SetFloat(sfr.blockId, sfr.offset, sfr.value, false) // don't log the undo
+}
+
+// A static method to write a setFloat record to the log.
+// This log record contains the SETFLOAT operator,
+// followed by the transaction id, the filename, number,
+// and offset of the modified block, and the previous
+// floating-point value at that offset.
+func (SetFloatRecord) WriteToLog(lm *walog.LogManager, txNum int64, blockId file.BlockId, offset int64, value float64) (int64, error) {
+	buffer := file.NewByteBuffer()
+	err := buffer.WriteInt(SETFLOAT)
+	if err != nil {
+		return -1, err
+	}
+
+	err = buffer.WriteInt(txNum)
+	if err != nil {
+		return -1, err
+	}
+
+	err = buffer.WriteString(blockId.FileName)
+	if err != nil {
+		return -1, err
+	}
+
+	err = buffer.WriteInt(blockId.BlockNum)
+	if err != nil {
+		return -1, err
+	}
+
+	err = buffer.WriteInt(offset)
+	if err != nil {
+		return -1, err
+	}
+
+	err = buffer.WriteFloat(value)
+	if err != nil {
+		return -1, err
+	}
+
+	return lm.Append(buffer.Data())
+}
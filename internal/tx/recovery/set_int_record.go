@@ -65,10 +65,15 @@ func (sir SetIntRecord) ToString() string {
 // The method pins a buffer to the specified block,
 // calls setInt to restore the saved value,
 // and unpins the buffer.
-func (sir SetIntRecord) Undo(tx *Transaction) {
-	tx.Pin(sir.blockId)
-	tx.SetInt(sir.blockId, sir.offset, sir.value, false) // don't log the undo
-	tx.Unpin(sir.blockId)
+// Returns an error if the block cannot be pinned
+// or the value cannot be restored.
+func (sir SetIntRecord) Undo(tx *Transaction) error {
+	err := tx.Pin(sir.blockId)
+	if err != nil {
+		return err
+	}
+	defer tx.Unpin(sir.blockId)
+	return tx.SetInt(sir.blockId, sir.offset, sir.value, false) // don't log the undo
 }
 
 // A static method to write a setInt record to the log.
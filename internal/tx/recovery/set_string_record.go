@@ -65,10 +65,15 @@ func (ssr SetStringRecord) ToString() string {
 // The method pins a buffer to the specified block,
 // calls setInt to restore the saved value,
 // and unpins the buffer.
-func (ssr SetStringRecord) Undo(tx *Transaction) {
-	tx.Pin(ssr.blockId)
-	tx.SetString(ssr.blockId, ssr.offset, ssr.value, false) // don't log the undo
-	tx.Unpin(ssr.blockId)
+// Returns an error if the block cannot be pinned
+// or the value cannot be restored.
+func (ssr SetStringRecord) Undo(tx *Transaction) error {
+	err := tx.Pin(ssr.blockId)
+	if err != nil {
+		return err
+	}
+	defer tx.Unpin(ssr.blockId)
+	return tx.SetString(ssr.blockId, ssr.offset, ssr.value, false) // don't log the undo
 }
 
 // A static method to write a SetString record to the log.
@@ -31,7 +31,7 @@ func (sr StartRecord) ToString() string {
 	return fmt.Sprintf("<START %d>", sr.txNum)
 }
 
-func (sr StartRecord) Undo(tx *Transaction) {}
+func (sr StartRecord) Undo(tx *Transaction) error { return nil }
 
 // A static method to write a start record to the log.
 // This log record contains the START operator,
@@ -23,6 +23,7 @@ type Transaction struct {
 	fileManager        *file.FileManager
 	txNum              int64
 	buffers            *BufferList
+	tempFiles          []string
 }
 
 func NewTransaction(fileManager *file.FileManager, logManager *walog.LogManager, bufferManager *buffer.BufferManager) *Transaction {
@@ -48,6 +49,7 @@ func (tx *Transaction) Commit() {
 	log.Printf("transaction %d committed\n", tx.txNum)
 	tx.concurrencyManager.Release()
 	tx.buffers.UnpinAll()
+	tx.deleteTempFiles()
 }
 
 // Rollback the current transaction.
@@ -60,6 +62,7 @@ func (tx *Transaction) Rollback() {
 	fmt.Printf("transaction %d rolled back\n", tx.txNum)
 	tx.concurrencyManager.Release()
 	tx.buffers.UnpinAll()
+	tx.deleteTempFiles()
 }
 
 // Flush all modified buffers.
@@ -75,8 +78,10 @@ func (tx *Transaction) Recover() {
 
 // Pin the specified block.
 // The transaction manages the buffer for the client.
-func (tx *Transaction) Pin(blockId file.BlockId) {
-	tx.buffers.Pin(blockId)
+// An error wrapping buffer.ErrBufferAbort is returned
+// if no buffer becomes available in time.
+func (tx *Transaction) Pin(blockId file.BlockId) error {
+	return tx.buffers.Pin(blockId)
 }
 
 // Unpin the specified block.
@@ -92,7 +97,10 @@ func (tx *Transaction) Unpin(blockId file.BlockId) {
 // then it calls the buffer to retrieve the value.
 // returns the integer stored at that offset
 func (tx *Transaction) GetInt(blockId file.BlockId, offset int64) (int64, error) {
-	tx.concurrencyManager.SLock(blockId)
+	err := tx.concurrencyManager.SLock(blockId)
+	if err != nil {
+		return 0, err
+	}
 	buffer := tx.buffers.GetBuffer(blockId)
 	return buffer.Content().ReadInt(offset)
 }
@@ -103,11 +111,27 @@ func (tx *Transaction) GetInt(blockId file.BlockId, offset int64) (int64, error)
 // then it calls the buffer to retrieve the value.
 // returns the string stored at that offset
 func (tx *Transaction) GetString(blockId file.BlockId, offset int64) (string, error) {
-	tx.concurrencyManager.SLock(blockId)
+	err := tx.concurrencyManager.SLock(blockId)
+	if err != nil {
+		return "", err
+	}
 	buffer := tx.buffers.GetBuffer(blockId)
 	return buffer.Content().ReadString(offset)
 }
 
+// Return the floating-point value stored at the
+// specified offset of the specified block.
+// The method first obtains an SLock on the block,
+// then it calls the buffer to retrieve the value.
+func (tx *Transaction) GetFloat(blockId file.BlockId, offset int64) (float64, error) {
+	err := tx.concurrencyManager.SLock(blockId)
+	if err != nil {
+		return 0, err
+	}
+	buffer := tx.buffers.GetBuffer(blockId)
+	return buffer.Content().ReadFloat(offset)
+}
+
 // Store an integer at the specified offset
 // of the specified block.
 // The method first obtains an XLock on the block.
@@ -117,17 +141,19 @@ func (tx *Transaction) GetString(blockId file.BlockId, offset int64) (string, er
 // Finally, it calls the buffer to store the value,
 // passing in the LSN of the log record and the transaction's id.
 func (tx *Transaction) SetInt(blockId file.BlockId, offset int64, value int64, okToLog bool) error {
-	tx.concurrencyManager.XLock(blockId)
+	err := tx.concurrencyManager.XLock(blockId)
+	if err != nil {
+		return err
+	}
 	buffer := tx.buffers.GetBuffer(blockId)
 	lsn := int64(-1)
 	if okToLog {
-		lsn, err := tx.recoveryManager.SetInt(buffer, offset, value)
-		_ = lsn
+		lsn, err = tx.recoveryManager.SetInt(buffer, offset, value)
 		if err != nil {
 			return err
 		}
 	}
-	err := buffer.Content().WriteInt(offset, value)
+	err = buffer.Content().WriteInt(offset, value)
 	if err != nil {
 		return err
 	}
@@ -144,17 +170,43 @@ func (tx *Transaction) SetInt(blockId file.BlockId, offset int64, value int64, o
 // Finally, it calls the buffer to store the value,
 // passing in the LSN of the log record and the transaction's id.
 func (tx *Transaction) SetString(blockId file.BlockId, offset int64, value string, okToLog bool) error {
-	tx.concurrencyManager.XLock(blockId)
+	err := tx.concurrencyManager.XLock(blockId)
+	if err != nil {
+		return err
+	}
+	buffer := tx.buffers.GetBuffer(blockId)
+	lsn := int64(-1)
+	if okToLog {
+		lsn, err = tx.recoveryManager.SetString(buffer, offset, value)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = buffer.Content().WriteString(offset, value)
+	if err != nil {
+		return err
+	}
+	buffer.Modify(tx.txNum, lsn)
+	return nil
+}
+
+// Store a floating-point value at the specified offset
+// of the specified block, logging the previous value
+// like SetInt does.
+func (tx *Transaction) SetFloat(blockId file.BlockId, offset int64, value float64, okToLog bool) error {
+	err := tx.concurrencyManager.XLock(blockId)
+	if err != nil {
+		return err
+	}
 	buffer := tx.buffers.GetBuffer(blockId)
 	lsn := int64(-1)
 	if okToLog {
-		lsn, err := tx.recoveryManager.SetString(buffer, offset, value)
-		_ = lsn
+		lsn, err = tx.recoveryManager.SetFloat(buffer, offset, value)
 		if err != nil {
 			return err
 		}
 	}
-	_, err := buffer.Content().WriteString(offset, value)
+	err = buffer.Content().WriteFloat(offset, value)
 	if err != nil {
 		return err
 	}
@@ -168,7 +220,10 @@ func (tx *Transaction) SetString(blockId file.BlockId, offset int64, value strin
 // to return the file size.
 func (tx *Transaction) Size(fileName string) (int64, error) {
 	blockId := file.NewBlockId(fileName, int64(END_OF_FILE))
-	tx.concurrencyManager.SLock(blockId)
+	err := tx.concurrencyManager.SLock(blockId)
+	if err != nil {
+		return 0, err
+	}
 	return tx.fileManager.BlockCount(fileName)
 }
 
@@ -178,10 +233,34 @@ func (tx *Transaction) Size(fileName string) (int64, error) {
 // "end of the file", before performing the append.
 func (tx *Transaction) Append(fileName string) (file.BlockId, error) {
 	blockId := file.NewBlockId(fileName, int64(END_OF_FILE))
-	tx.concurrencyManager.XLock(blockId)
+	err := tx.concurrencyManager.XLock(blockId)
+	if err != nil {
+		return file.BlockId{}, err
+	}
 	return tx.fileManager.Append(fileName)
 }
 
+// Register a temporary file of the transaction,
+// which is deleted when the transaction commits
+// or rolls back.
+func (tx *Transaction) AddTempFile(fileName string) {
+	tx.tempFiles = append(tx.tempFiles, fileName)
+}
+
+// Deletes the temporary files of the transaction, once its
+// buffers are unpinned, after dropping the buffers that still
+// hold their blocks so that they are never written back.
+func (tx *Transaction) deleteTempFiles() {
+	for _, fileName := range tx.tempFiles {
+		tx.bufferManager.DropFile(fileName)
+		err := tx.fileManager.Delete(fileName)
+		if err != nil {
+			log.Printf("could not delete temporary file %s: %v\n", fileName, err)
+		}
+	}
+	tx.tempFiles = nil
+}
+
 func (tx *Transaction) BlockSize() int64 {
 	return tx.fileManager.BlockSize()
 }
@@ -59,14 +59,25 @@ func TestTransaction(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal("one!", sVal)
 
-	tx3.SetInt(blockId, 80, 9999, true)
-	iVal, _ = tx3.GetInt(blockId, 80)
-	assert.Equal(int64(9999), iVal)
-	tx3.Rollback()
+	tx3.SetFloat(blockId, 120, 1.5, true)
+	tx3.Commit()
 
 	tx4 := recovery.NewTransaction(fm, lm, bm)
 	tx4.Pin(blockId)
+	tx4.SetInt(blockId, 80, 9999, true)
 	iVal, _ = tx4.GetInt(blockId, 80)
+	assert.Equal(int64(9999), iVal)
+	tx4.SetFloat(blockId, 120, -0.25, true)
+	fVal, err := tx4.GetFloat(blockId, 120)
+	assert.Nil(err)
+	assert.Equal(-0.25, fVal)
+	tx4.Rollback()
+
+	tx5 := recovery.NewTransaction(fm, lm, bm)
+	tx5.Pin(blockId)
+	iVal, _ = tx5.GetInt(blockId, 80)
 	assert.Equal(int64(2), iVal)
-	tx4.Commit()
+	fVal, _ = tx5.GetFloat(blockId, 120)
+	assert.Equal(1.5, fVal)
+	tx5.Commit()
 }